@@ -4,18 +4,24 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"time"
 
 	grpc_middleware "github.com/grpc-ecosystem/go-grpc-middleware"
 	_ "github.com/lib/pq"
 	"github.com/pentops/grpc.go/grpcbind"
 	"github.com/pentops/j5/lib/j5grpc"
 	"github.com/pentops/j5/lib/psm/psmigrate"
+	"github.com/pentops/log.go/log"
+	"github.com/pentops/runner"
 	"github.com/pentops/runner/commander"
 	"github.com/pentops/sqrlx.go/pgenv"
 	"github.com/pentops/sqrlx.go/sqrlx"
 	"github.com/pentops/trigger/service"
 	"github.com/pentops/trigger/states"
 	"github.com/pressly/goose"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/exporters/otlp/otlpmetric/otlpmetricgrpc"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/reflection"
 )
@@ -39,7 +45,12 @@ func runPSMTables(ctx context.Context, cfg struct{}) error {
 		return err
 	}
 
-	migrationFile, err := psmigrate.BuildStateMachineMigrations(sm.StateTableSpec())
+	calendarSM, err := states.NewCalendarStateMachine()
+	if err != nil {
+		return err
+	}
+
+	migrationFile, err := psmigrate.BuildStateMachineMigrations(sm.StateTableSpec(), calendarSM.StateTableSpec())
 	if err != nil {
 		return fmt.Errorf("build migration file: %w", err)
 	}
@@ -65,7 +76,7 @@ func runServiceInfo(ctx context.Context, config struct {
 }) error {
 
 	grpcServer := grpc.NewServer()
-	if err := buildAndRegister(ctx, nil, grpcServer); err != nil {
+	if _, err := buildAndRegister(ctx, nil, grpcServer); err != nil {
 		return err
 	}
 
@@ -75,8 +86,32 @@ func runServiceInfo(ctx context.Context, config struct {
 func runServe(ctx context.Context, config struct {
 	grpcbind.EnvConfig
 	pgenv.DatabaseConfig
+
+	// how often the self tick is sent, longer intervals send fewer ticks but
+	// fire triggers up to the interval late
+	TickInterval time.Duration `env:"TICK_INTERVAL" default:"5s"`
+
+	// the watchdog restarts the self tick chain when it has not advanced for
+	// TickStaleAfter, e.g. when the delayed tick message was lost. It must be
+	// at least twice TickInterval, serve fails to start otherwise
+	TickWatchdogInterval time.Duration `env:"TICK_WATCHDOG_INTERVAL" default:"1m"`
+	TickStaleAfter       time.Duration `env:"TICK_STALE_AFTER" default:"5m"`
+
+	// the tick metrics are exported over OTLP when set to otlp, which is
+	// configured by the standard OTEL_EXPORTER_OTLP_* variables
+	MetricsExporter string `env:"METRICS_EXPORTER" default:"none"`
 }) error {
 
+	shutdownMetrics, err := setupMetrics(ctx, config.MetricsExporter)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := shutdownMetrics(context.Background()); err != nil {
+			log.WithError(ctx, err).Error("failed to shut down metrics")
+		}
+	}()
+
 	db, err := config.OpenPostgresTransactor(ctx)
 	if err != nil {
 		return err
@@ -85,26 +120,63 @@ func runServe(ctx context.Context, config struct {
 		service.GRPCUnaryMiddleware(Version, false)...,
 	)))
 
-	if err := buildAndRegister(ctx, db, grpcServer); err != nil {
+	serviceSet, err := buildAndRegister(ctx, db, grpcServer)
+	if err != nil {
+		return err
+	}
+	if err := serviceSet.TriggerWorker.SetTickInterval(config.TickInterval); err != nil {
 		return err
 	}
 	reflection.Register(grpcServer)
 
-	return config.ListenAndServe(ctx, grpcServer)
+	runGroup := runner.NewGroup(runner.WithName("serve"))
+
+	runGroup.Add("grpc", func(ctx context.Context) error {
+		return config.ListenAndServe(ctx, grpcServer)
+	})
+
+	runGroup.Add("tickWatchdog", func(ctx context.Context) error {
+		return serviceSet.TriggerWorker.RunWatchdog(ctx, config.TickWatchdogInterval, config.TickStaleAfter)
+	})
+
+	return runGroup.Run(ctx)
 
 }
 
-func buildAndRegister(ctx context.Context, db sqrlx.Transactor, grpcServer grpc.ServiceRegistrar) error {
+func buildAndRegister(ctx context.Context, db sqrlx.Transactor, grpcServer grpc.ServiceRegistrar) (*service.Service, error) {
 	serviceSet, err := service.BuildService(db)
 	if err != nil {
-		return fmt.Errorf("failed to build service: %w", err)
+		return nil, fmt.Errorf("failed to build service: %w", err)
 	}
 	serviceSet.RegisterGRPC(grpcServer)
 	err = serviceSet.TriggerWorker.InitSelfTick(ctx)
 	if err != nil {
-		return fmt.Errorf("failed to init self tick: %w", err)
+		return nil, fmt.Errorf("failed to init self tick: %w", err)
 	}
 
-	return nil
+	return serviceSet, nil
 
 }
+
+// setupMetrics sets the global meter provider the service records against,
+// exporting to the named exporter. The returned func flushes and stops it.
+func setupMetrics(ctx context.Context, exporter string) (func(context.Context) error, error) {
+	switch exporter {
+	case "none":
+		return func(context.Context) error { return nil }, nil
+
+	case "otlp":
+		exp, err := otlpmetricgrpc.New(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create OTLP metric exporter: %w", err)
+		}
+		provider := sdkmetric.NewMeterProvider(
+			sdkmetric.WithReader(sdkmetric.NewPeriodicReader(exp)),
+		)
+		otel.SetMeterProvider(provider)
+		return provider.Shutdown, nil
+
+	default:
+		return nil, fmt.Errorf("unknown metrics exporter %q, expected none or otlp", exporter)
+	}
+}
@@ -0,0 +1,23 @@
+-- +goose Up
+
+CREATE TABLE calendar (
+  calendar_id char(22),
+  state jsonb NOT NULL,
+  CONSTRAINT calendar_pk PRIMARY KEY (calendar_id)
+);
+
+CREATE TABLE calendar_event (
+  id uuid,
+  calendar_id char(22) NOT NULL,
+  timestamp timestamptz NOT NULL,
+  sequence int NOT NULL,
+  data jsonb NOT NULL,
+  state jsonb NOT NULL,
+  CONSTRAINT calendar_event_pk PRIMARY KEY (id),
+  CONSTRAINT calendar_event_fk_state FOREIGN KEY (calendar_id) REFERENCES calendar(calendar_id)
+);
+
+-- +goose Down
+
+DROP TABLE calendar_event;
+DROP TABLE calendar;
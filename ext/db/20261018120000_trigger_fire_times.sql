@@ -0,0 +1,9 @@
+-- +goose Up
+
+CREATE INDEX trigger_next_fire_at_idx ON trigger ((state->'data'->'nextFireAt'));
+CREATE INDEX trigger_last_fired_at_idx ON trigger ((state->'data'->'lastFiredAt'));
+
+-- +goose Down
+
+DROP INDEX trigger_last_fired_at_idx;
+DROP INDEX trigger_next_fire_at_idx;
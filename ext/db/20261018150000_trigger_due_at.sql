@@ -0,0 +1,14 @@
+-- +goose Up
+
+ALTER TABLE trigger ADD COLUMN due_at timestamptz;
+
+-- triggers from before next fire times were kept are checked on the next tick,
+-- which fires or reschedules them
+UPDATE trigger SET due_at = '-infinity' WHERE state->>'status' = 'ACTIVE';
+
+CREATE INDEX trigger_due_at_idx ON trigger (due_at) WHERE due_at IS NOT NULL;
+
+-- +goose Down
+
+DROP INDEX trigger_due_at_idx;
+ALTER TABLE trigger DROP COLUMN due_at;
@@ -0,0 +1,13 @@
+-- +goose Up
+
+CREATE TABLE trigger_fire (
+  trigger_id char(22) NOT NULL,
+  trigger_time timestamptz NOT NULL,
+  fired_at timestamptz NOT NULL DEFAULT now(),
+  CONSTRAINT trigger_fire_pk PRIMARY KEY (trigger_id, trigger_time),
+  CONSTRAINT trigger_fire_fk_state FOREIGN KEY (trigger_id) REFERENCES trigger(trigger_id)
+);
+
+-- +goose Down
+
+DROP TABLE trigger_fire;
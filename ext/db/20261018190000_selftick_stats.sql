@@ -0,0 +1,11 @@
+-- +goose Up
+
+ALTER TABLE selftick
+  ADD COLUMN tick_duration_ms bigint NOT NULL DEFAULT 0,
+  ADD COLUMN fired_count integer NOT NULL DEFAULT 0;
+
+-- +goose Down
+
+ALTER TABLE selftick
+  DROP COLUMN fired_count,
+  DROP COLUMN tick_duration_ms;
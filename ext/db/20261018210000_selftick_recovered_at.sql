@@ -0,0 +1,7 @@
+-- +goose Up
+
+ALTER TABLE selftick ADD COLUMN recovered_at timestamptz;
+
+-- +goose Down
+
+ALTER TABLE selftick DROP COLUMN recovered_at;
@@ -0,0 +1,7 @@
+-- +goose Up
+
+CREATE INDEX trigger_fire_trigger_time_idx ON trigger_fire (trigger_time);
+
+-- +goose Down
+
+DROP INDEX trigger_fire_trigger_time_idx;
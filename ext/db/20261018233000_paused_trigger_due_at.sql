@@ -0,0 +1,9 @@
+-- +goose Up
+
+-- paused and faulted triggers are due at their notAfter time, to expire them
+UPDATE trigger SET due_at = (state->'data'->>'notAfter')::timestamptz
+WHERE state->>'status' IN ('PAUSED', 'FAULTED') AND state->'data'->>'notAfter' IS NOT NULL;
+
+-- +goose Down
+
+UPDATE trigger SET due_at = NULL WHERE state->>'status' IN ('PAUSED', 'FAULTED');
@@ -0,0 +1,28 @@
+-- +goose Up
+
+-- the jsonb expression indexes compare the timestamps as strings, so they do
+-- not order chronologically. Casting text to timestamptz is only STABLE as it
+-- depends on the session TimeZone, but the stored values always carry their
+-- offset, so the wrapper can be declared IMMUTABLE for use in an index.
+-- +goose StatementBegin
+CREATE OR REPLACE FUNCTION trigger_timestamptz(ts text)
+  RETURNS timestamptz AS $$
+  SELECT ts::timestamptz;
+$$ LANGUAGE sql IMMUTABLE PARALLEL SAFE;
+-- +goose StatementEnd
+
+DROP INDEX trigger_next_fire_at_idx;
+DROP INDEX trigger_last_fired_at_idx;
+
+CREATE INDEX trigger_next_fire_at_idx ON trigger (trigger_timestamptz(state->'data'->>'nextFireAt'));
+CREATE INDEX trigger_last_fired_at_idx ON trigger (trigger_timestamptz(state->'data'->>'lastFiredAt'));
+
+-- +goose Down
+
+DROP INDEX trigger_last_fired_at_idx;
+DROP INDEX trigger_next_fire_at_idx;
+
+CREATE INDEX trigger_next_fire_at_idx ON trigger ((state->'data'->'nextFireAt'));
+CREATE INDEX trigger_last_fired_at_idx ON trigger ((state->'data'->'lastFiredAt'));
+
+DROP FUNCTION trigger_timestamptz;
@@ -0,0 +1,27 @@
+-- +goose Up
+
+-- TriggerList sorts on the text of the fire times, so the indexes are on that
+-- same expression for the planner to use them. Fire times are stored as whole
+-- second UTC timestamps, which sort as text in time order. Filters on them go
+-- through jsonb_path_query_array, which no index serves.
+DROP INDEX trigger_next_fire_at_idx;
+DROP INDEX trigger_last_fired_at_idx;
+DROP FUNCTION trigger_timestamptz;
+
+CREATE INDEX trigger_next_fire_at_idx ON trigger ((state->'data'->>'nextFireAt'));
+CREATE INDEX trigger_last_fired_at_idx ON trigger ((state->'data'->>'lastFiredAt'));
+
+-- +goose Down
+
+DROP INDEX trigger_last_fired_at_idx;
+DROP INDEX trigger_next_fire_at_idx;
+
+-- +goose StatementBegin
+CREATE OR REPLACE FUNCTION trigger_timestamptz(ts text)
+  RETURNS timestamptz AS $$
+  SELECT ts::timestamptz;
+$$ LANGUAGE sql IMMUTABLE PARALLEL SAFE;
+-- +goose StatementEnd
+
+CREATE INDEX trigger_next_fire_at_idx ON trigger (trigger_timestamptz(state->'data'->>'nextFireAt'));
+CREATE INDEX trigger_last_fired_at_idx ON trigger (trigger_timestamptz(state->'data'->>'lastFiredAt'));
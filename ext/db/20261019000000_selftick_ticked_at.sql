@@ -0,0 +1,9 @@
+-- +goose Up
+
+-- when the chain last advanced, by the wall clock, which the watchdog checks
+-- so a chain catching up from an old last tick is not taken as lost
+ALTER TABLE selftick ADD COLUMN ticked_at timestamptz NOT NULL DEFAULT now();
+
+-- +goose Down
+
+ALTER TABLE selftick DROP COLUMN ticked_at;
@@ -12,6 +12,8 @@ import (
 	_ "github.com/pentops/j5/gen/j5/list/v1/list_j5pb"
 	messaging_j5pb "github.com/pentops/j5/gen/j5/messaging/v1/messaging_j5pb"
 	psm_j5pb "github.com/pentops/j5/gen/j5/state/v1/psm_j5pb"
+	any_j5t "github.com/pentops/j5/j5types/any_j5t"
+	date_j5t "github.com/pentops/j5/j5types/date_j5t"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
@@ -33,6 +35,10 @@ const (
 	TriggerStatus_TRIGGER_STATUS_ACTIVE      TriggerStatus = 1
 	TriggerStatus_TRIGGER_STATUS_PAUSED      TriggerStatus = 2
 	TriggerStatus_TRIGGER_STATUS_ARCHIVED    TriggerStatus = 3
+	TriggerStatus_TRIGGER_STATUS_COMPLETED   TriggerStatus = 4
+	TriggerStatus_TRIGGER_STATUS_EXPIRED     TriggerStatus = 5
+	// The stored schedule no longer parses, the trigger does not fire until it is updated and resumed
+	TriggerStatus_TRIGGER_STATUS_FAULTED TriggerStatus = 6
 )
 
 // Enum value maps for TriggerStatus.
@@ -42,12 +48,18 @@ var (
 		1: "TRIGGER_STATUS_ACTIVE",
 		2: "TRIGGER_STATUS_PAUSED",
 		3: "TRIGGER_STATUS_ARCHIVED",
+		4: "TRIGGER_STATUS_COMPLETED",
+		5: "TRIGGER_STATUS_EXPIRED",
+		6: "TRIGGER_STATUS_FAULTED",
 	}
 	TriggerStatus_value = map[string]int32{
 		"TRIGGER_STATUS_UNSPECIFIED": 0,
 		"TRIGGER_STATUS_ACTIVE":      1,
 		"TRIGGER_STATUS_PAUSED":      2,
 		"TRIGGER_STATUS_ARCHIVED":    3,
+		"TRIGGER_STATUS_COMPLETED":   4,
+		"TRIGGER_STATUS_EXPIRED":     5,
+		"TRIGGER_STATUS_FAULTED":     6,
 	}
 )
 
@@ -78,6 +90,219 @@ func (TriggerStatus) EnumDescriptor() ([]byte, []int) {
 	return file_o5_trigger_v1_trigger_j5s_proto_rawDescGZIP(), []int{0}
 }
 
+type CalendarStatus int32
+
+const (
+	CalendarStatus_CALENDAR_STATUS_UNSPECIFIED CalendarStatus = 0
+	CalendarStatus_CALENDAR_STATUS_ACTIVE      CalendarStatus = 1
+	CalendarStatus_CALENDAR_STATUS_ARCHIVED    CalendarStatus = 2
+)
+
+// Enum value maps for CalendarStatus.
+var (
+	CalendarStatus_name = map[int32]string{
+		0: "CALENDAR_STATUS_UNSPECIFIED",
+		1: "CALENDAR_STATUS_ACTIVE",
+		2: "CALENDAR_STATUS_ARCHIVED",
+	}
+	CalendarStatus_value = map[string]int32{
+		"CALENDAR_STATUS_UNSPECIFIED": 0,
+		"CALENDAR_STATUS_ACTIVE":      1,
+		"CALENDAR_STATUS_ARCHIVED":    2,
+	}
+)
+
+func (x CalendarStatus) Enum() *CalendarStatus {
+	p := new(CalendarStatus)
+	*p = x
+	return p
+}
+
+func (x CalendarStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CalendarStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_o5_trigger_v1_trigger_j5s_proto_enumTypes[1].Descriptor()
+}
+
+func (CalendarStatus) Type() protoreflect.EnumType {
+	return &file_o5_trigger_v1_trigger_j5s_proto_enumTypes[1]
+}
+
+func (x CalendarStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CalendarStatus.Descriptor instead.
+func (CalendarStatus) EnumDescriptor() ([]byte, []int) {
+	return file_o5_trigger_v1_trigger_j5s_proto_rawDescGZIP(), []int{1}
+}
+
+type CalendarRoll int32
+
+const (
+	// Treated as SKIP
+	CalendarRoll_CALENDAR_ROLL_UNSPECIFIED CalendarRoll = 0
+	// Fire times on excluded dates do not fire
+	CalendarRoll_CALENDAR_ROLL_SKIP CalendarRoll = 1
+	// Fire times on excluded dates fire at the same time on the next business day, merged with any fire already at that time
+	CalendarRoll_CALENDAR_ROLL_NEXT_BUSINESS_DAY CalendarRoll = 2
+)
+
+// Enum value maps for CalendarRoll.
+var (
+	CalendarRoll_name = map[int32]string{
+		0: "CALENDAR_ROLL_UNSPECIFIED",
+		1: "CALENDAR_ROLL_SKIP",
+		2: "CALENDAR_ROLL_NEXT_BUSINESS_DAY",
+	}
+	CalendarRoll_value = map[string]int32{
+		"CALENDAR_ROLL_UNSPECIFIED":       0,
+		"CALENDAR_ROLL_SKIP":              1,
+		"CALENDAR_ROLL_NEXT_BUSINESS_DAY": 2,
+	}
+)
+
+func (x CalendarRoll) Enum() *CalendarRoll {
+	p := new(CalendarRoll)
+	*p = x
+	return p
+}
+
+func (x CalendarRoll) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CalendarRoll) Descriptor() protoreflect.EnumDescriptor {
+	return file_o5_trigger_v1_trigger_j5s_proto_enumTypes[2].Descriptor()
+}
+
+func (CalendarRoll) Type() protoreflect.EnumType {
+	return &file_o5_trigger_v1_trigger_j5s_proto_enumTypes[2]
+}
+
+func (x CalendarRoll) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CalendarRoll.Descriptor instead.
+func (CalendarRoll) EnumDescriptor() ([]byte, []int) {
+	return file_o5_trigger_v1_trigger_j5s_proto_rawDescGZIP(), []int{2}
+}
+
+type DSTPolicy int32
+
+const (
+	// Treated as SHIFT
+	DSTPolicy_DST_POLICY_UNSPECIFIED DSTPolicy = 0
+	// Skipped times do not fire, repeated times fire the first time only unless the cron fires every hour
+	DSTPolicy_DST_POLICY_SKIP DSTPolicy = 1
+	// Skipped times fire shifted forward by the change, usually an hour, repeated times fire the first time only unless the cron fires every hour
+	DSTPolicy_DST_POLICY_SHIFT DSTPolicy = 2
+	// Skipped times fire shifted forward by the change, repeated times fire both times
+	DSTPolicy_DST_POLICY_FIRE_BOTH DSTPolicy = 3
+)
+
+// Enum value maps for DSTPolicy.
+var (
+	DSTPolicy_name = map[int32]string{
+		0: "DST_POLICY_UNSPECIFIED",
+		1: "DST_POLICY_SKIP",
+		2: "DST_POLICY_SHIFT",
+		3: "DST_POLICY_FIRE_BOTH",
+	}
+	DSTPolicy_value = map[string]int32{
+		"DST_POLICY_UNSPECIFIED": 0,
+		"DST_POLICY_SKIP":        1,
+		"DST_POLICY_SHIFT":       2,
+		"DST_POLICY_FIRE_BOTH":   3,
+	}
+)
+
+func (x DSTPolicy) Enum() *DSTPolicy {
+	p := new(DSTPolicy)
+	*p = x
+	return p
+}
+
+func (x DSTPolicy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DSTPolicy) Descriptor() protoreflect.EnumDescriptor {
+	return file_o5_trigger_v1_trigger_j5s_proto_enumTypes[3].Descriptor()
+}
+
+func (DSTPolicy) Type() protoreflect.EnumType {
+	return &file_o5_trigger_v1_trigger_j5s_proto_enumTypes[3]
+}
+
+func (x DSTPolicy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DSTPolicy.Descriptor instead.
+func (DSTPolicy) EnumDescriptor() ([]byte, []int) {
+	return file_o5_trigger_v1_trigger_j5s_proto_rawDescGZIP(), []int{3}
+}
+
+type CatchUpPolicy int32
+
+const (
+	// Treated as FIRE_ALL
+	CatchUpPolicy_CATCH_UP_POLICY_UNSPECIFIED CatchUpPolicy = 0
+	// Fire once for every missed time, oldest first
+	CatchUpPolicy_CATCH_UP_POLICY_FIRE_ALL CatchUpPolicy = 1
+	// Fire only the most recent missed time
+	CatchUpPolicy_CATCH_UP_POLICY_FIRE_LATEST CatchUpPolicy = 2
+	// Drop missed times, only fire on schedule
+	CatchUpPolicy_CATCH_UP_POLICY_SKIP CatchUpPolicy = 3
+)
+
+// Enum value maps for CatchUpPolicy.
+var (
+	CatchUpPolicy_name = map[int32]string{
+		0: "CATCH_UP_POLICY_UNSPECIFIED",
+		1: "CATCH_UP_POLICY_FIRE_ALL",
+		2: "CATCH_UP_POLICY_FIRE_LATEST",
+		3: "CATCH_UP_POLICY_SKIP",
+	}
+	CatchUpPolicy_value = map[string]int32{
+		"CATCH_UP_POLICY_UNSPECIFIED": 0,
+		"CATCH_UP_POLICY_FIRE_ALL":    1,
+		"CATCH_UP_POLICY_FIRE_LATEST": 2,
+		"CATCH_UP_POLICY_SKIP":        3,
+	}
+)
+
+func (x CatchUpPolicy) Enum() *CatchUpPolicy {
+	p := new(CatchUpPolicy)
+	*p = x
+	return p
+}
+
+func (x CatchUpPolicy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CatchUpPolicy) Descriptor() protoreflect.EnumDescriptor {
+	return file_o5_trigger_v1_trigger_j5s_proto_enumTypes[4].Descriptor()
+}
+
+func (CatchUpPolicy) Type() protoreflect.EnumType {
+	return &file_o5_trigger_v1_trigger_j5s_proto_enumTypes[4]
+}
+
+func (x CatchUpPolicy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CatchUpPolicy.Descriptor instead.
+func (CatchUpPolicy) EnumDescriptor() ([]byte, []int) {
+	return file_o5_trigger_v1_trigger_j5s_proto_rawDescGZIP(), []int{4}
+}
+
 type TriggerKeys struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -134,6 +359,25 @@ type TriggerData struct {
 	AppName         string                          `protobuf:"bytes,2,opt,name=app_name,json=appName,proto3" json:"app_name,omitempty"`
 	Cron            string                          `protobuf:"bytes,3,opt,name=cron,proto3" json:"cron,omitempty"`
 	RequestMetadata *messaging_j5pb.RequestMetadata `protobuf:"bytes,4,opt,name=request_metadata,json=requestMetadata,proto3" json:"request_metadata,omitempty"`
+	CatchUp         CatchUpPolicy                   `protobuf:"varint,5,opt,name=catch_up,json=catchUp,proto3,enum=o5.trigger.v1.CatchUpPolicy" json:"catch_up,omitempty"`
+	RunAt           *timestamppb.Timestamp          `protobuf:"bytes,6,opt,name=run_at,json=runAt,proto3,oneof" json:"run_at,omitempty"`
+	Interval        *Interval                       `protobuf:"bytes,7,opt,name=interval,proto3,oneof" json:"interval,omitempty"`
+	NotBefore       *timestamppb.Timestamp          `protobuf:"bytes,8,opt,name=not_before,json=notBefore,proto3,oneof" json:"not_before,omitempty"`
+	NotAfter        *timestamppb.Timestamp          `protobuf:"bytes,9,opt,name=not_after,json=notAfter,proto3,oneof" json:"not_after,omitempty"`
+	MaxFires        *int32                          `protobuf:"varint,10,opt,name=max_fires,json=maxFires,proto3,oneof" json:"max_fires,omitempty"`
+	FireCount       int32                           `protobuf:"varint,11,opt,name=fire_count,json=fireCount,proto3" json:"fire_count,omitempty"`
+	Timezone        string                          `protobuf:"bytes,12,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	DstPolicy       DSTPolicy                       `protobuf:"varint,13,opt,name=dst_policy,json=dstPolicy,proto3,enum=o5.trigger.v1.DSTPolicy" json:"dst_policy,omitempty"`
+	JitterSeconds   int32                           `protobuf:"varint,14,opt,name=jitter_seconds,json=jitterSeconds,proto3" json:"jitter_seconds,omitempty"`
+	CalendarId      *string                         `protobuf:"bytes,15,opt,name=calendar_id,json=calendarId,proto3,oneof" json:"calendar_id,omitempty"`
+	CalendarRoll    CalendarRoll                    `protobuf:"varint,16,opt,name=calendar_roll,json=calendarRoll,proto3,enum=o5.trigger.v1.CalendarRoll" json:"calendar_roll,omitempty"`
+	Payload         *any_j5t.Any                    `protobuf:"bytes,17,opt,name=payload,proto3,oneof" json:"payload,omitempty"`
+	// The scheduled time the trigger last fired for, manual fires are not
+	// included.
+	LastFiredAt *timestamppb.Timestamp `protobuf:"bytes,18,opt,name=last_fired_at,json=lastFiredAt,proto3,oneof" json:"last_fired_at,omitempty"`
+	// When the trigger is next scheduled to fire, unset while it is not active
+	// or when it will not fire again.
+	NextFireAt *timestamppb.Timestamp `protobuf:"bytes,19,opt,name=next_fire_at,json=nextFireAt,proto3,oneof" json:"next_fire_at,omitempty"`
 }
 
 func (x *TriggerData) Reset() {
@@ -196,6 +440,111 @@ func (x *TriggerData) GetRequestMetadata() *messaging_j5pb.RequestMetadata {
 	return nil
 }
 
+func (x *TriggerData) GetCatchUp() CatchUpPolicy {
+	if x != nil {
+		return x.CatchUp
+	}
+	return CatchUpPolicy_CATCH_UP_POLICY_UNSPECIFIED
+}
+
+func (x *TriggerData) GetRunAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RunAt
+	}
+	return nil
+}
+
+func (x *TriggerData) GetInterval() *Interval {
+	if x != nil {
+		return x.Interval
+	}
+	return nil
+}
+
+func (x *TriggerData) GetNotBefore() *timestamppb.Timestamp {
+	if x != nil {
+		return x.NotBefore
+	}
+	return nil
+}
+
+func (x *TriggerData) GetNotAfter() *timestamppb.Timestamp {
+	if x != nil {
+		return x.NotAfter
+	}
+	return nil
+}
+
+func (x *TriggerData) GetMaxFires() int32 {
+	if x != nil && x.MaxFires != nil {
+		return *x.MaxFires
+	}
+	return 0
+}
+
+func (x *TriggerData) GetFireCount() int32 {
+	if x != nil {
+		return x.FireCount
+	}
+	return 0
+}
+
+func (x *TriggerData) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *TriggerData) GetDstPolicy() DSTPolicy {
+	if x != nil {
+		return x.DstPolicy
+	}
+	return DSTPolicy_DST_POLICY_UNSPECIFIED
+}
+
+func (x *TriggerData) GetJitterSeconds() int32 {
+	if x != nil {
+		return x.JitterSeconds
+	}
+	return 0
+}
+
+func (x *TriggerData) GetCalendarId() string {
+	if x != nil && x.CalendarId != nil {
+		return *x.CalendarId
+	}
+	return ""
+}
+
+func (x *TriggerData) GetCalendarRoll() CalendarRoll {
+	if x != nil {
+		return x.CalendarRoll
+	}
+	return CalendarRoll_CALENDAR_ROLL_UNSPECIFIED
+}
+
+func (x *TriggerData) GetPayload() *any_j5t.Any {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+func (x *TriggerData) GetLastFiredAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastFiredAt
+	}
+	return nil
+}
+
+func (x *TriggerData) GetNextFireAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.NextFireAt
+	}
+	return nil
+}
+
 type TriggerState struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -281,6 +630,11 @@ type TriggerEventType struct {
 	//	*TriggerEventType_ManuallyTriggered_
 	//	*TriggerEventType_Triggered_
 	//	*TriggerEventType_Archived_
+	//	*TriggerEventType_Completed_
+	//	*TriggerEventType_Expired_
+	//	*TriggerEventType_Restored_
+	//	*TriggerEventType_Rescheduled_
+	//	*TriggerEventType_Faulted_
 	Type isTriggerEventType_Type `protobuf_oneof:"type"`
 }
 
@@ -372,6 +726,41 @@ func (x *TriggerEventType) GetArchived() *TriggerEventType_Archived {
 	return nil
 }
 
+func (x *TriggerEventType) GetCompleted() *TriggerEventType_Completed {
+	if x, ok := x.GetType().(*TriggerEventType_Completed_); ok {
+		return x.Completed
+	}
+	return nil
+}
+
+func (x *TriggerEventType) GetExpired() *TriggerEventType_Expired {
+	if x, ok := x.GetType().(*TriggerEventType_Expired_); ok {
+		return x.Expired
+	}
+	return nil
+}
+
+func (x *TriggerEventType) GetRestored() *TriggerEventType_Restored {
+	if x, ok := x.GetType().(*TriggerEventType_Restored_); ok {
+		return x.Restored
+	}
+	return nil
+}
+
+func (x *TriggerEventType) GetRescheduled() *TriggerEventType_Rescheduled {
+	if x, ok := x.GetType().(*TriggerEventType_Rescheduled_); ok {
+		return x.Rescheduled
+	}
+	return nil
+}
+
+func (x *TriggerEventType) GetFaulted() *TriggerEventType_Faulted {
+	if x, ok := x.GetType().(*TriggerEventType_Faulted_); ok {
+		return x.Faulted
+	}
+	return nil
+}
+
 type isTriggerEventType_Type interface {
 	isTriggerEventType_Type()
 }
@@ -404,6 +793,26 @@ type TriggerEventType_Archived_ struct {
 	Archived *TriggerEventType_Archived `protobuf:"bytes,7,opt,name=archived,proto3,oneof"`
 }
 
+type TriggerEventType_Completed_ struct {
+	Completed *TriggerEventType_Completed `protobuf:"bytes,8,opt,name=completed,proto3,oneof"`
+}
+
+type TriggerEventType_Expired_ struct {
+	Expired *TriggerEventType_Expired `protobuf:"bytes,9,opt,name=expired,proto3,oneof"`
+}
+
+type TriggerEventType_Restored_ struct {
+	Restored *TriggerEventType_Restored `protobuf:"bytes,10,opt,name=restored,proto3,oneof"`
+}
+
+type TriggerEventType_Rescheduled_ struct {
+	Rescheduled *TriggerEventType_Rescheduled `protobuf:"bytes,11,opt,name=rescheduled,proto3,oneof"`
+}
+
+type TriggerEventType_Faulted_ struct {
+	Faulted *TriggerEventType_Faulted `protobuf:"bytes,12,opt,name=faulted,proto3,oneof"`
+}
+
 func (*TriggerEventType_Created_) isTriggerEventType_Type() {}
 
 func (*TriggerEventType_Updated_) isTriggerEventType_Type() {}
@@ -418,6 +827,16 @@ func (*TriggerEventType_Triggered_) isTriggerEventType_Type() {}
 
 func (*TriggerEventType_Archived_) isTriggerEventType_Type() {}
 
+func (*TriggerEventType_Completed_) isTriggerEventType_Type() {}
+
+func (*TriggerEventType_Expired_) isTriggerEventType_Type() {}
+
+func (*TriggerEventType_Restored_) isTriggerEventType_Type() {}
+
+func (*TriggerEventType_Rescheduled_) isTriggerEventType_Type() {}
+
+func (*TriggerEventType_Faulted_) isTriggerEventType_Type() {}
+
 type TriggerEvent struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -481,21 +900,16 @@ func (x *TriggerEvent) GetEvent() *TriggerEventType {
 	return nil
 }
 
-type ActionType struct {
+type CalendarKeys struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Types that are assignable to Type:
-	//
-	//	*ActionType_Create_
-	//	*ActionType_Update_
-	//	*ActionType_Archive_
-	Type isActionType_Type `protobuf_oneof:"type"`
+	CalendarId string `protobuf:"bytes,1,opt,name=calendar_id,json=calendarId,proto3" json:"calendar_id,omitempty"`
 }
 
-func (x *ActionType) Reset() {
-	*x = ActionType{}
+func (x *CalendarKeys) Reset() {
+	*x = CalendarKeys{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_o5_trigger_v1_trigger_j5s_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -503,13 +917,13 @@ func (x *ActionType) Reset() {
 	}
 }
 
-func (x *ActionType) String() string {
+func (x *CalendarKeys) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ActionType) ProtoMessage() {}
+func (*CalendarKeys) ProtoMessage() {}
 
-func (x *ActionType) ProtoReflect() protoreflect.Message {
+func (x *CalendarKeys) ProtoReflect() protoreflect.Message {
 	mi := &file_o5_trigger_v1_trigger_j5s_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -521,90 +935,1954 @@ func (x *ActionType) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ActionType.ProtoReflect.Descriptor instead.
-func (*ActionType) Descriptor() ([]byte, []int) {
+// Deprecated: Use CalendarKeys.ProtoReflect.Descriptor instead.
+func (*CalendarKeys) Descriptor() ([]byte, []int) {
 	return file_o5_trigger_v1_trigger_j5s_proto_rawDescGZIP(), []int{5}
 }
 
-func (m *ActionType) GetType() isActionType_Type {
-	if m != nil {
-		return m.Type
+func (x *CalendarKeys) GetCalendarId() string {
+	if x != nil {
+		return x.CalendarId
 	}
-	return nil
+	return ""
 }
 
-func (x *ActionType) GetCreate() *ActionType_Create {
-	if x, ok := x.GetType().(*ActionType_Create_); ok {
-		return x.Create
-	}
-	return nil
-}
+type CalendarData struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-func (x *ActionType) GetUpdate() *ActionType_Update {
-	if x, ok := x.GetType().(*ActionType_Update_); ok {
-		return x.Update
-	}
-	return nil
+	Name            string           `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	ExcludedDates   []*date_j5t.Date `protobuf:"bytes,2,rep,name=excluded_dates,json=excludedDates,proto3" json:"excluded_dates,omitempty"`
+	ExcludeWeekends bool             `protobuf:"varint,3,opt,name=exclude_weekends,json=excludeWeekends,proto3" json:"exclude_weekends,omitempty"`
 }
 
-func (x *ActionType) GetArchive() *ActionType_Archive {
-	if x, ok := x.GetType().(*ActionType_Archive_); ok {
-		return x.Archive
+func (x *CalendarData) Reset() {
+	*x = CalendarData{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_o5_trigger_v1_trigger_j5s_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return nil
 }
 
-type isActionType_Type interface {
-	isActionType_Type()
+func (x *CalendarData) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-type ActionType_Create_ struct {
-	Create *ActionType_Create `protobuf:"bytes,1,opt,name=create,proto3,oneof"`
-}
+func (*CalendarData) ProtoMessage() {}
 
-type ActionType_Update_ struct {
-	Update *ActionType_Update `protobuf:"bytes,2,opt,name=update,proto3,oneof"`
+func (x *CalendarData) ProtoReflect() protoreflect.Message {
+	mi := &file_o5_trigger_v1_trigger_j5s_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CalendarData.ProtoReflect.Descriptor instead.
+func (*CalendarData) Descriptor() ([]byte, []int) {
+	return file_o5_trigger_v1_trigger_j5s_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *CalendarData) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CalendarData) GetExcludedDates() []*date_j5t.Date {
+	if x != nil {
+		return x.ExcludedDates
+	}
+	return nil
+}
+
+func (x *CalendarData) GetExcludeWeekends() bool {
+	if x != nil {
+		return x.ExcludeWeekends
+	}
+	return false
+}
+
+type CalendarState struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Metadata *psm_j5pb.StateMetadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	Keys     *CalendarKeys           `protobuf:"bytes,2,opt,name=keys,proto3" json:"keys,omitempty"`
+	Data     *CalendarData           `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	Status   CalendarStatus          `protobuf:"varint,4,opt,name=status,proto3,enum=o5.trigger.v1.CalendarStatus" json:"status,omitempty"`
+}
+
+func (x *CalendarState) Reset() {
+	*x = CalendarState{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_o5_trigger_v1_trigger_j5s_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CalendarState) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CalendarState) ProtoMessage() {}
+
+func (x *CalendarState) ProtoReflect() protoreflect.Message {
+	mi := &file_o5_trigger_v1_trigger_j5s_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CalendarState.ProtoReflect.Descriptor instead.
+func (*CalendarState) Descriptor() ([]byte, []int) {
+	return file_o5_trigger_v1_trigger_j5s_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *CalendarState) GetMetadata() *psm_j5pb.StateMetadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *CalendarState) GetKeys() *CalendarKeys {
+	if x != nil {
+		return x.Keys
+	}
+	return nil
+}
+
+func (x *CalendarState) GetData() *CalendarData {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *CalendarState) GetStatus() CalendarStatus {
+	if x != nil {
+		return x.Status
+	}
+	return CalendarStatus_CALENDAR_STATUS_UNSPECIFIED
+}
+
+type CalendarEventType struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Type:
+	//
+	//	*CalendarEventType_Created_
+	//	*CalendarEventType_Updated_
+	//	*CalendarEventType_Archived_
+	Type isCalendarEventType_Type `protobuf_oneof:"type"`
+}
+
+func (x *CalendarEventType) Reset() {
+	*x = CalendarEventType{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_o5_trigger_v1_trigger_j5s_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CalendarEventType) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CalendarEventType) ProtoMessage() {}
+
+func (x *CalendarEventType) ProtoReflect() protoreflect.Message {
+	mi := &file_o5_trigger_v1_trigger_j5s_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CalendarEventType.ProtoReflect.Descriptor instead.
+func (*CalendarEventType) Descriptor() ([]byte, []int) {
+	return file_o5_trigger_v1_trigger_j5s_proto_rawDescGZIP(), []int{8}
+}
+
+func (m *CalendarEventType) GetType() isCalendarEventType_Type {
+	if m != nil {
+		return m.Type
+	}
+	return nil
+}
+
+func (x *CalendarEventType) GetCreated() *CalendarEventType_Created {
+	if x, ok := x.GetType().(*CalendarEventType_Created_); ok {
+		return x.Created
+	}
+	return nil
+}
+
+func (x *CalendarEventType) GetUpdated() *CalendarEventType_Updated {
+	if x, ok := x.GetType().(*CalendarEventType_Updated_); ok {
+		return x.Updated
+	}
+	return nil
+}
+
+func (x *CalendarEventType) GetArchived() *CalendarEventType_Archived {
+	if x, ok := x.GetType().(*CalendarEventType_Archived_); ok {
+		return x.Archived
+	}
+	return nil
+}
+
+type isCalendarEventType_Type interface {
+	isCalendarEventType_Type()
+}
+
+type CalendarEventType_Created_ struct {
+	Created *CalendarEventType_Created `protobuf:"bytes,1,opt,name=created,proto3,oneof"`
+}
+
+type CalendarEventType_Updated_ struct {
+	Updated *CalendarEventType_Updated `protobuf:"bytes,2,opt,name=updated,proto3,oneof"`
+}
+
+type CalendarEventType_Archived_ struct {
+	Archived *CalendarEventType_Archived `protobuf:"bytes,3,opt,name=archived,proto3,oneof"`
+}
+
+func (*CalendarEventType_Created_) isCalendarEventType_Type() {}
+
+func (*CalendarEventType_Updated_) isCalendarEventType_Type() {}
+
+func (*CalendarEventType_Archived_) isCalendarEventType_Type() {}
+
+type CalendarEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Metadata *psm_j5pb.EventMetadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	Keys     *CalendarKeys           `protobuf:"bytes,2,opt,name=keys,proto3" json:"keys,omitempty"`
+	Event    *CalendarEventType      `protobuf:"bytes,3,opt,name=event,proto3" json:"event,omitempty"`
+}
+
+func (x *CalendarEvent) Reset() {
+	*x = CalendarEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_o5_trigger_v1_trigger_j5s_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CalendarEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CalendarEvent) ProtoMessage() {}
+
+func (x *CalendarEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_o5_trigger_v1_trigger_j5s_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CalendarEvent.ProtoReflect.Descriptor instead.
+func (*CalendarEvent) Descriptor() ([]byte, []int) {
+	return file_o5_trigger_v1_trigger_j5s_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *CalendarEvent) GetMetadata() *psm_j5pb.EventMetadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *CalendarEvent) GetKeys() *CalendarKeys {
+	if x != nil {
+		return x.Keys
+	}
+	return nil
+}
+
+func (x *CalendarEvent) GetEvent() *CalendarEventType {
+	if x != nil {
+		return x.Event
+	}
+	return nil
+}
+
+type ActionType struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Type:
+	//
+	//	*ActionType_Create_
+	//	*ActionType_Update_
+	//	*ActionType_Archive_
+	//	*ActionType_CreateCalendar_
+	//	*ActionType_UpdateCalendar_
+	//	*ActionType_ArchiveCalendar_
+	//	*ActionType_Sync_
+	//	*ActionType_Pause_
+	//	*ActionType_Resume_
+	//	*ActionType_ManuallyTrigger_
+	//	*ActionType_Restore_
+	Type isActionType_Type `protobuf_oneof:"type"`
+}
+
+func (x *ActionType) Reset() {
+	*x = ActionType{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_o5_trigger_v1_trigger_j5s_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ActionType) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActionType) ProtoMessage() {}
+
+func (x *ActionType) ProtoReflect() protoreflect.Message {
+	mi := &file_o5_trigger_v1_trigger_j5s_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActionType.ProtoReflect.Descriptor instead.
+func (*ActionType) Descriptor() ([]byte, []int) {
+	return file_o5_trigger_v1_trigger_j5s_proto_rawDescGZIP(), []int{10}
+}
+
+func (m *ActionType) GetType() isActionType_Type {
+	if m != nil {
+		return m.Type
+	}
+	return nil
+}
+
+func (x *ActionType) GetCreate() *ActionType_Create {
+	if x, ok := x.GetType().(*ActionType_Create_); ok {
+		return x.Create
+	}
+	return nil
+}
+
+func (x *ActionType) GetUpdate() *ActionType_Update {
+	if x, ok := x.GetType().(*ActionType_Update_); ok {
+		return x.Update
+	}
+	return nil
+}
+
+func (x *ActionType) GetArchive() *ActionType_Archive {
+	if x, ok := x.GetType().(*ActionType_Archive_); ok {
+		return x.Archive
+	}
+	return nil
+}
+
+func (x *ActionType) GetCreateCalendar() *ActionType_CreateCalendar {
+	if x, ok := x.GetType().(*ActionType_CreateCalendar_); ok {
+		return x.CreateCalendar
+	}
+	return nil
+}
+
+func (x *ActionType) GetUpdateCalendar() *ActionType_UpdateCalendar {
+	if x, ok := x.GetType().(*ActionType_UpdateCalendar_); ok {
+		return x.UpdateCalendar
+	}
+	return nil
+}
+
+func (x *ActionType) GetArchiveCalendar() *ActionType_ArchiveCalendar {
+	if x, ok := x.GetType().(*ActionType_ArchiveCalendar_); ok {
+		return x.ArchiveCalendar
+	}
+	return nil
+}
+
+func (x *ActionType) GetSync() *ActionType_Sync {
+	if x, ok := x.GetType().(*ActionType_Sync_); ok {
+		return x.Sync
+	}
+	return nil
+}
+
+func (x *ActionType) GetPause() *ActionType_Pause {
+	if x, ok := x.GetType().(*ActionType_Pause_); ok {
+		return x.Pause
+	}
+	return nil
+}
+
+func (x *ActionType) GetResume() *ActionType_Resume {
+	if x, ok := x.GetType().(*ActionType_Resume_); ok {
+		return x.Resume
+	}
+	return nil
+}
+
+func (x *ActionType) GetManuallyTrigger() *ActionType_ManuallyTrigger {
+	if x, ok := x.GetType().(*ActionType_ManuallyTrigger_); ok {
+		return x.ManuallyTrigger
+	}
+	return nil
+}
+
+func (x *ActionType) GetRestore() *ActionType_Restore {
+	if x, ok := x.GetType().(*ActionType_Restore_); ok {
+		return x.Restore
+	}
+	return nil
+}
+
+type isActionType_Type interface {
+	isActionType_Type()
+}
+
+type ActionType_Create_ struct {
+	Create *ActionType_Create `protobuf:"bytes,1,opt,name=create,proto3,oneof"`
+}
+
+type ActionType_Update_ struct {
+	Update *ActionType_Update `protobuf:"bytes,2,opt,name=update,proto3,oneof"`
+}
+
+type ActionType_Archive_ struct {
+	Archive *ActionType_Archive `protobuf:"bytes,3,opt,name=archive,proto3,oneof"`
+}
+
+type ActionType_CreateCalendar_ struct {
+	CreateCalendar *ActionType_CreateCalendar `protobuf:"bytes,4,opt,name=create_calendar,json=createCalendar,proto3,oneof"`
+}
+
+type ActionType_UpdateCalendar_ struct {
+	UpdateCalendar *ActionType_UpdateCalendar `protobuf:"bytes,5,opt,name=update_calendar,json=updateCalendar,proto3,oneof"`
+}
+
+type ActionType_ArchiveCalendar_ struct {
+	ArchiveCalendar *ActionType_ArchiveCalendar `protobuf:"bytes,6,opt,name=archive_calendar,json=archiveCalendar,proto3,oneof"`
+}
+
+type ActionType_Sync_ struct {
+	// Reconciles the triggers of the app with the declared set, creating
+	// missing triggers, updating changed ones and archiving ones no longer
+	// declared.
+	Sync *ActionType_Sync `protobuf:"bytes,7,opt,name=sync,proto3,oneof"`
+}
+
+type ActionType_Pause_ struct {
+	Pause *ActionType_Pause `protobuf:"bytes,8,opt,name=pause,proto3,oneof"`
+}
+
+type ActionType_Resume_ struct {
+	Resume *ActionType_Resume `protobuf:"bytes,9,opt,name=resume,proto3,oneof"`
+}
+
+type ActionType_ManuallyTrigger_ struct {
+	ManuallyTrigger *ActionType_ManuallyTrigger `protobuf:"bytes,10,opt,name=manually_trigger,json=manuallyTrigger,proto3,oneof"`
+}
+
+type ActionType_Restore_ struct {
+	Restore *ActionType_Restore `protobuf:"bytes,11,opt,name=restore,proto3,oneof"`
+}
+
+func (*ActionType_Create_) isActionType_Type() {}
+
+func (*ActionType_Update_) isActionType_Type() {}
+
+func (*ActionType_Archive_) isActionType_Type() {}
+
+func (*ActionType_CreateCalendar_) isActionType_Type() {}
+
+func (*ActionType_UpdateCalendar_) isActionType_Type() {}
+
+func (*ActionType_ArchiveCalendar_) isActionType_Type() {}
+
+func (*ActionType_Sync_) isActionType_Type() {}
+
+func (*ActionType_Pause_) isActionType_Type() {}
+
+func (*ActionType_Resume_) isActionType_Type() {}
+
+func (*ActionType_ManuallyTrigger_) isActionType_Type() {}
+
+func (*ActionType_Restore_) isActionType_Type() {}
+
+// A trigger declared by an app in a sync, identified within the app by its
+// name.
+type TriggerDefinition struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TriggerName   string                 `protobuf:"bytes,1,opt,name=trigger_name,json=triggerName,proto3" json:"trigger_name,omitempty"`
+	Cron          string                 `protobuf:"bytes,2,opt,name=cron,proto3" json:"cron,omitempty"`
+	CatchUp       CatchUpPolicy          `protobuf:"varint,3,opt,name=catch_up,json=catchUp,proto3,enum=o5.trigger.v1.CatchUpPolicy" json:"catch_up,omitempty"`
+	RunAt         *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=run_at,json=runAt,proto3,oneof" json:"run_at,omitempty"`
+	Interval      *Interval              `protobuf:"bytes,5,opt,name=interval,proto3,oneof" json:"interval,omitempty"`
+	NotBefore     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=not_before,json=notBefore,proto3,oneof" json:"not_before,omitempty"`
+	NotAfter      *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=not_after,json=notAfter,proto3,oneof" json:"not_after,omitempty"`
+	MaxFires      *int32                 `protobuf:"varint,8,opt,name=max_fires,json=maxFires,proto3,oneof" json:"max_fires,omitempty"`
+	Timezone      string                 `protobuf:"bytes,9,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	DstPolicy     DSTPolicy              `protobuf:"varint,10,opt,name=dst_policy,json=dstPolicy,proto3,enum=o5.trigger.v1.DSTPolicy" json:"dst_policy,omitempty"`
+	JitterSeconds int32                  `protobuf:"varint,11,opt,name=jitter_seconds,json=jitterSeconds,proto3" json:"jitter_seconds,omitempty"`
+	CalendarId    *string                `protobuf:"bytes,12,opt,name=calendar_id,json=calendarId,proto3,oneof" json:"calendar_id,omitempty"`
+	CalendarRoll  CalendarRoll           `protobuf:"varint,13,opt,name=calendar_roll,json=calendarRoll,proto3,enum=o5.trigger.v1.CalendarRoll" json:"calendar_roll,omitempty"`
+	Payload       *any_j5t.Any           `protobuf:"bytes,14,opt,name=payload,proto3,oneof" json:"payload,omitempty"`
+}
+
+func (x *TriggerDefinition) Reset() {
+	*x = TriggerDefinition{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_o5_trigger_v1_trigger_j5s_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TriggerDefinition) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TriggerDefinition) ProtoMessage() {}
+
+func (x *TriggerDefinition) ProtoReflect() protoreflect.Message {
+	mi := &file_o5_trigger_v1_trigger_j5s_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TriggerDefinition.ProtoReflect.Descriptor instead.
+func (*TriggerDefinition) Descriptor() ([]byte, []int) {
+	return file_o5_trigger_v1_trigger_j5s_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *TriggerDefinition) GetTriggerName() string {
+	if x != nil {
+		return x.TriggerName
+	}
+	return ""
+}
+
+func (x *TriggerDefinition) GetCron() string {
+	if x != nil {
+		return x.Cron
+	}
+	return ""
+}
+
+func (x *TriggerDefinition) GetCatchUp() CatchUpPolicy {
+	if x != nil {
+		return x.CatchUp
+	}
+	return CatchUpPolicy_CATCH_UP_POLICY_UNSPECIFIED
+}
+
+func (x *TriggerDefinition) GetRunAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RunAt
+	}
+	return nil
+}
+
+func (x *TriggerDefinition) GetInterval() *Interval {
+	if x != nil {
+		return x.Interval
+	}
+	return nil
+}
+
+func (x *TriggerDefinition) GetNotBefore() *timestamppb.Timestamp {
+	if x != nil {
+		return x.NotBefore
+	}
+	return nil
+}
+
+func (x *TriggerDefinition) GetNotAfter() *timestamppb.Timestamp {
+	if x != nil {
+		return x.NotAfter
+	}
+	return nil
+}
+
+func (x *TriggerDefinition) GetMaxFires() int32 {
+	if x != nil && x.MaxFires != nil {
+		return *x.MaxFires
+	}
+	return 0
+}
+
+func (x *TriggerDefinition) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *TriggerDefinition) GetDstPolicy() DSTPolicy {
+	if x != nil {
+		return x.DstPolicy
+	}
+	return DSTPolicy_DST_POLICY_UNSPECIFIED
+}
+
+func (x *TriggerDefinition) GetJitterSeconds() int32 {
+	if x != nil {
+		return x.JitterSeconds
+	}
+	return 0
+}
+
+func (x *TriggerDefinition) GetCalendarId() string {
+	if x != nil && x.CalendarId != nil {
+		return *x.CalendarId
+	}
+	return ""
+}
+
+func (x *TriggerDefinition) GetCalendarRoll() CalendarRoll {
+	if x != nil {
+		return x.CalendarRoll
+	}
+	return CalendarRoll_CALENDAR_ROLL_UNSPECIFIED
+}
+
+func (x *TriggerDefinition) GetPayload() *any_j5t.Any {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+// Fires every period, counting from the anchor time, e.g. every 90 minutes
+// starting at 08:15.
+type Interval struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Seconds between fires, a multiple of 5 to match the tick cadence and at
+	// most 366 days
+	EverySeconds int64 `protobuf:"varint,1,opt,name=every_seconds,json=everySeconds,proto3" json:"every_seconds,omitempty"`
+	// The first fire time, later fires are anchor + n * everySeconds
+	Anchor *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=anchor,proto3" json:"anchor,omitempty"`
+}
+
+func (x *Interval) Reset() {
+	*x = Interval{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_o5_trigger_v1_trigger_j5s_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Interval) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Interval) ProtoMessage() {}
+
+func (x *Interval) ProtoReflect() protoreflect.Message {
+	mi := &file_o5_trigger_v1_trigger_j5s_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Interval.ProtoReflect.Descriptor instead.
+func (*Interval) Descriptor() ([]byte, []int) {
+	return file_o5_trigger_v1_trigger_j5s_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *Interval) GetEverySeconds() int64 {
+	if x != nil {
+		return x.EverySeconds
+	}
+	return 0
+}
+
+func (x *Interval) GetAnchor() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Anchor
+	}
+	return nil
+}
+
+// Trigger has been requested
+type TriggerEventType_Created struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TriggerName     string                          `protobuf:"bytes,1,opt,name=trigger_name,json=triggerName,proto3" json:"trigger_name,omitempty"`
+	AppName         string                          `protobuf:"bytes,2,opt,name=app_name,json=appName,proto3" json:"app_name,omitempty"`
+	Cron            string                          `protobuf:"bytes,3,opt,name=cron,proto3" json:"cron,omitempty"`
+	RequestMetadata *messaging_j5pb.RequestMetadata `protobuf:"bytes,4,opt,name=request_metadata,json=requestMetadata,proto3" json:"request_metadata,omitempty"`
+	CatchUp         CatchUpPolicy                   `protobuf:"varint,5,opt,name=catch_up,json=catchUp,proto3,enum=o5.trigger.v1.CatchUpPolicy" json:"catch_up,omitempty"`
+	RunAt           *timestamppb.Timestamp          `protobuf:"bytes,6,opt,name=run_at,json=runAt,proto3,oneof" json:"run_at,omitempty"`
+	Interval        *Interval                       `protobuf:"bytes,7,opt,name=interval,proto3,oneof" json:"interval,omitempty"`
+	NotBefore       *timestamppb.Timestamp          `protobuf:"bytes,8,opt,name=not_before,json=notBefore,proto3,oneof" json:"not_before,omitempty"`
+	NotAfter        *timestamppb.Timestamp          `protobuf:"bytes,9,opt,name=not_after,json=notAfter,proto3,oneof" json:"not_after,omitempty"`
+	MaxFires        *int32                          `protobuf:"varint,10,opt,name=max_fires,json=maxFires,proto3,oneof" json:"max_fires,omitempty"`
+	Timezone        string                          `protobuf:"bytes,11,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	DstPolicy       DSTPolicy                       `protobuf:"varint,12,opt,name=dst_policy,json=dstPolicy,proto3,enum=o5.trigger.v1.DSTPolicy" json:"dst_policy,omitempty"`
+	JitterSeconds   int32                           `protobuf:"varint,13,opt,name=jitter_seconds,json=jitterSeconds,proto3" json:"jitter_seconds,omitempty"`
+	CalendarId      *string                         `protobuf:"bytes,14,opt,name=calendar_id,json=calendarId,proto3,oneof" json:"calendar_id,omitempty"`
+	CalendarRoll    CalendarRoll                    `protobuf:"varint,15,opt,name=calendar_roll,json=calendarRoll,proto3,enum=o5.trigger.v1.CalendarRoll" json:"calendar_roll,omitempty"`
+	Payload         *any_j5t.Any                    `protobuf:"bytes,16,opt,name=payload,proto3,oneof" json:"payload,omitempty"`
+	// When the trigger is next scheduled to fire after the event
+	NextFireAt *timestamppb.Timestamp `protobuf:"bytes,17,opt,name=next_fire_at,json=nextFireAt,proto3,oneof" json:"next_fire_at,omitempty"`
+}
+
+func (x *TriggerEventType_Created) Reset() {
+	*x = TriggerEventType_Created{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_o5_trigger_v1_trigger_j5s_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TriggerEventType_Created) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TriggerEventType_Created) ProtoMessage() {}
+
+func (x *TriggerEventType_Created) ProtoReflect() protoreflect.Message {
+	mi := &file_o5_trigger_v1_trigger_j5s_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TriggerEventType_Created.ProtoReflect.Descriptor instead.
+func (*TriggerEventType_Created) Descriptor() ([]byte, []int) {
+	return file_o5_trigger_v1_trigger_j5s_proto_rawDescGZIP(), []int{3, 0}
+}
+
+func (x *TriggerEventType_Created) GetTriggerName() string {
+	if x != nil {
+		return x.TriggerName
+	}
+	return ""
+}
+
+func (x *TriggerEventType_Created) GetAppName() string {
+	if x != nil {
+		return x.AppName
+	}
+	return ""
+}
+
+func (x *TriggerEventType_Created) GetCron() string {
+	if x != nil {
+		return x.Cron
+	}
+	return ""
+}
+
+func (x *TriggerEventType_Created) GetRequestMetadata() *messaging_j5pb.RequestMetadata {
+	if x != nil {
+		return x.RequestMetadata
+	}
+	return nil
+}
+
+func (x *TriggerEventType_Created) GetCatchUp() CatchUpPolicy {
+	if x != nil {
+		return x.CatchUp
+	}
+	return CatchUpPolicy_CATCH_UP_POLICY_UNSPECIFIED
+}
+
+func (x *TriggerEventType_Created) GetRunAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RunAt
+	}
+	return nil
+}
+
+func (x *TriggerEventType_Created) GetInterval() *Interval {
+	if x != nil {
+		return x.Interval
+	}
+	return nil
+}
+
+func (x *TriggerEventType_Created) GetNotBefore() *timestamppb.Timestamp {
+	if x != nil {
+		return x.NotBefore
+	}
+	return nil
+}
+
+func (x *TriggerEventType_Created) GetNotAfter() *timestamppb.Timestamp {
+	if x != nil {
+		return x.NotAfter
+	}
+	return nil
+}
+
+func (x *TriggerEventType_Created) GetMaxFires() int32 {
+	if x != nil && x.MaxFires != nil {
+		return *x.MaxFires
+	}
+	return 0
+}
+
+func (x *TriggerEventType_Created) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *TriggerEventType_Created) GetDstPolicy() DSTPolicy {
+	if x != nil {
+		return x.DstPolicy
+	}
+	return DSTPolicy_DST_POLICY_UNSPECIFIED
+}
+
+func (x *TriggerEventType_Created) GetJitterSeconds() int32 {
+	if x != nil {
+		return x.JitterSeconds
+	}
+	return 0
+}
+
+func (x *TriggerEventType_Created) GetCalendarId() string {
+	if x != nil && x.CalendarId != nil {
+		return *x.CalendarId
+	}
+	return ""
+}
+
+func (x *TriggerEventType_Created) GetCalendarRoll() CalendarRoll {
+	if x != nil {
+		return x.CalendarRoll
+	}
+	return CalendarRoll_CALENDAR_ROLL_UNSPECIFIED
+}
+
+func (x *TriggerEventType_Created) GetPayload() *any_j5t.Any {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+func (x *TriggerEventType_Created) GetNextFireAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.NextFireAt
+	}
+	return nil
+}
+
+// Trigger has been modified
+type TriggerEventType_Updated struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TriggerName     string                          `protobuf:"bytes,1,opt,name=trigger_name,json=triggerName,proto3" json:"trigger_name,omitempty"`
+	AppName         string                          `protobuf:"bytes,2,opt,name=app_name,json=appName,proto3" json:"app_name,omitempty"`
+	Cron            string                          `protobuf:"bytes,3,opt,name=cron,proto3" json:"cron,omitempty"`
+	RequestMetadata *messaging_j5pb.RequestMetadata `protobuf:"bytes,4,opt,name=request_metadata,json=requestMetadata,proto3" json:"request_metadata,omitempty"`
+	CatchUp         CatchUpPolicy                   `protobuf:"varint,5,opt,name=catch_up,json=catchUp,proto3,enum=o5.trigger.v1.CatchUpPolicy" json:"catch_up,omitempty"`
+	RunAt           *timestamppb.Timestamp          `protobuf:"bytes,6,opt,name=run_at,json=runAt,proto3,oneof" json:"run_at,omitempty"`
+	Interval        *Interval                       `protobuf:"bytes,7,opt,name=interval,proto3,oneof" json:"interval,omitempty"`
+	NotBefore       *timestamppb.Timestamp          `protobuf:"bytes,8,opt,name=not_before,json=notBefore,proto3,oneof" json:"not_before,omitempty"`
+	NotAfter        *timestamppb.Timestamp          `protobuf:"bytes,9,opt,name=not_after,json=notAfter,proto3,oneof" json:"not_after,omitempty"`
+	MaxFires        *int32                          `protobuf:"varint,10,opt,name=max_fires,json=maxFires,proto3,oneof" json:"max_fires,omitempty"`
+	Timezone        string                          `protobuf:"bytes,11,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	DstPolicy       DSTPolicy                       `protobuf:"varint,12,opt,name=dst_policy,json=dstPolicy,proto3,enum=o5.trigger.v1.DSTPolicy" json:"dst_policy,omitempty"`
+	JitterSeconds   int32                           `protobuf:"varint,13,opt,name=jitter_seconds,json=jitterSeconds,proto3" json:"jitter_seconds,omitempty"`
+	CalendarId      *string                         `protobuf:"bytes,14,opt,name=calendar_id,json=calendarId,proto3,oneof" json:"calendar_id,omitempty"`
+	CalendarRoll    CalendarRoll                    `protobuf:"varint,15,opt,name=calendar_roll,json=calendarRoll,proto3,enum=o5.trigger.v1.CalendarRoll" json:"calendar_roll,omitempty"`
+	Payload         *any_j5t.Any                    `protobuf:"bytes,16,opt,name=payload,proto3,oneof" json:"payload,omitempty"`
+	// When the trigger is next scheduled to fire after the event
+	NextFireAt *timestamppb.Timestamp `protobuf:"bytes,17,opt,name=next_fire_at,json=nextFireAt,proto3,oneof" json:"next_fire_at,omitempty"`
+}
+
+func (x *TriggerEventType_Updated) Reset() {
+	*x = TriggerEventType_Updated{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_o5_trigger_v1_trigger_j5s_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TriggerEventType_Updated) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TriggerEventType_Updated) ProtoMessage() {}
+
+func (x *TriggerEventType_Updated) ProtoReflect() protoreflect.Message {
+	mi := &file_o5_trigger_v1_trigger_j5s_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TriggerEventType_Updated.ProtoReflect.Descriptor instead.
+func (*TriggerEventType_Updated) Descriptor() ([]byte, []int) {
+	return file_o5_trigger_v1_trigger_j5s_proto_rawDescGZIP(), []int{3, 1}
+}
+
+func (x *TriggerEventType_Updated) GetTriggerName() string {
+	if x != nil {
+		return x.TriggerName
+	}
+	return ""
+}
+
+func (x *TriggerEventType_Updated) GetAppName() string {
+	if x != nil {
+		return x.AppName
+	}
+	return ""
+}
+
+func (x *TriggerEventType_Updated) GetCron() string {
+	if x != nil {
+		return x.Cron
+	}
+	return ""
+}
+
+func (x *TriggerEventType_Updated) GetRequestMetadata() *messaging_j5pb.RequestMetadata {
+	if x != nil {
+		return x.RequestMetadata
+	}
+	return nil
+}
+
+func (x *TriggerEventType_Updated) GetCatchUp() CatchUpPolicy {
+	if x != nil {
+		return x.CatchUp
+	}
+	return CatchUpPolicy_CATCH_UP_POLICY_UNSPECIFIED
+}
+
+func (x *TriggerEventType_Updated) GetRunAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RunAt
+	}
+	return nil
+}
+
+func (x *TriggerEventType_Updated) GetInterval() *Interval {
+	if x != nil {
+		return x.Interval
+	}
+	return nil
+}
+
+func (x *TriggerEventType_Updated) GetNotBefore() *timestamppb.Timestamp {
+	if x != nil {
+		return x.NotBefore
+	}
+	return nil
+}
+
+func (x *TriggerEventType_Updated) GetNotAfter() *timestamppb.Timestamp {
+	if x != nil {
+		return x.NotAfter
+	}
+	return nil
+}
+
+func (x *TriggerEventType_Updated) GetMaxFires() int32 {
+	if x != nil && x.MaxFires != nil {
+		return *x.MaxFires
+	}
+	return 0
+}
+
+func (x *TriggerEventType_Updated) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *TriggerEventType_Updated) GetDstPolicy() DSTPolicy {
+	if x != nil {
+		return x.DstPolicy
+	}
+	return DSTPolicy_DST_POLICY_UNSPECIFIED
+}
+
+func (x *TriggerEventType_Updated) GetJitterSeconds() int32 {
+	if x != nil {
+		return x.JitterSeconds
+	}
+	return 0
+}
+
+func (x *TriggerEventType_Updated) GetCalendarId() string {
+	if x != nil && x.CalendarId != nil {
+		return *x.CalendarId
+	}
+	return ""
+}
+
+func (x *TriggerEventType_Updated) GetCalendarRoll() CalendarRoll {
+	if x != nil {
+		return x.CalendarRoll
+	}
+	return CalendarRoll_CALENDAR_ROLL_UNSPECIFIED
+}
+
+func (x *TriggerEventType_Updated) GetPayload() *any_j5t.Any {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+func (x *TriggerEventType_Updated) GetNextFireAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.NextFireAt
+	}
+	return nil
+}
+
+// Pause the trigger
+type TriggerEventType_Paused struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *TriggerEventType_Paused) Reset() {
+	*x = TriggerEventType_Paused{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_o5_trigger_v1_trigger_j5s_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TriggerEventType_Paused) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TriggerEventType_Paused) ProtoMessage() {}
+
+func (x *TriggerEventType_Paused) ProtoReflect() protoreflect.Message {
+	mi := &file_o5_trigger_v1_trigger_j5s_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TriggerEventType_Paused.ProtoReflect.Descriptor instead.
+func (*TriggerEventType_Paused) Descriptor() ([]byte, []int) {
+	return file_o5_trigger_v1_trigger_j5s_proto_rawDescGZIP(), []int{3, 2}
+}
+
+// Resume the paused trigger
+type TriggerEventType_Activated struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// When the trigger is next scheduled to fire after the event
+	NextFireAt *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=next_fire_at,json=nextFireAt,proto3,oneof" json:"next_fire_at,omitempty"`
+}
+
+func (x *TriggerEventType_Activated) Reset() {
+	*x = TriggerEventType_Activated{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_o5_trigger_v1_trigger_j5s_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TriggerEventType_Activated) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TriggerEventType_Activated) ProtoMessage() {}
+
+func (x *TriggerEventType_Activated) ProtoReflect() protoreflect.Message {
+	mi := &file_o5_trigger_v1_trigger_j5s_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TriggerEventType_Activated.ProtoReflect.Descriptor instead.
+func (*TriggerEventType_Activated) Descriptor() ([]byte, []int) {
+	return file_o5_trigger_v1_trigger_j5s_proto_rawDescGZIP(), []int{3, 3}
+}
+
+func (x *TriggerEventType_Activated) GetNextFireAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.NextFireAt
+	}
+	return nil
+}
+
+// Manually run the trigger for a specific time
+type TriggerEventType_ManuallyTriggered struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The time the trigger is for
+	TriggerTime *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=trigger_time,json=triggerTime,proto3" json:"trigger_time,omitempty"`
+}
+
+func (x *TriggerEventType_ManuallyTriggered) Reset() {
+	*x = TriggerEventType_ManuallyTriggered{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_o5_trigger_v1_trigger_j5s_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TriggerEventType_ManuallyTriggered) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TriggerEventType_ManuallyTriggered) ProtoMessage() {}
+
+func (x *TriggerEventType_ManuallyTriggered) ProtoReflect() protoreflect.Message {
+	mi := &file_o5_trigger_v1_trigger_j5s_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TriggerEventType_ManuallyTriggered.ProtoReflect.Descriptor instead.
+func (*TriggerEventType_ManuallyTriggered) Descriptor() ([]byte, []int) {
+	return file_o5_trigger_v1_trigger_j5s_proto_rawDescGZIP(), []int{3, 4}
+}
+
+func (x *TriggerEventType_ManuallyTriggered) GetTriggerTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.TriggerTime
+	}
+	return nil
+}
+
+// The trigger has been executed for this time.
+type TriggerEventType_Triggered struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The time the trigger is for
+	TriggerTime *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=trigger_time,json=triggerTime,proto3" json:"trigger_time,omitempty"`
+	// The trigger time was missed and is being fired during catch-up.
+	Late bool `protobuf:"varint,2,opt,name=late,proto3" json:"late,omitempty"`
+	// When the trigger is next scheduled to fire after the event
+	NextFireAt *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=next_fire_at,json=nextFireAt,proto3,oneof" json:"next_fire_at,omitempty"`
+}
+
+func (x *TriggerEventType_Triggered) Reset() {
+	*x = TriggerEventType_Triggered{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_o5_trigger_v1_trigger_j5s_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TriggerEventType_Triggered) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TriggerEventType_Triggered) ProtoMessage() {}
+
+func (x *TriggerEventType_Triggered) ProtoReflect() protoreflect.Message {
+	mi := &file_o5_trigger_v1_trigger_j5s_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TriggerEventType_Triggered.ProtoReflect.Descriptor instead.
+func (*TriggerEventType_Triggered) Descriptor() ([]byte, []int) {
+	return file_o5_trigger_v1_trigger_j5s_proto_rawDescGZIP(), []int{3, 5}
+}
+
+func (x *TriggerEventType_Triggered) GetTriggerTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.TriggerTime
+	}
+	return nil
+}
+
+func (x *TriggerEventType_Triggered) GetLate() bool {
+	if x != nil {
+		return x.Late
+	}
+	return false
+}
+
+func (x *TriggerEventType_Triggered) GetNextFireAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.NextFireAt
+	}
+	return nil
+}
+
+// Archive the trigger
+type TriggerEventType_Archived struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *TriggerEventType_Archived) Reset() {
+	*x = TriggerEventType_Archived{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_o5_trigger_v1_trigger_j5s_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TriggerEventType_Archived) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TriggerEventType_Archived) ProtoMessage() {}
+
+func (x *TriggerEventType_Archived) ProtoReflect() protoreflect.Message {
+	mi := &file_o5_trigger_v1_trigger_j5s_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TriggerEventType_Archived.ProtoReflect.Descriptor instead.
+func (*TriggerEventType_Archived) Descriptor() ([]byte, []int) {
+	return file_o5_trigger_v1_trigger_j5s_proto_rawDescGZIP(), []int{3, 6}
+}
+
+// A one-shot trigger has fired, or a trigger has reached its maxFires, and
+// will not fire again
+type TriggerEventType_Completed struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *TriggerEventType_Completed) Reset() {
+	*x = TriggerEventType_Completed{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_o5_trigger_v1_trigger_j5s_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TriggerEventType_Completed) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TriggerEventType_Completed) ProtoMessage() {}
+
+func (x *TriggerEventType_Completed) ProtoReflect() protoreflect.Message {
+	mi := &file_o5_trigger_v1_trigger_j5s_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TriggerEventType_Completed.ProtoReflect.Descriptor instead.
+func (*TriggerEventType_Completed) Descriptor() ([]byte, []int) {
+	return file_o5_trigger_v1_trigger_j5s_proto_rawDescGZIP(), []int{3, 7}
+}
+
+// The notAfter time of the trigger has passed, it will not fire again
+type TriggerEventType_Expired struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *TriggerEventType_Expired) Reset() {
+	*x = TriggerEventType_Expired{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_o5_trigger_v1_trigger_j5s_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TriggerEventType_Expired) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TriggerEventType_Expired) ProtoMessage() {}
+
+func (x *TriggerEventType_Expired) ProtoReflect() protoreflect.Message {
+	mi := &file_o5_trigger_v1_trigger_j5s_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TriggerEventType_Expired.ProtoReflect.Descriptor instead.
+func (*TriggerEventType_Expired) Descriptor() ([]byte, []int) {
+	return file_o5_trigger_v1_trigger_j5s_proto_rawDescGZIP(), []int{3, 8}
+}
+
+// Restore the archived trigger, keeping its ID and event history. The
+// trigger is restored paused, and resumed straight after when activate is
+// set.
+type TriggerEventType_Restored struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Why the trigger was restored
+	Reason string `protobuf:"bytes,1,opt,name=reason,proto3" json:"reason,omitempty"`
+	// Resume the trigger once restored, otherwise it is left paused
+	Activate bool `protobuf:"varint,2,opt,name=activate,proto3" json:"activate,omitempty"`
+	// When the trigger is next scheduled to fire once resumed
+	NextFireAt *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=next_fire_at,json=nextFireAt,proto3,oneof" json:"next_fire_at,omitempty"`
+}
+
+func (x *TriggerEventType_Restored) Reset() {
+	*x = TriggerEventType_Restored{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_o5_trigger_v1_trigger_j5s_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TriggerEventType_Restored) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TriggerEventType_Restored) ProtoMessage() {}
+
+func (x *TriggerEventType_Restored) ProtoReflect() protoreflect.Message {
+	mi := &file_o5_trigger_v1_trigger_j5s_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TriggerEventType_Restored.ProtoReflect.Descriptor instead.
+func (*TriggerEventType_Restored) Descriptor() ([]byte, []int) {
+	return file_o5_trigger_v1_trigger_j5s_proto_rawDescGZIP(), []int{3, 9}
+}
+
+func (x *TriggerEventType_Restored) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *TriggerEventType_Restored) GetActivate() bool {
+	if x != nil {
+		return x.Activate
+	}
+	return false
+}
+
+func (x *TriggerEventType_Restored) GetNextFireAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.NextFireAt
+	}
+	return nil
+}
+
+// The next fire time of the trigger moved without it firing, as the tick
+// passed over a time it did not fire for, or its calendar changed.
+type TriggerEventType_Rescheduled struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// When the trigger is next scheduled to fire after the event
+	NextFireAt *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=next_fire_at,json=nextFireAt,proto3,oneof" json:"next_fire_at,omitempty"`
+}
+
+func (x *TriggerEventType_Rescheduled) Reset() {
+	*x = TriggerEventType_Rescheduled{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_o5_trigger_v1_trigger_j5s_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TriggerEventType_Rescheduled) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TriggerEventType_Rescheduled) ProtoMessage() {}
+
+func (x *TriggerEventType_Rescheduled) ProtoReflect() protoreflect.Message {
+	mi := &file_o5_trigger_v1_trigger_j5s_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TriggerEventType_Rescheduled.ProtoReflect.Descriptor instead.
+func (*TriggerEventType_Rescheduled) Descriptor() ([]byte, []int) {
+	return file_o5_trigger_v1_trigger_j5s_proto_rawDescGZIP(), []int{3, 10}
+}
+
+func (x *TriggerEventType_Rescheduled) GetNextFireAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.NextFireAt
+	}
+	return nil
+}
+
+// The tick could not check the trigger against its stored schedule, it is
+// quarantined so the tick keeps running for every other trigger.
+type TriggerEventType_Faulted struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Why the trigger could not be checked
+	Error string `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *TriggerEventType_Faulted) Reset() {
+	*x = TriggerEventType_Faulted{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_o5_trigger_v1_trigger_j5s_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TriggerEventType_Faulted) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TriggerEventType_Faulted) ProtoMessage() {}
+
+func (x *TriggerEventType_Faulted) ProtoReflect() protoreflect.Message {
+	mi := &file_o5_trigger_v1_trigger_j5s_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TriggerEventType_Faulted.ProtoReflect.Descriptor instead.
+func (*TriggerEventType_Faulted) Descriptor() ([]byte, []int) {
+	return file_o5_trigger_v1_trigger_j5s_proto_rawDescGZIP(), []int{3, 11}
+}
+
+func (x *TriggerEventType_Faulted) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+// Calendar has been created
+type CalendarEventType_Created struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name            string           `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	ExcludedDates   []*date_j5t.Date `protobuf:"bytes,2,rep,name=excluded_dates,json=excludedDates,proto3" json:"excluded_dates,omitempty"`
+	ExcludeWeekends bool             `protobuf:"varint,3,opt,name=exclude_weekends,json=excludeWeekends,proto3" json:"exclude_weekends,omitempty"`
+}
+
+func (x *CalendarEventType_Created) Reset() {
+	*x = CalendarEventType_Created{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_o5_trigger_v1_trigger_j5s_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CalendarEventType_Created) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CalendarEventType_Created) ProtoMessage() {}
+
+func (x *CalendarEventType_Created) ProtoReflect() protoreflect.Message {
+	mi := &file_o5_trigger_v1_trigger_j5s_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CalendarEventType_Created.ProtoReflect.Descriptor instead.
+func (*CalendarEventType_Created) Descriptor() ([]byte, []int) {
+	return file_o5_trigger_v1_trigger_j5s_proto_rawDescGZIP(), []int{8, 0}
+}
+
+func (x *CalendarEventType_Created) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CalendarEventType_Created) GetExcludedDates() []*date_j5t.Date {
+	if x != nil {
+		return x.ExcludedDates
+	}
+	return nil
+}
+
+func (x *CalendarEventType_Created) GetExcludeWeekends() bool {
+	if x != nil {
+		return x.ExcludeWeekends
+	}
+	return false
+}
+
+// Calendar has been modified, replacing the excluded dates
+type CalendarEventType_Updated struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name            string           `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	ExcludedDates   []*date_j5t.Date `protobuf:"bytes,2,rep,name=excluded_dates,json=excludedDates,proto3" json:"excluded_dates,omitempty"`
+	ExcludeWeekends bool             `protobuf:"varint,3,opt,name=exclude_weekends,json=excludeWeekends,proto3" json:"exclude_weekends,omitempty"`
+}
+
+func (x *CalendarEventType_Updated) Reset() {
+	*x = CalendarEventType_Updated{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_o5_trigger_v1_trigger_j5s_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CalendarEventType_Updated) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CalendarEventType_Updated) ProtoMessage() {}
+
+func (x *CalendarEventType_Updated) ProtoReflect() protoreflect.Message {
+	mi := &file_o5_trigger_v1_trigger_j5s_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CalendarEventType_Updated.ProtoReflect.Descriptor instead.
+func (*CalendarEventType_Updated) Descriptor() ([]byte, []int) {
+	return file_o5_trigger_v1_trigger_j5s_proto_rawDescGZIP(), []int{8, 1}
+}
+
+func (x *CalendarEventType_Updated) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CalendarEventType_Updated) GetExcludedDates() []*date_j5t.Date {
+	if x != nil {
+		return x.ExcludedDates
+	}
+	return nil
+}
+
+func (x *CalendarEventType_Updated) GetExcludeWeekends() bool {
+	if x != nil {
+		return x.ExcludeWeekends
+	}
+	return false
+}
+
+// Archive the calendar, triggers referencing it fire as if it had no
+// excluded dates
+type CalendarEventType_Archived struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *CalendarEventType_Archived) Reset() {
+	*x = CalendarEventType_Archived{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_o5_trigger_v1_trigger_j5s_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CalendarEventType_Archived) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CalendarEventType_Archived) ProtoMessage() {}
+
+func (x *CalendarEventType_Archived) ProtoReflect() protoreflect.Message {
+	mi := &file_o5_trigger_v1_trigger_j5s_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-type ActionType_Archive_ struct {
-	Archive *ActionType_Archive `protobuf:"bytes,3,opt,name=archive,proto3,oneof"`
+// Deprecated: Use CalendarEventType_Archived.ProtoReflect.Descriptor instead.
+func (*CalendarEventType_Archived) Descriptor() ([]byte, []int) {
+	return file_o5_trigger_v1_trigger_j5s_proto_rawDescGZIP(), []int{8, 2}
 }
 
-func (*ActionType_Create_) isActionType_Type() {}
+type ActionType_Create struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-func (*ActionType_Update_) isActionType_Type() {}
+	// Derived from the app and trigger name when unset, so a repeated create finds the same trigger
+	TriggerId     *string                `protobuf:"bytes,1,opt,name=trigger_id,json=triggerId,proto3,oneof" json:"trigger_id,omitempty"`
+	TriggerName   string                 `protobuf:"bytes,2,opt,name=trigger_name,json=triggerName,proto3" json:"trigger_name,omitempty"`
+	AppName       string                 `protobuf:"bytes,3,opt,name=app_name,json=appName,proto3" json:"app_name,omitempty"`
+	Cron          string                 `protobuf:"bytes,4,opt,name=cron,proto3" json:"cron,omitempty"`
+	CatchUp       CatchUpPolicy          `protobuf:"varint,5,opt,name=catch_up,json=catchUp,proto3,enum=o5.trigger.v1.CatchUpPolicy" json:"catch_up,omitempty"`
+	RunAt         *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=run_at,json=runAt,proto3,oneof" json:"run_at,omitempty"`
+	Interval      *Interval              `protobuf:"bytes,7,opt,name=interval,proto3,oneof" json:"interval,omitempty"`
+	NotBefore     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=not_before,json=notBefore,proto3,oneof" json:"not_before,omitempty"`
+	NotAfter      *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=not_after,json=notAfter,proto3,oneof" json:"not_after,omitempty"`
+	MaxFires      *int32                 `protobuf:"varint,10,opt,name=max_fires,json=maxFires,proto3,oneof" json:"max_fires,omitempty"`
+	Timezone      string                 `protobuf:"bytes,11,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	DstPolicy     DSTPolicy              `protobuf:"varint,12,opt,name=dst_policy,json=dstPolicy,proto3,enum=o5.trigger.v1.DSTPolicy" json:"dst_policy,omitempty"`
+	JitterSeconds int32                  `protobuf:"varint,13,opt,name=jitter_seconds,json=jitterSeconds,proto3" json:"jitter_seconds,omitempty"`
+	CalendarId    *string                `protobuf:"bytes,14,opt,name=calendar_id,json=calendarId,proto3,oneof" json:"calendar_id,omitempty"`
+	CalendarRoll  CalendarRoll           `protobuf:"varint,15,opt,name=calendar_roll,json=calendarRoll,proto3,enum=o5.trigger.v1.CalendarRoll" json:"calendar_roll,omitempty"`
+	Payload       *any_j5t.Any           `protobuf:"bytes,16,opt,name=payload,proto3,oneof" json:"payload,omitempty"`
+}
 
-func (*ActionType_Archive_) isActionType_Type() {}
+func (x *ActionType_Create) Reset() {
+	*x = ActionType_Create{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_o5_trigger_v1_trigger_j5s_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
 
-// Trigger has been requested
-type TriggerEventType_Created struct {
+func (x *ActionType_Create) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActionType_Create) ProtoMessage() {}
+
+func (x *ActionType_Create) ProtoReflect() protoreflect.Message {
+	mi := &file_o5_trigger_v1_trigger_j5s_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActionType_Create.ProtoReflect.Descriptor instead.
+func (*ActionType_Create) Descriptor() ([]byte, []int) {
+	return file_o5_trigger_v1_trigger_j5s_proto_rawDescGZIP(), []int{10, 0}
+}
+
+func (x *ActionType_Create) GetTriggerId() string {
+	if x != nil && x.TriggerId != nil {
+		return *x.TriggerId
+	}
+	return ""
+}
+
+func (x *ActionType_Create) GetTriggerName() string {
+	if x != nil {
+		return x.TriggerName
+	}
+	return ""
+}
+
+func (x *ActionType_Create) GetAppName() string {
+	if x != nil {
+		return x.AppName
+	}
+	return ""
+}
+
+func (x *ActionType_Create) GetCron() string {
+	if x != nil {
+		return x.Cron
+	}
+	return ""
+}
+
+func (x *ActionType_Create) GetCatchUp() CatchUpPolicy {
+	if x != nil {
+		return x.CatchUp
+	}
+	return CatchUpPolicy_CATCH_UP_POLICY_UNSPECIFIED
+}
+
+func (x *ActionType_Create) GetRunAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RunAt
+	}
+	return nil
+}
+
+func (x *ActionType_Create) GetInterval() *Interval {
+	if x != nil {
+		return x.Interval
+	}
+	return nil
+}
+
+func (x *ActionType_Create) GetNotBefore() *timestamppb.Timestamp {
+	if x != nil {
+		return x.NotBefore
+	}
+	return nil
+}
+
+func (x *ActionType_Create) GetNotAfter() *timestamppb.Timestamp {
+	if x != nil {
+		return x.NotAfter
+	}
+	return nil
+}
+
+func (x *ActionType_Create) GetMaxFires() int32 {
+	if x != nil && x.MaxFires != nil {
+		return *x.MaxFires
+	}
+	return 0
+}
+
+func (x *ActionType_Create) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *ActionType_Create) GetDstPolicy() DSTPolicy {
+	if x != nil {
+		return x.DstPolicy
+	}
+	return DSTPolicy_DST_POLICY_UNSPECIFIED
+}
+
+func (x *ActionType_Create) GetJitterSeconds() int32 {
+	if x != nil {
+		return x.JitterSeconds
+	}
+	return 0
+}
+
+func (x *ActionType_Create) GetCalendarId() string {
+	if x != nil && x.CalendarId != nil {
+		return *x.CalendarId
+	}
+	return ""
+}
+
+func (x *ActionType_Create) GetCalendarRoll() CalendarRoll {
+	if x != nil {
+		return x.CalendarRoll
+	}
+	return CalendarRoll_CALENDAR_ROLL_UNSPECIFIED
+}
+
+func (x *ActionType_Create) GetPayload() *any_j5t.Any {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+type ActionType_Update struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	TriggerName     string                          `protobuf:"bytes,1,opt,name=trigger_name,json=triggerName,proto3" json:"trigger_name,omitempty"`
-	AppName         string                          `protobuf:"bytes,2,opt,name=app_name,json=appName,proto3" json:"app_name,omitempty"`
-	Cron            string                          `protobuf:"bytes,3,opt,name=cron,proto3" json:"cron,omitempty"`
-	RequestMetadata *messaging_j5pb.RequestMetadata `protobuf:"bytes,4,opt,name=request_metadata,json=requestMetadata,proto3" json:"request_metadata,omitempty"`
+	TriggerId     string                 `protobuf:"bytes,1,opt,name=trigger_id,json=triggerId,proto3" json:"trigger_id,omitempty"`
+	TriggerName   string                 `protobuf:"bytes,2,opt,name=trigger_name,json=triggerName,proto3" json:"trigger_name,omitempty"`
+	AppName       string                 `protobuf:"bytes,3,opt,name=app_name,json=appName,proto3" json:"app_name,omitempty"`
+	Cron          string                 `protobuf:"bytes,4,opt,name=cron,proto3" json:"cron,omitempty"`
+	CatchUp       CatchUpPolicy          `protobuf:"varint,5,opt,name=catch_up,json=catchUp,proto3,enum=o5.trigger.v1.CatchUpPolicy" json:"catch_up,omitempty"`
+	RunAt         *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=run_at,json=runAt,proto3,oneof" json:"run_at,omitempty"`
+	Interval      *Interval              `protobuf:"bytes,7,opt,name=interval,proto3,oneof" json:"interval,omitempty"`
+	NotBefore     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=not_before,json=notBefore,proto3,oneof" json:"not_before,omitempty"`
+	NotAfter      *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=not_after,json=notAfter,proto3,oneof" json:"not_after,omitempty"`
+	MaxFires      *int32                 `protobuf:"varint,10,opt,name=max_fires,json=maxFires,proto3,oneof" json:"max_fires,omitempty"`
+	Timezone      string                 `protobuf:"bytes,11,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	DstPolicy     DSTPolicy              `protobuf:"varint,12,opt,name=dst_policy,json=dstPolicy,proto3,enum=o5.trigger.v1.DSTPolicy" json:"dst_policy,omitempty"`
+	JitterSeconds int32                  `protobuf:"varint,13,opt,name=jitter_seconds,json=jitterSeconds,proto3" json:"jitter_seconds,omitempty"`
+	CalendarId    *string                `protobuf:"bytes,14,opt,name=calendar_id,json=calendarId,proto3,oneof" json:"calendar_id,omitempty"`
+	CalendarRoll  CalendarRoll           `protobuf:"varint,15,opt,name=calendar_roll,json=calendarRoll,proto3,enum=o5.trigger.v1.CalendarRoll" json:"calendar_roll,omitempty"`
+	Payload       *any_j5t.Any           `protobuf:"bytes,16,opt,name=payload,proto3,oneof" json:"payload,omitempty"`
 }
 
-func (x *TriggerEventType_Created) Reset() {
-	*x = TriggerEventType_Created{}
+func (x *ActionType_Update) Reset() {
+	*x = ActionType_Update{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_o5_trigger_v1_trigger_j5s_proto_msgTypes[6]
+		mi := &file_o5_trigger_v1_trigger_j5s_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *TriggerEventType_Created) String() string {
+func (x *ActionType_Update) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TriggerEventType_Created) ProtoMessage() {}
+func (*ActionType_Update) ProtoMessage() {}
 
-func (x *TriggerEventType_Created) ProtoReflect() protoreflect.Message {
-	mi := &file_o5_trigger_v1_trigger_j5s_proto_msgTypes[6]
+func (x *ActionType_Update) ProtoReflect() protoreflect.Message {
+	mi := &file_o5_trigger_v1_trigger_j5s_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -615,68 +2893,198 @@ func (x *TriggerEventType_Created) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TriggerEventType_Created.ProtoReflect.Descriptor instead.
-func (*TriggerEventType_Created) Descriptor() ([]byte, []int) {
-	return file_o5_trigger_v1_trigger_j5s_proto_rawDescGZIP(), []int{3, 0}
+// Deprecated: Use ActionType_Update.ProtoReflect.Descriptor instead.
+func (*ActionType_Update) Descriptor() ([]byte, []int) {
+	return file_o5_trigger_v1_trigger_j5s_proto_rawDescGZIP(), []int{10, 1}
 }
 
-func (x *TriggerEventType_Created) GetTriggerName() string {
+func (x *ActionType_Update) GetTriggerId() string {
+	if x != nil {
+		return x.TriggerId
+	}
+	return ""
+}
+
+func (x *ActionType_Update) GetTriggerName() string {
 	if x != nil {
 		return x.TriggerName
 	}
 	return ""
 }
 
-func (x *TriggerEventType_Created) GetAppName() string {
+func (x *ActionType_Update) GetAppName() string {
 	if x != nil {
 		return x.AppName
 	}
 	return ""
 }
 
-func (x *TriggerEventType_Created) GetCron() string {
+func (x *ActionType_Update) GetCron() string {
 	if x != nil {
 		return x.Cron
 	}
 	return ""
 }
 
-func (x *TriggerEventType_Created) GetRequestMetadata() *messaging_j5pb.RequestMetadata {
+func (x *ActionType_Update) GetCatchUp() CatchUpPolicy {
 	if x != nil {
-		return x.RequestMetadata
+		return x.CatchUp
+	}
+	return CatchUpPolicy_CATCH_UP_POLICY_UNSPECIFIED
+}
+
+func (x *ActionType_Update) GetRunAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RunAt
 	}
 	return nil
 }
 
-// Trigger has been modified
-type TriggerEventType_Updated struct {
+func (x *ActionType_Update) GetInterval() *Interval {
+	if x != nil {
+		return x.Interval
+	}
+	return nil
+}
+
+func (x *ActionType_Update) GetNotBefore() *timestamppb.Timestamp {
+	if x != nil {
+		return x.NotBefore
+	}
+	return nil
+}
+
+func (x *ActionType_Update) GetNotAfter() *timestamppb.Timestamp {
+	if x != nil {
+		return x.NotAfter
+	}
+	return nil
+}
+
+func (x *ActionType_Update) GetMaxFires() int32 {
+	if x != nil && x.MaxFires != nil {
+		return *x.MaxFires
+	}
+	return 0
+}
+
+func (x *ActionType_Update) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *ActionType_Update) GetDstPolicy() DSTPolicy {
+	if x != nil {
+		return x.DstPolicy
+	}
+	return DSTPolicy_DST_POLICY_UNSPECIFIED
+}
+
+func (x *ActionType_Update) GetJitterSeconds() int32 {
+	if x != nil {
+		return x.JitterSeconds
+	}
+	return 0
+}
+
+func (x *ActionType_Update) GetCalendarId() string {
+	if x != nil && x.CalendarId != nil {
+		return *x.CalendarId
+	}
+	return ""
+}
+
+func (x *ActionType_Update) GetCalendarRoll() CalendarRoll {
+	if x != nil {
+		return x.CalendarRoll
+	}
+	return CalendarRoll_CALENDAR_ROLL_UNSPECIFIED
+}
+
+func (x *ActionType_Update) GetPayload() *any_j5t.Any {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+type ActionType_Archive struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	TriggerName     string                          `protobuf:"bytes,1,opt,name=trigger_name,json=triggerName,proto3" json:"trigger_name,omitempty"`
-	AppName         string                          `protobuf:"bytes,2,opt,name=app_name,json=appName,proto3" json:"app_name,omitempty"`
-	Cron            string                          `protobuf:"bytes,3,opt,name=cron,proto3" json:"cron,omitempty"`
-	RequestMetadata *messaging_j5pb.RequestMetadata `protobuf:"bytes,4,opt,name=request_metadata,json=requestMetadata,proto3" json:"request_metadata,omitempty"`
+	TriggerId string `protobuf:"bytes,1,opt,name=trigger_id,json=triggerId,proto3" json:"trigger_id,omitempty"`
+}
+
+func (x *ActionType_Archive) Reset() {
+	*x = ActionType_Archive{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_o5_trigger_v1_trigger_j5s_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ActionType_Archive) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActionType_Archive) ProtoMessage() {}
+
+func (x *ActionType_Archive) ProtoReflect() protoreflect.Message {
+	mi := &file_o5_trigger_v1_trigger_j5s_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActionType_Archive.ProtoReflect.Descriptor instead.
+func (*ActionType_Archive) Descriptor() ([]byte, []int) {
+	return file_o5_trigger_v1_trigger_j5s_proto_rawDescGZIP(), []int{10, 2}
+}
+
+func (x *ActionType_Archive) GetTriggerId() string {
+	if x != nil {
+		return x.TriggerId
+	}
+	return ""
+}
+
+type ActionType_CreateCalendar struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CalendarId      *string          `protobuf:"bytes,1,opt,name=calendar_id,json=calendarId,proto3,oneof" json:"calendar_id,omitempty"`
+	Name            string           `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	ExcludedDates   []*date_j5t.Date `protobuf:"bytes,3,rep,name=excluded_dates,json=excludedDates,proto3" json:"excluded_dates,omitempty"`
+	ExcludeWeekends bool             `protobuf:"varint,4,opt,name=exclude_weekends,json=excludeWeekends,proto3" json:"exclude_weekends,omitempty"`
 }
 
-func (x *TriggerEventType_Updated) Reset() {
-	*x = TriggerEventType_Updated{}
+func (x *ActionType_CreateCalendar) Reset() {
+	*x = ActionType_CreateCalendar{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_o5_trigger_v1_trigger_j5s_proto_msgTypes[7]
+		mi := &file_o5_trigger_v1_trigger_j5s_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *TriggerEventType_Updated) String() string {
+func (x *ActionType_CreateCalendar) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TriggerEventType_Updated) ProtoMessage() {}
+func (*ActionType_CreateCalendar) ProtoMessage() {}
 
-func (x *TriggerEventType_Updated) ProtoReflect() protoreflect.Message {
-	mi := &file_o5_trigger_v1_trigger_j5s_proto_msgTypes[7]
+func (x *ActionType_CreateCalendar) ProtoReflect() protoreflect.Message {
+	mi := &file_o5_trigger_v1_trigger_j5s_proto_msgTypes[31]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -687,63 +3095,67 @@ func (x *TriggerEventType_Updated) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TriggerEventType_Updated.ProtoReflect.Descriptor instead.
-func (*TriggerEventType_Updated) Descriptor() ([]byte, []int) {
-	return file_o5_trigger_v1_trigger_j5s_proto_rawDescGZIP(), []int{3, 1}
+// Deprecated: Use ActionType_CreateCalendar.ProtoReflect.Descriptor instead.
+func (*ActionType_CreateCalendar) Descriptor() ([]byte, []int) {
+	return file_o5_trigger_v1_trigger_j5s_proto_rawDescGZIP(), []int{10, 3}
 }
 
-func (x *TriggerEventType_Updated) GetTriggerName() string {
-	if x != nil {
-		return x.TriggerName
+func (x *ActionType_CreateCalendar) GetCalendarId() string {
+	if x != nil && x.CalendarId != nil {
+		return *x.CalendarId
 	}
 	return ""
 }
 
-func (x *TriggerEventType_Updated) GetAppName() string {
+func (x *ActionType_CreateCalendar) GetName() string {
 	if x != nil {
-		return x.AppName
+		return x.Name
 	}
 	return ""
 }
 
-func (x *TriggerEventType_Updated) GetCron() string {
+func (x *ActionType_CreateCalendar) GetExcludedDates() []*date_j5t.Date {
 	if x != nil {
-		return x.Cron
+		return x.ExcludedDates
 	}
-	return ""
+	return nil
 }
 
-func (x *TriggerEventType_Updated) GetRequestMetadata() *messaging_j5pb.RequestMetadata {
+func (x *ActionType_CreateCalendar) GetExcludeWeekends() bool {
 	if x != nil {
-		return x.RequestMetadata
+		return x.ExcludeWeekends
 	}
-	return nil
+	return false
 }
 
-// Pause the trigger
-type TriggerEventType_Paused struct {
+type ActionType_UpdateCalendar struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	CalendarId      string           `protobuf:"bytes,1,opt,name=calendar_id,json=calendarId,proto3" json:"calendar_id,omitempty"`
+	Name            string           `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	ExcludedDates   []*date_j5t.Date `protobuf:"bytes,3,rep,name=excluded_dates,json=excludedDates,proto3" json:"excluded_dates,omitempty"`
+	ExcludeWeekends bool             `protobuf:"varint,4,opt,name=exclude_weekends,json=excludeWeekends,proto3" json:"exclude_weekends,omitempty"`
 }
 
-func (x *TriggerEventType_Paused) Reset() {
-	*x = TriggerEventType_Paused{}
+func (x *ActionType_UpdateCalendar) Reset() {
+	*x = ActionType_UpdateCalendar{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_o5_trigger_v1_trigger_j5s_proto_msgTypes[8]
+		mi := &file_o5_trigger_v1_trigger_j5s_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *TriggerEventType_Paused) String() string {
+func (x *ActionType_UpdateCalendar) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TriggerEventType_Paused) ProtoMessage() {}
+func (*ActionType_UpdateCalendar) ProtoMessage() {}
 
-func (x *TriggerEventType_Paused) ProtoReflect() protoreflect.Message {
-	mi := &file_o5_trigger_v1_trigger_j5s_proto_msgTypes[8]
+func (x *ActionType_UpdateCalendar) ProtoReflect() protoreflect.Message {
+	mi := &file_o5_trigger_v1_trigger_j5s_proto_msgTypes[32]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -754,77 +3166,64 @@ func (x *TriggerEventType_Paused) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TriggerEventType_Paused.ProtoReflect.Descriptor instead.
-func (*TriggerEventType_Paused) Descriptor() ([]byte, []int) {
-	return file_o5_trigger_v1_trigger_j5s_proto_rawDescGZIP(), []int{3, 2}
-}
-
-// Resume the paused trigger
-type TriggerEventType_Activated struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+// Deprecated: Use ActionType_UpdateCalendar.ProtoReflect.Descriptor instead.
+func (*ActionType_UpdateCalendar) Descriptor() ([]byte, []int) {
+	return file_o5_trigger_v1_trigger_j5s_proto_rawDescGZIP(), []int{10, 4}
 }
 
-func (x *TriggerEventType_Activated) Reset() {
-	*x = TriggerEventType_Activated{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_o5_trigger_v1_trigger_j5s_proto_msgTypes[9]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *ActionType_UpdateCalendar) GetCalendarId() string {
+	if x != nil {
+		return x.CalendarId
 	}
+	return ""
 }
 
-func (x *TriggerEventType_Activated) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *ActionType_UpdateCalendar) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
 }
 
-func (*TriggerEventType_Activated) ProtoMessage() {}
-
-func (x *TriggerEventType_Activated) ProtoReflect() protoreflect.Message {
-	mi := &file_o5_trigger_v1_trigger_j5s_proto_msgTypes[9]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *ActionType_UpdateCalendar) GetExcludedDates() []*date_j5t.Date {
+	if x != nil {
+		return x.ExcludedDates
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use TriggerEventType_Activated.ProtoReflect.Descriptor instead.
-func (*TriggerEventType_Activated) Descriptor() ([]byte, []int) {
-	return file_o5_trigger_v1_trigger_j5s_proto_rawDescGZIP(), []int{3, 3}
+func (x *ActionType_UpdateCalendar) GetExcludeWeekends() bool {
+	if x != nil {
+		return x.ExcludeWeekends
+	}
+	return false
 }
 
-// Manually run the trigger for a specific time
-type TriggerEventType_ManuallyTriggered struct {
+type ActionType_ArchiveCalendar struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// The time the trigger is for
-	TriggerTime *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=trigger_time,json=triggerTime,proto3" json:"trigger_time,omitempty"`
+	CalendarId string `protobuf:"bytes,1,opt,name=calendar_id,json=calendarId,proto3" json:"calendar_id,omitempty"`
 }
 
-func (x *TriggerEventType_ManuallyTriggered) Reset() {
-	*x = TriggerEventType_ManuallyTriggered{}
+func (x *ActionType_ArchiveCalendar) Reset() {
+	*x = ActionType_ArchiveCalendar{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_o5_trigger_v1_trigger_j5s_proto_msgTypes[10]
+		mi := &file_o5_trigger_v1_trigger_j5s_proto_msgTypes[33]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *TriggerEventType_ManuallyTriggered) String() string {
+func (x *ActionType_ArchiveCalendar) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TriggerEventType_ManuallyTriggered) ProtoMessage() {}
+func (*ActionType_ArchiveCalendar) ProtoMessage() {}
 
-func (x *TriggerEventType_ManuallyTriggered) ProtoReflect() protoreflect.Message {
-	mi := &file_o5_trigger_v1_trigger_j5s_proto_msgTypes[10]
+func (x *ActionType_ArchiveCalendar) ProtoReflect() protoreflect.Message {
+	mi := &file_o5_trigger_v1_trigger_j5s_proto_msgTypes[33]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -835,45 +3234,44 @@ func (x *TriggerEventType_ManuallyTriggered) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TriggerEventType_ManuallyTriggered.ProtoReflect.Descriptor instead.
-func (*TriggerEventType_ManuallyTriggered) Descriptor() ([]byte, []int) {
-	return file_o5_trigger_v1_trigger_j5s_proto_rawDescGZIP(), []int{3, 4}
+// Deprecated: Use ActionType_ArchiveCalendar.ProtoReflect.Descriptor instead.
+func (*ActionType_ArchiveCalendar) Descriptor() ([]byte, []int) {
+	return file_o5_trigger_v1_trigger_j5s_proto_rawDescGZIP(), []int{10, 5}
 }
 
-func (x *TriggerEventType_ManuallyTriggered) GetTriggerTime() *timestamppb.Timestamp {
+func (x *ActionType_ArchiveCalendar) GetCalendarId() string {
 	if x != nil {
-		return x.TriggerTime
+		return x.CalendarId
 	}
-	return nil
+	return ""
 }
 
-// The trigger has been executed for this time.
-type TriggerEventType_Triggered struct {
+type ActionType_Sync struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// The time the trigger is for
-	TriggerTime *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=trigger_time,json=triggerTime,proto3" json:"trigger_time,omitempty"`
+	AppName  string               `protobuf:"bytes,1,opt,name=app_name,json=appName,proto3" json:"app_name,omitempty"`
+	Triggers []*TriggerDefinition `protobuf:"bytes,2,rep,name=triggers,proto3" json:"triggers,omitempty"`
 }
 
-func (x *TriggerEventType_Triggered) Reset() {
-	*x = TriggerEventType_Triggered{}
+func (x *ActionType_Sync) Reset() {
+	*x = ActionType_Sync{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_o5_trigger_v1_trigger_j5s_proto_msgTypes[11]
+		mi := &file_o5_trigger_v1_trigger_j5s_proto_msgTypes[34]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *TriggerEventType_Triggered) String() string {
+func (x *ActionType_Sync) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TriggerEventType_Triggered) ProtoMessage() {}
+func (*ActionType_Sync) ProtoMessage() {}
 
-func (x *TriggerEventType_Triggered) ProtoReflect() protoreflect.Message {
-	mi := &file_o5_trigger_v1_trigger_j5s_proto_msgTypes[11]
+func (x *ActionType_Sync) ProtoReflect() protoreflect.Message {
+	mi := &file_o5_trigger_v1_trigger_j5s_proto_msgTypes[34]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -884,42 +3282,50 @@ func (x *TriggerEventType_Triggered) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TriggerEventType_Triggered.ProtoReflect.Descriptor instead.
-func (*TriggerEventType_Triggered) Descriptor() ([]byte, []int) {
-	return file_o5_trigger_v1_trigger_j5s_proto_rawDescGZIP(), []int{3, 5}
+// Deprecated: Use ActionType_Sync.ProtoReflect.Descriptor instead.
+func (*ActionType_Sync) Descriptor() ([]byte, []int) {
+	return file_o5_trigger_v1_trigger_j5s_proto_rawDescGZIP(), []int{10, 6}
 }
 
-func (x *TriggerEventType_Triggered) GetTriggerTime() *timestamppb.Timestamp {
+func (x *ActionType_Sync) GetAppName() string {
 	if x != nil {
-		return x.TriggerTime
+		return x.AppName
+	}
+	return ""
+}
+
+func (x *ActionType_Sync) GetTriggers() []*TriggerDefinition {
+	if x != nil {
+		return x.Triggers
 	}
 	return nil
 }
 
-// Archive the trigger
-type TriggerEventType_Archived struct {
+type ActionType_Pause struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	TriggerId string `protobuf:"bytes,1,opt,name=trigger_id,json=triggerId,proto3" json:"trigger_id,omitempty"`
 }
 
-func (x *TriggerEventType_Archived) Reset() {
-	*x = TriggerEventType_Archived{}
+func (x *ActionType_Pause) Reset() {
+	*x = ActionType_Pause{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_o5_trigger_v1_trigger_j5s_proto_msgTypes[12]
+		mi := &file_o5_trigger_v1_trigger_j5s_proto_msgTypes[35]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *TriggerEventType_Archived) String() string {
+func (x *ActionType_Pause) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TriggerEventType_Archived) ProtoMessage() {}
+func (*ActionType_Pause) ProtoMessage() {}
 
-func (x *TriggerEventType_Archived) ProtoReflect() protoreflect.Message {
-	mi := &file_o5_trigger_v1_trigger_j5s_proto_msgTypes[12]
+func (x *ActionType_Pause) ProtoReflect() protoreflect.Message {
+	mi := &file_o5_trigger_v1_trigger_j5s_proto_msgTypes[35]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -930,39 +3336,43 @@ func (x *TriggerEventType_Archived) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TriggerEventType_Archived.ProtoReflect.Descriptor instead.
-func (*TriggerEventType_Archived) Descriptor() ([]byte, []int) {
-	return file_o5_trigger_v1_trigger_j5s_proto_rawDescGZIP(), []int{3, 6}
+// Deprecated: Use ActionType_Pause.ProtoReflect.Descriptor instead.
+func (*ActionType_Pause) Descriptor() ([]byte, []int) {
+	return file_o5_trigger_v1_trigger_j5s_proto_rawDescGZIP(), []int{10, 7}
 }
 
-type ActionType_Create struct {
+func (x *ActionType_Pause) GetTriggerId() string {
+	if x != nil {
+		return x.TriggerId
+	}
+	return ""
+}
+
+type ActionType_Resume struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	TriggerId   *string `protobuf:"bytes,1,opt,name=trigger_id,json=triggerId,proto3,oneof" json:"trigger_id,omitempty"`
-	TriggerName string  `protobuf:"bytes,2,opt,name=trigger_name,json=triggerName,proto3" json:"trigger_name,omitempty"`
-	AppName     string  `protobuf:"bytes,3,opt,name=app_name,json=appName,proto3" json:"app_name,omitempty"`
-	Cron        string  `protobuf:"bytes,4,opt,name=cron,proto3" json:"cron,omitempty"`
+	TriggerId string `protobuf:"bytes,1,opt,name=trigger_id,json=triggerId,proto3" json:"trigger_id,omitempty"`
 }
 
-func (x *ActionType_Create) Reset() {
-	*x = ActionType_Create{}
+func (x *ActionType_Resume) Reset() {
+	*x = ActionType_Resume{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_o5_trigger_v1_trigger_j5s_proto_msgTypes[13]
+		mi := &file_o5_trigger_v1_trigger_j5s_proto_msgTypes[36]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ActionType_Create) String() string {
+func (x *ActionType_Resume) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ActionType_Create) ProtoMessage() {}
+func (*ActionType_Resume) ProtoMessage() {}
 
-func (x *ActionType_Create) ProtoReflect() protoreflect.Message {
-	mi := &file_o5_trigger_v1_trigger_j5s_proto_msgTypes[13]
+func (x *ActionType_Resume) ProtoReflect() protoreflect.Message {
+	mi := &file_o5_trigger_v1_trigger_j5s_proto_msgTypes[36]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -973,67 +3383,45 @@ func (x *ActionType_Create) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ActionType_Create.ProtoReflect.Descriptor instead.
-func (*ActionType_Create) Descriptor() ([]byte, []int) {
-	return file_o5_trigger_v1_trigger_j5s_proto_rawDescGZIP(), []int{5, 0}
-}
-
-func (x *ActionType_Create) GetTriggerId() string {
-	if x != nil && x.TriggerId != nil {
-		return *x.TriggerId
-	}
-	return ""
-}
-
-func (x *ActionType_Create) GetTriggerName() string {
-	if x != nil {
-		return x.TriggerName
-	}
-	return ""
-}
-
-func (x *ActionType_Create) GetAppName() string {
-	if x != nil {
-		return x.AppName
-	}
-	return ""
+// Deprecated: Use ActionType_Resume.ProtoReflect.Descriptor instead.
+func (*ActionType_Resume) Descriptor() ([]byte, []int) {
+	return file_o5_trigger_v1_trigger_j5s_proto_rawDescGZIP(), []int{10, 8}
 }
 
-func (x *ActionType_Create) GetCron() string {
+func (x *ActionType_Resume) GetTriggerId() string {
 	if x != nil {
-		return x.Cron
+		return x.TriggerId
 	}
 	return ""
 }
 
-type ActionType_Update struct {
+type ActionType_ManuallyTrigger struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	TriggerId   string `protobuf:"bytes,1,opt,name=trigger_id,json=triggerId,proto3" json:"trigger_id,omitempty"`
-	TriggerName string `protobuf:"bytes,2,opt,name=trigger_name,json=triggerName,proto3" json:"trigger_name,omitempty"`
-	AppName     string `protobuf:"bytes,3,opt,name=app_name,json=appName,proto3" json:"app_name,omitempty"`
-	Cron        string `protobuf:"bytes,4,opt,name=cron,proto3" json:"cron,omitempty"`
+	TriggerId string `protobuf:"bytes,1,opt,name=trigger_id,json=triggerId,proto3" json:"trigger_id,omitempty"`
+	// The time the trigger is for, defaults to the time the request is handled
+	TriggerTime *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=trigger_time,json=triggerTime,proto3,oneof" json:"trigger_time,omitempty"`
 }
 
-func (x *ActionType_Update) Reset() {
-	*x = ActionType_Update{}
+func (x *ActionType_ManuallyTrigger) Reset() {
+	*x = ActionType_ManuallyTrigger{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_o5_trigger_v1_trigger_j5s_proto_msgTypes[14]
+		mi := &file_o5_trigger_v1_trigger_j5s_proto_msgTypes[37]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ActionType_Update) String() string {
+func (x *ActionType_ManuallyTrigger) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ActionType_Update) ProtoMessage() {}
+func (*ActionType_ManuallyTrigger) ProtoMessage() {}
 
-func (x *ActionType_Update) ProtoReflect() protoreflect.Message {
-	mi := &file_o5_trigger_v1_trigger_j5s_proto_msgTypes[14]
+func (x *ActionType_ManuallyTrigger) ProtoReflect() protoreflect.Message {
+	mi := &file_o5_trigger_v1_trigger_j5s_proto_msgTypes[37]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1044,64 +3432,52 @@ func (x *ActionType_Update) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ActionType_Update.ProtoReflect.Descriptor instead.
-func (*ActionType_Update) Descriptor() ([]byte, []int) {
-	return file_o5_trigger_v1_trigger_j5s_proto_rawDescGZIP(), []int{5, 1}
+// Deprecated: Use ActionType_ManuallyTrigger.ProtoReflect.Descriptor instead.
+func (*ActionType_ManuallyTrigger) Descriptor() ([]byte, []int) {
+	return file_o5_trigger_v1_trigger_j5s_proto_rawDescGZIP(), []int{10, 9}
 }
 
-func (x *ActionType_Update) GetTriggerId() string {
+func (x *ActionType_ManuallyTrigger) GetTriggerId() string {
 	if x != nil {
 		return x.TriggerId
 	}
 	return ""
 }
 
-func (x *ActionType_Update) GetTriggerName() string {
-	if x != nil {
-		return x.TriggerName
-	}
-	return ""
-}
-
-func (x *ActionType_Update) GetAppName() string {
-	if x != nil {
-		return x.AppName
-	}
-	return ""
-}
-
-func (x *ActionType_Update) GetCron() string {
+func (x *ActionType_ManuallyTrigger) GetTriggerTime() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Cron
+		return x.TriggerTime
 	}
-	return ""
+	return nil
 }
 
-type ActionType_Archive struct {
+type ActionType_Restore struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	TriggerId string `protobuf:"bytes,1,opt,name=trigger_id,json=triggerId,proto3" json:"trigger_id,omitempty"`
+	Reason    string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	Activate  bool   `protobuf:"varint,3,opt,name=activate,proto3" json:"activate,omitempty"`
 }
 
-func (x *ActionType_Archive) Reset() {
-	*x = ActionType_Archive{}
+func (x *ActionType_Restore) Reset() {
+	*x = ActionType_Restore{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_o5_trigger_v1_trigger_j5s_proto_msgTypes[15]
+		mi := &file_o5_trigger_v1_trigger_j5s_proto_msgTypes[38]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ActionType_Archive) String() string {
+func (x *ActionType_Restore) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ActionType_Archive) ProtoMessage() {}
+func (*ActionType_Restore) ProtoMessage() {}
 
-func (x *ActionType_Archive) ProtoReflect() protoreflect.Message {
-	mi := &file_o5_trigger_v1_trigger_j5s_proto_msgTypes[15]
+func (x *ActionType_Restore) ProtoReflect() protoreflect.Message {
+	mi := &file_o5_trigger_v1_trigger_j5s_proto_msgTypes[38]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1112,18 +3488,32 @@ func (x *ActionType_Archive) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ActionType_Archive.ProtoReflect.Descriptor instead.
-func (*ActionType_Archive) Descriptor() ([]byte, []int) {
-	return file_o5_trigger_v1_trigger_j5s_proto_rawDescGZIP(), []int{5, 2}
+// Deprecated: Use ActionType_Restore.ProtoReflect.Descriptor instead.
+func (*ActionType_Restore) Descriptor() ([]byte, []int) {
+	return file_o5_trigger_v1_trigger_j5s_proto_rawDescGZIP(), []int{10, 10}
 }
 
-func (x *ActionType_Archive) GetTriggerId() string {
+func (x *ActionType_Restore) GetTriggerId() string {
 	if x != nil {
 		return x.TriggerId
 	}
 	return ""
 }
 
+func (x *ActionType_Restore) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *ActionType_Restore) GetActivate() bool {
+	if x != nil {
+		return x.Activate
+	}
+	return false
+}
+
 var File_o5_trigger_v1_trigger_j5s_proto protoreflect.FileDescriptor
 
 var file_o5_trigger_v1_trigger_j5s_proto_rawDesc = []byte{
@@ -1141,212 +3531,908 @@ var file_o5_trigger_v1_trigger_j5s_proto_rawDesc = []byte{
 	0x73, 0x73, 0x61, 0x67, 0x69, 0x6e, 0x67, 0x2f, 0x76, 0x31, 0x2f, 0x72, 0x65, 0x71, 0x72, 0x65,
 	0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1a, 0x6a, 0x35, 0x2f, 0x73, 0x74, 0x61, 0x74,
 	0x65, 0x2f, 0x76, 0x31, 0x2f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x22, 0x70, 0x0a, 0x0b, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x4b, 0x65,
-	0x79, 0x73, 0x12, 0x48, 0x0a, 0x0a, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x5f, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x29, 0xba, 0x48, 0x15, 0x72, 0x13, 0x32, 0x11, 0x5e,
-	0x5b, 0x30, 0x2d, 0x39, 0x41, 0x2d, 0x5a, 0x61, 0x2d, 0x7a, 0x5d, 0x7b, 0x32, 0x32, 0x7d, 0x24,
-	0xc2, 0xff, 0x8e, 0x02, 0x05, 0xb2, 0x02, 0x02, 0x08, 0x03, 0xea, 0x85, 0x8f, 0x02, 0x02, 0x08,
-	0x01, 0x52, 0x09, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x49, 0x64, 0x3a, 0x17, 0xc2, 0xff,
-	0x8e, 0x02, 0x02, 0x52, 0x00, 0xea, 0x85, 0x8f, 0x02, 0x0b, 0x0a, 0x07, 0x74, 0x72, 0x69, 0x67,
-	0x67, 0x65, 0x72, 0x10, 0x01, 0x22, 0xf8, 0x01, 0x0a, 0x0b, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65,
-	0x72, 0x44, 0x61, 0x74, 0x61, 0x12, 0x31, 0x0a, 0x0c, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72,
-	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0e, 0xba, 0x48, 0x03,
-	0xc8, 0x01, 0x01, 0xc2, 0xff, 0x8e, 0x02, 0x03, 0xf2, 0x01, 0x00, 0x52, 0x0b, 0x74, 0x72, 0x69,
-	0x67, 0x67, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x29, 0x0a, 0x08, 0x61, 0x70, 0x70, 0x5f,
-	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0e, 0xba, 0x48, 0x03, 0xc8,
-	0x01, 0x01, 0xc2, 0xff, 0x8e, 0x02, 0x03, 0xf2, 0x01, 0x00, 0x52, 0x07, 0x61, 0x70, 0x70, 0x4e,
-	0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x04, 0x63, 0x72, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x09, 0x42, 0x08, 0xc2, 0xff, 0x8e, 0x02, 0x03, 0xf2, 0x01, 0x00, 0x52, 0x04, 0x63, 0x72, 0x6f,
-	0x6e, 0x12, 0x54, 0x0a, 0x10, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x6d, 0x65, 0x74,
-	0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6a, 0x35,
-	0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x42, 0x07, 0xc2,
-	0xff, 0x8e, 0x02, 0x02, 0x52, 0x00, 0x52, 0x0f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4d,
-	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x3a, 0x17, 0xc2, 0xff, 0x8e, 0x02, 0x02, 0x52, 0x00,
-	0xea, 0x85, 0x8f, 0x02, 0x0b, 0x0a, 0x07, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x10, 0x04,
-	0x22, 0xc5, 0x02, 0x0a, 0x0c, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74,
-	0x65, 0x12, 0x45, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x6a, 0x35, 0x2e, 0x73, 0x74, 0x61, 0x74, 0x65, 0x2e, 0x76,
-	0x31, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x42,
-	0x0d, 0xba, 0x48, 0x03, 0xc8, 0x01, 0x01, 0xc2, 0xff, 0x8e, 0x02, 0x02, 0x52, 0x00, 0x52, 0x08,
-	0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x3f, 0x0a, 0x04, 0x6b, 0x65, 0x79, 0x73,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x6f, 0x35, 0x2e, 0x74, 0x72, 0x69, 0x67,
-	0x67, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x4b, 0x65,
-	0x79, 0x73, 0x42, 0x0f, 0xba, 0x48, 0x03, 0xc8, 0x01, 0x01, 0xc2, 0xff, 0x8e, 0x02, 0x04, 0x52,
-	0x02, 0x08, 0x01, 0x52, 0x04, 0x6b, 0x65, 0x79, 0x73, 0x12, 0x3d, 0x0a, 0x04, 0x64, 0x61, 0x74,
-	0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x6f, 0x35, 0x2e, 0x74, 0x72, 0x69,
-	0x67, 0x67, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x44,
-	0x61, 0x74, 0x61, 0x42, 0x0d, 0xba, 0x48, 0x03, 0xc8, 0x01, 0x01, 0xc2, 0xff, 0x8e, 0x02, 0x02,
-	0x52, 0x00, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x55, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1c, 0x2e, 0x6f, 0x35, 0x2e, 0x74, 0x72,
-	0x69, 0x67, 0x67, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72,
-	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x42, 0x1f, 0xba, 0x48, 0x08, 0xc8, 0x01, 0x01, 0x82, 0x01,
-	0x02, 0x10, 0x01, 0xc2, 0xff, 0x8e, 0x02, 0x02, 0x5a, 0x00, 0x8a, 0xf7, 0x98, 0xc6, 0x02, 0x07,
-	0xa2, 0x01, 0x04, 0x52, 0x02, 0x08, 0x01, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x3a,
+	0x6f, 0x74, 0x6f, 0x1a, 0x19, 0x6a, 0x35, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2f, 0x61, 0x6e,
+	0x79, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x6e, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1b,
+	0x6a, 0x35, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2f, 0x64, 0x61, 0x74, 0x65, 0x2f, 0x76, 0x31,
+	0x2f, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x70, 0x0a, 0x0b, 0x54,
+	0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x4b, 0x65, 0x79, 0x73, 0x12, 0x48, 0x0a, 0x0a, 0x74, 0x72,
+	0x69, 0x67, 0x67, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x29,
+	0xba, 0x48, 0x15, 0x72, 0x13, 0x32, 0x11, 0x5e, 0x5b, 0x30, 0x2d, 0x39, 0x41, 0x2d, 0x5a, 0x61,
+	0x2d, 0x7a, 0x5d, 0x7b, 0x32, 0x32, 0x7d, 0x24, 0xc2, 0xff, 0x8e, 0x02, 0x05, 0xb2, 0x02, 0x02,
+	0x08, 0x03, 0xea, 0x85, 0x8f, 0x02, 0x02, 0x08, 0x01, 0x52, 0x09, 0x74, 0x72, 0x69, 0x67, 0x67,
+	0x65, 0x72, 0x49, 0x64, 0x3a, 0x17, 0xc2, 0xff, 0x8e, 0x02, 0x02, 0x52, 0x00, 0xea, 0x85, 0x8f,
+	0x02, 0x0b, 0x0a, 0x07, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x10, 0x01, 0x22, 0xea, 0x0a,
+	0x0a, 0x0b, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x12, 0x31, 0x0a,
+	0x0c, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x0e, 0xba, 0x48, 0x03, 0xc8, 0x01, 0x01, 0xc2, 0xff, 0x8e, 0x02, 0x03,
+	0xf2, 0x01, 0x00, 0x52, 0x0b, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65,
+	0x12, 0x29, 0x0a, 0x08, 0x61, 0x70, 0x70, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x0e, 0xba, 0x48, 0x03, 0xc8, 0x01, 0x01, 0xc2, 0xff, 0x8e, 0x02, 0x03, 0xf2,
+	0x01, 0x00, 0x52, 0x07, 0x61, 0x70, 0x70, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x04, 0x63,
+	0x72, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xc2, 0xff, 0x8e, 0x02, 0x03,
+	0xf2, 0x01, 0x00, 0x52, 0x04, 0x63, 0x72, 0x6f, 0x6e, 0x12, 0x54, 0x0a, 0x10, 0x72, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x5f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6a, 0x35, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x69,
+	0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4d, 0x65, 0x74,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0x42, 0x07, 0xc2, 0xff, 0x8e, 0x02, 0x02, 0x52, 0x00, 0x52, 0x0f,
+	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12,
+	0x48, 0x0a, 0x08, 0x63, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x75, 0x70, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x1c, 0x2e, 0x6f, 0x35, 0x2e, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x2e, 0x76,
+	0x31, 0x2e, 0x43, 0x61, 0x74, 0x63, 0x68, 0x55, 0x70, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x42,
+	0x0f, 0xba, 0x48, 0x05, 0x82, 0x01, 0x02, 0x10, 0x01, 0xc2, 0xff, 0x8e, 0x02, 0x02, 0x5a, 0x00,
+	0x52, 0x07, 0x63, 0x61, 0x74, 0x63, 0x68, 0x55, 0x70, 0x12, 0x40, 0x0a, 0x06, 0x72, 0x75, 0x6e,
+	0x5f, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x08, 0xc2, 0xff, 0x8e, 0x02, 0x03, 0xaa, 0x02, 0x00, 0x48,
+	0x00, 0x52, 0x05, 0x72, 0x75, 0x6e, 0x41, 0x74, 0x88, 0x01, 0x01, 0x12, 0x41, 0x0a, 0x08, 0x69,
+	0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e,
+	0x6f, 0x35, 0x2e, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e,
+	0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x42, 0x07, 0xc2, 0xff, 0x8e, 0x02, 0x02, 0x52, 0x00, 0x48,
+	0x01, 0x52, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x88, 0x01, 0x01, 0x12, 0x48,
+	0x0a, 0x0a, 0x6e, 0x6f, 0x74, 0x5f, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x08,
+	0xc2, 0xff, 0x8e, 0x02, 0x03, 0xaa, 0x02, 0x00, 0x48, 0x02, 0x52, 0x09, 0x6e, 0x6f, 0x74, 0x42,
+	0x65, 0x66, 0x6f, 0x72, 0x65, 0x88, 0x01, 0x01, 0x12, 0x46, 0x0a, 0x09, 0x6e, 0x6f, 0x74, 0x5f,
+	0x61, 0x66, 0x74, 0x65, 0x72, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x08, 0xc2, 0xff, 0x8e, 0x02, 0x03, 0xaa, 0x02,
+	0x00, 0x48, 0x03, 0x52, 0x08, 0x6e, 0x6f, 0x74, 0x41, 0x66, 0x74, 0x65, 0x72, 0x88, 0x01, 0x01,
+	0x12, 0x2a, 0x0a, 0x09, 0x6d, 0x61, 0x78, 0x5f, 0x66, 0x69, 0x72, 0x65, 0x73, 0x18, 0x0a, 0x20,
+	0x01, 0x28, 0x05, 0x42, 0x08, 0xc2, 0xff, 0x8e, 0x02, 0x03, 0xfa, 0x01, 0x00, 0x48, 0x04, 0x52,
+	0x08, 0x6d, 0x61, 0x78, 0x46, 0x69, 0x72, 0x65, 0x73, 0x88, 0x01, 0x01, 0x12, 0x27, 0x0a, 0x0a,
+	0x66, 0x69, 0x72, 0x65, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x05,
+	0x42, 0x08, 0xc2, 0xff, 0x8e, 0x02, 0x03, 0xfa, 0x01, 0x00, 0x52, 0x09, 0x66, 0x69, 0x72, 0x65,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x24, 0x0a, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e,
+	0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xc2, 0xff, 0x8e, 0x02, 0x03, 0xf2, 0x01,
+	0x00, 0x52, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e, 0x65, 0x12, 0x48, 0x0a, 0x0a, 0x64,
+	0x73, 0x74, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x18, 0x2e, 0x6f, 0x35, 0x2e, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e,
+	0x44, 0x53, 0x54, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x42, 0x0f, 0xba, 0x48, 0x05, 0x82, 0x01,
+	0x02, 0x10, 0x01, 0xc2, 0xff, 0x8e, 0x02, 0x02, 0x5a, 0x00, 0x52, 0x09, 0x64, 0x73, 0x74, 0x50,
+	0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x2f, 0x0a, 0x0e, 0x6a, 0x69, 0x74, 0x74, 0x65, 0x72, 0x5f,
+	0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x05, 0x42, 0x08, 0xc2,
+	0xff, 0x8e, 0x02, 0x03, 0xfa, 0x01, 0x00, 0x52, 0x0d, 0x6a, 0x69, 0x74, 0x74, 0x65, 0x72, 0x53,
+	0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x48, 0x0a, 0x0b, 0x63, 0x61, 0x6c, 0x65, 0x6e, 0x64,
+	0x61, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x42, 0x22, 0xba, 0x48, 0x15,
+	0x72, 0x13, 0x32, 0x11, 0x5e, 0x5b, 0x30, 0x2d, 0x39, 0x41, 0x2d, 0x5a, 0x61, 0x2d, 0x7a, 0x5d,
+	0x7b, 0x32, 0x32, 0x7d, 0x24, 0xc2, 0xff, 0x8e, 0x02, 0x05, 0xb2, 0x02, 0x02, 0x08, 0x03, 0x48,
+	0x05, 0x52, 0x0a, 0x63, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x49, 0x64, 0x88, 0x01, 0x01,
+	0x12, 0x51, 0x0a, 0x0d, 0x63, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x5f, 0x72, 0x6f, 0x6c,
+	0x6c, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x6f, 0x35, 0x2e, 0x74, 0x72, 0x69,
+	0x67, 0x67, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72,
+	0x52, 0x6f, 0x6c, 0x6c, 0x42, 0x0f, 0xba, 0x48, 0x05, 0x82, 0x01, 0x02, 0x10, 0x01, 0xc2, 0xff,
+	0x8e, 0x02, 0x02, 0x5a, 0x00, 0x52, 0x0c, 0x63, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x52,
+	0x6f, 0x6c, 0x6c, 0x12, 0x3c, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x11,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6a, 0x35, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
+	0x61, 0x6e, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x6e, 0x79, 0x42, 0x07, 0xc2, 0xff, 0x8e, 0x02,
+	0x02, 0x4a, 0x00, 0x48, 0x06, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x88, 0x01,
+	0x01, 0x12, 0x5e, 0x0a, 0x0d, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x66, 0x69, 0x72, 0x65, 0x64, 0x5f,
+	0x61, 0x74, 0x18, 0x12, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x42, 0x19, 0xc2, 0xff, 0x8e, 0x02, 0x03, 0xaa, 0x02, 0x00, 0x8a, 0xf7,
+	0x98, 0xc6, 0x02, 0x0b, 0xf2, 0x01, 0x08, 0x52, 0x02, 0x08, 0x01, 0x5a, 0x02, 0x08, 0x01, 0x48,
+	0x07, 0x52, 0x0b, 0x6c, 0x61, 0x73, 0x74, 0x46, 0x69, 0x72, 0x65, 0x64, 0x41, 0x74, 0x88, 0x01,
+	0x01, 0x12, 0x5c, 0x0a, 0x0c, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x66, 0x69, 0x72, 0x65, 0x5f, 0x61,
+	0x74, 0x18, 0x13, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x42, 0x19, 0xc2, 0xff, 0x8e, 0x02, 0x03, 0xaa, 0x02, 0x00, 0x8a, 0xf7, 0x98,
+	0xc6, 0x02, 0x0b, 0xf2, 0x01, 0x08, 0x52, 0x02, 0x08, 0x01, 0x5a, 0x02, 0x08, 0x01, 0x48, 0x08,
+	0x52, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x46, 0x69, 0x72, 0x65, 0x41, 0x74, 0x88, 0x01, 0x01, 0x3a,
 	0x17, 0xc2, 0xff, 0x8e, 0x02, 0x02, 0x52, 0x00, 0xea, 0x85, 0x8f, 0x02, 0x0b, 0x0a, 0x07, 0x74,
-	0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x10, 0x02, 0x22, 0xe6, 0x0a, 0x0a, 0x10, 0x54, 0x72, 0x69,
-	0x67, 0x67, 0x65, 0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x4c, 0x0a,
-	0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27,
+	0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x10, 0x04, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x72, 0x75, 0x6e,
+	0x5f, 0x61, 0x74, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c,
+	0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x6e, 0x6f, 0x74, 0x5f, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x42,
+	0x0c, 0x0a, 0x0a, 0x5f, 0x6e, 0x6f, 0x74, 0x5f, 0x61, 0x66, 0x74, 0x65, 0x72, 0x42, 0x0c, 0x0a,
+	0x0a, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x66, 0x69, 0x72, 0x65, 0x73, 0x42, 0x0e, 0x0a, 0x0c, 0x5f,
+	0x63, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x5f, 0x69, 0x64, 0x42, 0x0a, 0x0a, 0x08, 0x5f,
+	0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x6c, 0x61, 0x73, 0x74,
+	0x5f, 0x66, 0x69, 0x72, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x6e, 0x65,
+	0x78, 0x74, 0x5f, 0x66, 0x69, 0x72, 0x65, 0x5f, 0x61, 0x74, 0x22, 0xc5, 0x02, 0x0a, 0x0c, 0x54,
+	0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x45, 0x0a, 0x08, 0x6d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x6a, 0x35, 0x2e, 0x73, 0x74, 0x61, 0x74, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x74,
+	0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x42, 0x0d, 0xba, 0x48, 0x03, 0xc8, 0x01,
+	0x01, 0xc2, 0xff, 0x8e, 0x02, 0x02, 0x52, 0x00, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0x12, 0x3f, 0x0a, 0x04, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x6f, 0x35, 0x2e, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x2e, 0x76, 0x31,
+	0x2e, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x4b, 0x65, 0x79, 0x73, 0x42, 0x0f, 0xba, 0x48,
+	0x03, 0xc8, 0x01, 0x01, 0xc2, 0xff, 0x8e, 0x02, 0x04, 0x52, 0x02, 0x08, 0x01, 0x52, 0x04, 0x6b,
+	0x65, 0x79, 0x73, 0x12, 0x3d, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x6f, 0x35, 0x2e, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x2e, 0x76,
+	0x31, 0x2e, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x42, 0x0d, 0xba,
+	0x48, 0x03, 0xc8, 0x01, 0x01, 0xc2, 0xff, 0x8e, 0x02, 0x02, 0x52, 0x00, 0x52, 0x04, 0x64, 0x61,
+	0x74, 0x61, 0x12, 0x55, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x1c, 0x2e, 0x6f, 0x35, 0x2e, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x2e,
+	0x76, 0x31, 0x2e, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x42, 0x1f, 0xba, 0x48, 0x08, 0xc8, 0x01, 0x01, 0x82, 0x01, 0x02, 0x10, 0x01, 0xc2, 0xff, 0x8e,
+	0x02, 0x02, 0x5a, 0x00, 0x8a, 0xf7, 0x98, 0xc6, 0x02, 0x07, 0xa2, 0x01, 0x04, 0x52, 0x02, 0x08,
+	0x01, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x3a, 0x17, 0xc2, 0xff, 0x8e, 0x02, 0x02,
+	0x52, 0x00, 0xea, 0x85, 0x8f, 0x02, 0x0b, 0x0a, 0x07, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72,
+	0x10, 0x02, 0x22, 0xf8, 0x21, 0x0a, 0x10, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x4c, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x6f, 0x35, 0x2e, 0x74, 0x72,
+	0x69, 0x67, 0x67, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x42, 0x07, 0xc2, 0xff, 0x8e, 0x02, 0x02, 0x52, 0x00, 0x48, 0x00, 0x52, 0x07, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x64, 0x12, 0x4c, 0x0a, 0x07, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x6f, 0x35, 0x2e, 0x74, 0x72, 0x69, 0x67,
+	0x67, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x42,
+	0x07, 0xc2, 0xff, 0x8e, 0x02, 0x02, 0x52, 0x00, 0x48, 0x00, 0x52, 0x07, 0x75, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x64, 0x12, 0x49, 0x0a, 0x06, 0x70, 0x61, 0x75, 0x73, 0x65, 0x64, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x6f, 0x35, 0x2e, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72,
+	0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x54, 0x79, 0x70, 0x65, 0x2e, 0x50, 0x61, 0x75, 0x73, 0x65, 0x64, 0x42, 0x07, 0xc2, 0xff, 0x8e,
+	0x02, 0x02, 0x52, 0x00, 0x48, 0x00, 0x52, 0x06, 0x70, 0x61, 0x75, 0x73, 0x65, 0x64, 0x12, 0x52,
+	0x0a, 0x09, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x29, 0x2e, 0x6f, 0x35, 0x2e, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x2e, 0x76,
+	0x31, 0x2e, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79,
+	0x70, 0x65, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x64, 0x42, 0x07, 0xc2, 0xff,
+	0x8e, 0x02, 0x02, 0x52, 0x00, 0x48, 0x00, 0x52, 0x09, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74,
+	0x65, 0x64, 0x12, 0x6b, 0x0a, 0x12, 0x6d, 0x61, 0x6e, 0x75, 0x61, 0x6c, 0x6c, 0x79, 0x5f, 0x74,
+	0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x31,
 	0x2e, 0x6f, 0x35, 0x2e, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x54,
 	0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x2e,
-	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x42, 0x07, 0xc2, 0xff, 0x8e, 0x02, 0x02, 0x52, 0x00,
-	0x48, 0x00, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x12, 0x4c, 0x0a, 0x07, 0x75,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x6f,
-	0x35, 0x2e, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x69,
-	0x67, 0x67, 0x65, 0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x2e, 0x55, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x64, 0x42, 0x07, 0xc2, 0xff, 0x8e, 0x02, 0x02, 0x52, 0x00, 0x48, 0x00,
-	0x52, 0x07, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x12, 0x49, 0x0a, 0x06, 0x70, 0x61, 0x75,
-	0x73, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x6f, 0x35, 0x2e, 0x74,
-	0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65,
-	0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x2e, 0x50, 0x61, 0x75, 0x73, 0x65,
-	0x64, 0x42, 0x07, 0xc2, 0xff, 0x8e, 0x02, 0x02, 0x52, 0x00, 0x48, 0x00, 0x52, 0x06, 0x70, 0x61,
-	0x75, 0x73, 0x65, 0x64, 0x12, 0x52, 0x0a, 0x09, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65,
-	0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x6f, 0x35, 0x2e, 0x74, 0x72, 0x69,
+	0x4d, 0x61, 0x6e, 0x75, 0x61, 0x6c, 0x6c, 0x79, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x65,
+	0x64, 0x42, 0x07, 0xc2, 0xff, 0x8e, 0x02, 0x02, 0x52, 0x00, 0x48, 0x00, 0x52, 0x11, 0x6d, 0x61,
+	0x6e, 0x75, 0x61, 0x6c, 0x6c, 0x79, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x65, 0x64, 0x12,
+	0x52, 0x0a, 0x09, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x29, 0x2e, 0x6f, 0x35, 0x2e, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x2e,
+	0x76, 0x31, 0x2e, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54,
+	0x79, 0x70, 0x65, 0x2e, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x65, 0x64, 0x42, 0x07, 0xc2,
+	0xff, 0x8e, 0x02, 0x02, 0x52, 0x00, 0x48, 0x00, 0x52, 0x09, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65,
+	0x72, 0x65, 0x64, 0x12, 0x4f, 0x0a, 0x08, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x6f, 0x35, 0x2e, 0x74, 0x72, 0x69, 0x67, 0x67,
+	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x2e, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x42,
+	0x07, 0xc2, 0xff, 0x8e, 0x02, 0x02, 0x52, 0x00, 0x48, 0x00, 0x52, 0x08, 0x61, 0x72, 0x63, 0x68,
+	0x69, 0x76, 0x65, 0x64, 0x12, 0x52, 0x0a, 0x09, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65,
+	0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x6f, 0x35, 0x2e, 0x74, 0x72, 0x69,
 	0x67, 0x67, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x45,
-	0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74,
-	0x65, 0x64, 0x42, 0x07, 0xc2, 0xff, 0x8e, 0x02, 0x02, 0x52, 0x00, 0x48, 0x00, 0x52, 0x09, 0x61,
-	0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x64, 0x12, 0x6b, 0x0a, 0x12, 0x6d, 0x61, 0x6e, 0x75,
-	0x61, 0x6c, 0x6c, 0x79, 0x5f, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x65, 0x64, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x31, 0x2e, 0x6f, 0x35, 0x2e, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65,
-	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x45, 0x76, 0x65, 0x6e,
-	0x74, 0x54, 0x79, 0x70, 0x65, 0x2e, 0x4d, 0x61, 0x6e, 0x75, 0x61, 0x6c, 0x6c, 0x79, 0x54, 0x72,
-	0x69, 0x67, 0x67, 0x65, 0x72, 0x65, 0x64, 0x42, 0x07, 0xc2, 0xff, 0x8e, 0x02, 0x02, 0x52, 0x00,
-	0x48, 0x00, 0x52, 0x11, 0x6d, 0x61, 0x6e, 0x75, 0x61, 0x6c, 0x6c, 0x79, 0x54, 0x72, 0x69, 0x67,
-	0x67, 0x65, 0x72, 0x65, 0x64, 0x12, 0x52, 0x0a, 0x09, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72,
-	0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x6f, 0x35, 0x2e, 0x74, 0x72,
+	0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74,
+	0x65, 0x64, 0x42, 0x07, 0xc2, 0xff, 0x8e, 0x02, 0x02, 0x52, 0x00, 0x48, 0x00, 0x52, 0x09, 0x63,
+	0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x12, 0x4c, 0x0a, 0x07, 0x65, 0x78, 0x70, 0x69,
+	0x72, 0x65, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x6f, 0x35, 0x2e, 0x74,
+	0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65,
+	0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x2e, 0x45, 0x78, 0x70, 0x69, 0x72,
+	0x65, 0x64, 0x42, 0x07, 0xc2, 0xff, 0x8e, 0x02, 0x02, 0x52, 0x00, 0x48, 0x00, 0x52, 0x07, 0x65,
+	0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x12, 0x4f, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x74, 0x6f, 0x72,
+	0x65, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x6f, 0x35, 0x2e, 0x74, 0x72,
 	0x69, 0x67, 0x67, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72,
-	0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x2e, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65,
-	0x72, 0x65, 0x64, 0x42, 0x07, 0xc2, 0xff, 0x8e, 0x02, 0x02, 0x52, 0x00, 0x48, 0x00, 0x52, 0x09,
-	0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x65, 0x64, 0x12, 0x4f, 0x0a, 0x08, 0x61, 0x72, 0x63,
-	0x68, 0x69, 0x76, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x6f, 0x35,
-	0x2e, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x69, 0x67,
-	0x67, 0x65, 0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x2e, 0x41, 0x72, 0x63,
-	0x68, 0x69, 0x76, 0x65, 0x64, 0x42, 0x07, 0xc2, 0xff, 0x8e, 0x02, 0x02, 0x52, 0x00, 0x48, 0x00,
-	0x52, 0x08, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x1a, 0xf0, 0x01, 0x0a, 0x07, 0x43,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x12, 0x31, 0x0a, 0x0c, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65,
-	0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0e, 0xba, 0x48,
-	0x03, 0xc8, 0x01, 0x01, 0xc2, 0xff, 0x8e, 0x02, 0x03, 0xf2, 0x01, 0x00, 0x52, 0x0b, 0x74, 0x72,
-	0x69, 0x67, 0x67, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x29, 0x0a, 0x08, 0x61, 0x70, 0x70,
-	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0e, 0xba, 0x48, 0x03,
-	0xc8, 0x01, 0x01, 0xc2, 0xff, 0x8e, 0x02, 0x03, 0xf2, 0x01, 0x00, 0x52, 0x07, 0x61, 0x70, 0x70,
-	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x22, 0x0a, 0x04, 0x63, 0x72, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x2e, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72,
+	0x65, 0x64, 0x42, 0x07, 0xc2, 0xff, 0x8e, 0x02, 0x02, 0x52, 0x00, 0x48, 0x00, 0x52, 0x08, 0x72,
+	0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x64, 0x12, 0x58, 0x0a, 0x0b, 0x72, 0x65, 0x73, 0x63, 0x68,
+	0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x6f,
+	0x35, 0x2e, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x69,
+	0x67, 0x67, 0x65, 0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x2e, 0x52, 0x65,
+	0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x42, 0x07, 0xc2, 0xff, 0x8e, 0x02, 0x02,
+	0x52, 0x00, 0x48, 0x00, 0x52, 0x0b, 0x72, 0x65, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
+	0x64, 0x12, 0x4c, 0x0a, 0x07, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x65, 0x64, 0x18, 0x0c, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x27, 0x2e, 0x6f, 0x35, 0x2e, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x2e,
+	0x76, 0x31, 0x2e, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54,
+	0x79, 0x70, 0x65, 0x2e, 0x46, 0x61, 0x75, 0x6c, 0x74, 0x65, 0x64, 0x42, 0x07, 0xc2, 0xff, 0x8e,
+	0x02, 0x02, 0x52, 0x00, 0x48, 0x00, 0x52, 0x07, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x65, 0x64, 0x1a,
+	0xb0, 0x09, 0x0a, 0x07, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x12, 0x31, 0x0a, 0x0c, 0x74,
+	0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x0e, 0xba, 0x48, 0x03, 0xc8, 0x01, 0x01, 0xc2, 0xff, 0x8e, 0x02, 0x03, 0xf2, 0x01,
+	0x00, 0x52, 0x0b, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x29,
+	0x0a, 0x08, 0x61, 0x70, 0x70, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x0e, 0xba, 0x48, 0x03, 0xc8, 0x01, 0x01, 0xc2, 0xff, 0x8e, 0x02, 0x03, 0xf2, 0x01, 0x00,
+	0x52, 0x07, 0x61, 0x70, 0x70, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x04, 0x63, 0x72, 0x6f,
+	0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xc2, 0xff, 0x8e, 0x02, 0x03, 0xf2, 0x01,
+	0x00, 0x52, 0x04, 0x63, 0x72, 0x6f, 0x6e, 0x12, 0x5a, 0x0a, 0x10, 0x72, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x5f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x20, 0x2e, 0x6a, 0x35, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x69, 0x6e, 0x67,
+	0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x64,
+	0x61, 0x74, 0x61, 0x42, 0x0d, 0xba, 0x48, 0x03, 0xc8, 0x01, 0x01, 0xc2, 0xff, 0x8e, 0x02, 0x02,
+	0x52, 0x00, 0x52, 0x0f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x64,
+	0x61, 0x74, 0x61, 0x12, 0x48, 0x0a, 0x08, 0x63, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x75, 0x70, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1c, 0x2e, 0x6f, 0x35, 0x2e, 0x74, 0x72, 0x69, 0x67, 0x67,
+	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x74, 0x63, 0x68, 0x55, 0x70, 0x50, 0x6f, 0x6c,
+	0x69, 0x63, 0x79, 0x42, 0x0f, 0xba, 0x48, 0x05, 0x82, 0x01, 0x02, 0x10, 0x01, 0xc2, 0xff, 0x8e,
+	0x02, 0x02, 0x5a, 0x00, 0x52, 0x07, 0x63, 0x61, 0x74, 0x63, 0x68, 0x55, 0x70, 0x12, 0x40, 0x0a,
+	0x06, 0x72, 0x75, 0x6e, 0x5f, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x08, 0xc2, 0xff, 0x8e, 0x02, 0x03,
+	0xaa, 0x02, 0x00, 0x48, 0x00, 0x52, 0x05, 0x72, 0x75, 0x6e, 0x41, 0x74, 0x88, 0x01, 0x01, 0x12,
+	0x41, 0x0a, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x17, 0x2e, 0x6f, 0x35, 0x2e, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x2e, 0x76,
+	0x31, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x42, 0x07, 0xc2, 0xff, 0x8e, 0x02,
+	0x02, 0x52, 0x00, 0x48, 0x01, 0x52, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x88,
+	0x01, 0x01, 0x12, 0x48, 0x0a, 0x0a, 0x6e, 0x6f, 0x74, 0x5f, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x42, 0x08, 0xc2, 0xff, 0x8e, 0x02, 0x03, 0xaa, 0x02, 0x00, 0x48, 0x02, 0x52, 0x09,
+	0x6e, 0x6f, 0x74, 0x42, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x88, 0x01, 0x01, 0x12, 0x46, 0x0a, 0x09,
+	0x6e, 0x6f, 0x74, 0x5f, 0x61, 0x66, 0x74, 0x65, 0x72, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x08, 0xc2, 0xff, 0x8e,
+	0x02, 0x03, 0xaa, 0x02, 0x00, 0x48, 0x03, 0x52, 0x08, 0x6e, 0x6f, 0x74, 0x41, 0x66, 0x74, 0x65,
+	0x72, 0x88, 0x01, 0x01, 0x12, 0x2a, 0x0a, 0x09, 0x6d, 0x61, 0x78, 0x5f, 0x66, 0x69, 0x72, 0x65,
+	0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x05, 0x42, 0x08, 0xc2, 0xff, 0x8e, 0x02, 0x03, 0xfa, 0x01,
+	0x00, 0x48, 0x04, 0x52, 0x08, 0x6d, 0x61, 0x78, 0x46, 0x69, 0x72, 0x65, 0x73, 0x88, 0x01, 0x01,
+	0x12, 0x24, 0x0a, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e, 0x65, 0x18, 0x0b, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x08, 0xc2, 0xff, 0x8e, 0x02, 0x03, 0xf2, 0x01, 0x00, 0x52, 0x08, 0x74, 0x69,
+	0x6d, 0x65, 0x7a, 0x6f, 0x6e, 0x65, 0x12, 0x48, 0x0a, 0x0a, 0x64, 0x73, 0x74, 0x5f, 0x70, 0x6f,
+	0x6c, 0x69, 0x63, 0x79, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x6f, 0x35, 0x2e,
+	0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x53, 0x54, 0x50, 0x6f,
+	0x6c, 0x69, 0x63, 0x79, 0x42, 0x0f, 0xba, 0x48, 0x05, 0x82, 0x01, 0x02, 0x10, 0x01, 0xc2, 0xff,
+	0x8e, 0x02, 0x02, 0x5a, 0x00, 0x52, 0x09, 0x64, 0x73, 0x74, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79,
+	0x12, 0x2f, 0x0a, 0x0e, 0x6a, 0x69, 0x74, 0x74, 0x65, 0x72, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e,
+	0x64, 0x73, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x05, 0x42, 0x08, 0xc2, 0xff, 0x8e, 0x02, 0x03, 0xfa,
+	0x01, 0x00, 0x52, 0x0d, 0x6a, 0x69, 0x74, 0x74, 0x65, 0x72, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64,
+	0x73, 0x12, 0x48, 0x0a, 0x0b, 0x63, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x5f, 0x69, 0x64,
+	0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x42, 0x22, 0xba, 0x48, 0x15, 0x72, 0x13, 0x32, 0x11, 0x5e,
+	0x5b, 0x30, 0x2d, 0x39, 0x41, 0x2d, 0x5a, 0x61, 0x2d, 0x7a, 0x5d, 0x7b, 0x32, 0x32, 0x7d, 0x24,
+	0xc2, 0xff, 0x8e, 0x02, 0x05, 0xb2, 0x02, 0x02, 0x08, 0x03, 0x48, 0x05, 0x52, 0x0a, 0x63, 0x61,
+	0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x51, 0x0a, 0x0d, 0x63,
+	0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x5f, 0x72, 0x6f, 0x6c, 0x6c, 0x18, 0x0f, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x6f, 0x35, 0x2e, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x2e,
+	0x76, 0x31, 0x2e, 0x43, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x52, 0x6f, 0x6c, 0x6c, 0x42,
+	0x0f, 0xba, 0x48, 0x05, 0x82, 0x01, 0x02, 0x10, 0x01, 0xc2, 0xff, 0x8e, 0x02, 0x02, 0x5a, 0x00,
+	0x52, 0x0c, 0x63, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x52, 0x6f, 0x6c, 0x6c, 0x12, 0x3c,
+	0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x14, 0x2e, 0x6a, 0x35, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x61, 0x6e, 0x79, 0x2e, 0x76,
+	0x31, 0x2e, 0x41, 0x6e, 0x79, 0x42, 0x07, 0xc2, 0xff, 0x8e, 0x02, 0x02, 0x4a, 0x00, 0x48, 0x06,
+	0x52, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x88, 0x01, 0x01, 0x12, 0x4b, 0x0a, 0x0c,
+	0x6e, 0x65, 0x78, 0x74, 0x5f, 0x66, 0x69, 0x72, 0x65, 0x5f, 0x61, 0x74, 0x18, 0x11, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x08,
+	0xc2, 0xff, 0x8e, 0x02, 0x03, 0xaa, 0x02, 0x00, 0x48, 0x07, 0x52, 0x0a, 0x6e, 0x65, 0x78, 0x74,
+	0x46, 0x69, 0x72, 0x65, 0x41, 0x74, 0x88, 0x01, 0x01, 0x3a, 0x07, 0xc2, 0xff, 0x8e, 0x02, 0x02,
+	0x52, 0x00, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x72, 0x75, 0x6e, 0x5f, 0x61, 0x74, 0x42, 0x0b, 0x0a,
+	0x09, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x6e,
+	0x6f, 0x74, 0x5f, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x6e, 0x6f,
+	0x74, 0x5f, 0x61, 0x66, 0x74, 0x65, 0x72, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x6d, 0x61, 0x78, 0x5f,
+	0x66, 0x69, 0x72, 0x65, 0x73, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x63, 0x61, 0x6c, 0x65, 0x6e, 0x64,
+	0x61, 0x72, 0x5f, 0x69, 0x64, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61,
+	0x64, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x66, 0x69, 0x72, 0x65, 0x5f,
+	0x61, 0x74, 0x1a, 0xb0, 0x09, 0x0a, 0x07, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x12, 0x31,
+	0x0a, 0x0c, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x0e, 0xba, 0x48, 0x03, 0xc8, 0x01, 0x01, 0xc2, 0xff, 0x8e, 0x02,
+	0x03, 0xf2, 0x01, 0x00, 0x52, 0x0b, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x4e, 0x61, 0x6d,
+	0x65, 0x12, 0x29, 0x0a, 0x08, 0x61, 0x70, 0x70, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x0e, 0xba, 0x48, 0x03, 0xc8, 0x01, 0x01, 0xc2, 0xff, 0x8e, 0x02, 0x03,
+	0xf2, 0x01, 0x00, 0x52, 0x07, 0x61, 0x70, 0x70, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x04,
+	0x63, 0x72, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xc2, 0xff, 0x8e, 0x02,
+	0x03, 0xf2, 0x01, 0x00, 0x52, 0x04, 0x63, 0x72, 0x6f, 0x6e, 0x12, 0x5a, 0x0a, 0x10, 0x72, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6a, 0x35, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x42, 0x0d, 0xba, 0x48, 0x03, 0xc8, 0x01, 0x01, 0xc2, 0xff,
+	0x8e, 0x02, 0x02, 0x52, 0x00, 0x52, 0x0f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x48, 0x0a, 0x08, 0x63, 0x61, 0x74, 0x63, 0x68, 0x5f,
+	0x75, 0x70, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1c, 0x2e, 0x6f, 0x35, 0x2e, 0x74, 0x72,
+	0x69, 0x67, 0x67, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x74, 0x63, 0x68, 0x55, 0x70,
+	0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x42, 0x0f, 0xba, 0x48, 0x05, 0x82, 0x01, 0x02, 0x10, 0x01,
+	0xc2, 0xff, 0x8e, 0x02, 0x02, 0x5a, 0x00, 0x52, 0x07, 0x63, 0x61, 0x74, 0x63, 0x68, 0x55, 0x70,
+	0x12, 0x40, 0x0a, 0x06, 0x72, 0x75, 0x6e, 0x5f, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x08, 0xc2, 0xff,
+	0x8e, 0x02, 0x03, 0xaa, 0x02, 0x00, 0x48, 0x00, 0x52, 0x05, 0x72, 0x75, 0x6e, 0x41, 0x74, 0x88,
+	0x01, 0x01, 0x12, 0x41, 0x0a, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6f, 0x35, 0x2e, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65,
+	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x42, 0x07, 0xc2,
+	0xff, 0x8e, 0x02, 0x02, 0x52, 0x00, 0x48, 0x01, 0x52, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76,
+	0x61, 0x6c, 0x88, 0x01, 0x01, 0x12, 0x48, 0x0a, 0x0a, 0x6e, 0x6f, 0x74, 0x5f, 0x62, 0x65, 0x66,
+	0x6f, 0x72, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x08, 0xc2, 0xff, 0x8e, 0x02, 0x03, 0xaa, 0x02, 0x00, 0x48,
+	0x02, 0x52, 0x09, 0x6e, 0x6f, 0x74, 0x42, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x88, 0x01, 0x01, 0x12,
+	0x46, 0x0a, 0x09, 0x6e, 0x6f, 0x74, 0x5f, 0x61, 0x66, 0x74, 0x65, 0x72, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x08,
+	0xc2, 0xff, 0x8e, 0x02, 0x03, 0xaa, 0x02, 0x00, 0x48, 0x03, 0x52, 0x08, 0x6e, 0x6f, 0x74, 0x41,
+	0x66, 0x74, 0x65, 0x72, 0x88, 0x01, 0x01, 0x12, 0x2a, 0x0a, 0x09, 0x6d, 0x61, 0x78, 0x5f, 0x66,
+	0x69, 0x72, 0x65, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x05, 0x42, 0x08, 0xc2, 0xff, 0x8e, 0x02,
+	0x03, 0xfa, 0x01, 0x00, 0x48, 0x04, 0x52, 0x08, 0x6d, 0x61, 0x78, 0x46, 0x69, 0x72, 0x65, 0x73,
+	0x88, 0x01, 0x01, 0x12, 0x24, 0x0a, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e, 0x65, 0x18,
+	0x0b, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xc2, 0xff, 0x8e, 0x02, 0x03, 0xf2, 0x01, 0x00, 0x52,
+	0x08, 0x74, 0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e, 0x65, 0x12, 0x48, 0x0a, 0x0a, 0x64, 0x73, 0x74,
+	0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e,
+	0x6f, 0x35, 0x2e, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x53,
+	0x54, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x42, 0x0f, 0xba, 0x48, 0x05, 0x82, 0x01, 0x02, 0x10,
+	0x01, 0xc2, 0xff, 0x8e, 0x02, 0x02, 0x5a, 0x00, 0x52, 0x09, 0x64, 0x73, 0x74, 0x50, 0x6f, 0x6c,
+	0x69, 0x63, 0x79, 0x12, 0x2f, 0x0a, 0x0e, 0x6a, 0x69, 0x74, 0x74, 0x65, 0x72, 0x5f, 0x73, 0x65,
+	0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x05, 0x42, 0x08, 0xc2, 0xff, 0x8e,
+	0x02, 0x03, 0xfa, 0x01, 0x00, 0x52, 0x0d, 0x6a, 0x69, 0x74, 0x74, 0x65, 0x72, 0x53, 0x65, 0x63,
+	0x6f, 0x6e, 0x64, 0x73, 0x12, 0x48, 0x0a, 0x0b, 0x63, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72,
+	0x5f, 0x69, 0x64, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x42, 0x22, 0xba, 0x48, 0x15, 0x72, 0x13,
+	0x32, 0x11, 0x5e, 0x5b, 0x30, 0x2d, 0x39, 0x41, 0x2d, 0x5a, 0x61, 0x2d, 0x7a, 0x5d, 0x7b, 0x32,
+	0x32, 0x7d, 0x24, 0xc2, 0xff, 0x8e, 0x02, 0x05, 0xb2, 0x02, 0x02, 0x08, 0x03, 0x48, 0x05, 0x52,
+	0x0a, 0x63, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x51,
+	0x0a, 0x0d, 0x63, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x5f, 0x72, 0x6f, 0x6c, 0x6c, 0x18,
+	0x0f, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x6f, 0x35, 0x2e, 0x74, 0x72, 0x69, 0x67, 0x67,
+	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x52, 0x6f,
+	0x6c, 0x6c, 0x42, 0x0f, 0xba, 0x48, 0x05, 0x82, 0x01, 0x02, 0x10, 0x01, 0xc2, 0xff, 0x8e, 0x02,
+	0x02, 0x5a, 0x00, 0x52, 0x0c, 0x63, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x52, 0x6f, 0x6c,
+	0x6c, 0x12, 0x3c, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x10, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6a, 0x35, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x61, 0x6e,
+	0x79, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x6e, 0x79, 0x42, 0x07, 0xc2, 0xff, 0x8e, 0x02, 0x02, 0x4a,
+	0x00, 0x48, 0x06, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x88, 0x01, 0x01, 0x12,
+	0x4b, 0x0a, 0x0c, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x66, 0x69, 0x72, 0x65, 0x5f, 0x61, 0x74, 0x18,
+	0x11, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x42, 0x08, 0xc2, 0xff, 0x8e, 0x02, 0x03, 0xaa, 0x02, 0x00, 0x48, 0x07, 0x52, 0x0a, 0x6e,
+	0x65, 0x78, 0x74, 0x46, 0x69, 0x72, 0x65, 0x41, 0x74, 0x88, 0x01, 0x01, 0x3a, 0x07, 0xc2, 0xff,
+	0x8e, 0x02, 0x02, 0x52, 0x00, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x72, 0x75, 0x6e, 0x5f, 0x61, 0x74,
+	0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x42, 0x0d, 0x0a,
+	0x0b, 0x5f, 0x6e, 0x6f, 0x74, 0x5f, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x42, 0x0c, 0x0a, 0x0a,
+	0x5f, 0x6e, 0x6f, 0x74, 0x5f, 0x61, 0x66, 0x74, 0x65, 0x72, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x6d,
+	0x61, 0x78, 0x5f, 0x66, 0x69, 0x72, 0x65, 0x73, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x63, 0x61, 0x6c,
+	0x65, 0x6e, 0x64, 0x61, 0x72, 0x5f, 0x69, 0x64, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x70, 0x61, 0x79,
+	0x6c, 0x6f, 0x61, 0x64, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x66, 0x69,
+	0x72, 0x65, 0x5f, 0x61, 0x74, 0x1a, 0x11, 0x0a, 0x06, 0x50, 0x61, 0x75, 0x73, 0x65, 0x64, 0x3a,
+	0x07, 0xc2, 0xff, 0x8e, 0x02, 0x02, 0x52, 0x00, 0x1a, 0x72, 0x0a, 0x09, 0x41, 0x63, 0x74, 0x69,
+	0x76, 0x61, 0x74, 0x65, 0x64, 0x12, 0x4b, 0x0a, 0x0c, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x66, 0x69,
+	0x72, 0x65, 0x5f, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x08, 0xc2, 0xff, 0x8e, 0x02, 0x03, 0xaa, 0x02,
+	0x00, 0x48, 0x00, 0x52, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x46, 0x69, 0x72, 0x65, 0x41, 0x74, 0x88,
+	0x01, 0x01, 0x3a, 0x07, 0xc2, 0xff, 0x8e, 0x02, 0x02, 0x52, 0x00, 0x42, 0x0f, 0x0a, 0x0d, 0x5f,
+	0x6e, 0x65, 0x78, 0x74, 0x5f, 0x66, 0x69, 0x72, 0x65, 0x5f, 0x61, 0x74, 0x1a, 0x6b, 0x0a, 0x11,
+	0x4d, 0x61, 0x6e, 0x75, 0x61, 0x6c, 0x6c, 0x79, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x65,
+	0x64, 0x12, 0x4d, 0x0a, 0x0c, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x5f, 0x74, 0x69, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x42, 0x0e, 0xba, 0x48, 0x03, 0xc8, 0x01, 0x01, 0xc2, 0xff, 0x8e, 0x02, 0x03,
+	0xaa, 0x02, 0x00, 0x52, 0x0b, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x54, 0x69, 0x6d, 0x65,
+	0x3a, 0x07, 0xc2, 0xff, 0x8e, 0x02, 0x02, 0x52, 0x00, 0x1a, 0xdf, 0x01, 0x0a, 0x09, 0x54, 0x72,
+	0x69, 0x67, 0x67, 0x65, 0x72, 0x65, 0x64, 0x12, 0x4d, 0x0a, 0x0c, 0x74, 0x72, 0x69, 0x67, 0x67,
+	0x65, 0x72, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x0e, 0xba, 0x48, 0x03, 0xc8, 0x01,
+	0x01, 0xc2, 0xff, 0x8e, 0x02, 0x03, 0xaa, 0x02, 0x00, 0x52, 0x0b, 0x74, 0x72, 0x69, 0x67, 0x67,
+	0x65, 0x72, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x04, 0x6c, 0x61, 0x74, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x08, 0x42, 0x08, 0xc2, 0xff, 0x8e, 0x02, 0x03, 0x8a, 0x02, 0x00, 0x52, 0x04,
+	0x6c, 0x61, 0x74, 0x65, 0x12, 0x4b, 0x0a, 0x0c, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x66, 0x69, 0x72,
+	0x65, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x08, 0xc2, 0xff, 0x8e, 0x02, 0x03, 0xaa, 0x02, 0x00,
+	0x48, 0x00, 0x52, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x46, 0x69, 0x72, 0x65, 0x41, 0x74, 0x88, 0x01,
+	0x01, 0x3a, 0x07, 0xc2, 0xff, 0x8e, 0x02, 0x02, 0x52, 0x00, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x6e,
+	0x65, 0x78, 0x74, 0x5f, 0x66, 0x69, 0x72, 0x65, 0x5f, 0x61, 0x74, 0x1a, 0x13, 0x0a, 0x08, 0x41,
+	0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x3a, 0x07, 0xc2, 0xff, 0x8e, 0x02, 0x02, 0x52, 0x00,
+	0x1a, 0x14, 0x0a, 0x09, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x3a, 0x07, 0xc2,
+	0xff, 0x8e, 0x02, 0x02, 0x52, 0x00, 0x1a, 0x12, 0x0a, 0x07, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65,
+	0x64, 0x3a, 0x07, 0xc2, 0xff, 0x8e, 0x02, 0x02, 0x52, 0x00, 0x1a, 0xbf, 0x01, 0x0a, 0x08, 0x52,
+	0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x64, 0x12, 0x26, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f,
+	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0e, 0xba, 0x48, 0x03, 0xc8, 0x01, 0x01, 0xc2,
+	0xff, 0x8e, 0x02, 0x03, 0xf2, 0x01, 0x00, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12,
+	0x24, 0x0a, 0x08, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x08, 0x42, 0x08, 0xc2, 0xff, 0x8e, 0x02, 0x03, 0x8a, 0x02, 0x00, 0x52, 0x08, 0x61, 0x63, 0x74,
+	0x69, 0x76, 0x61, 0x74, 0x65, 0x12, 0x4b, 0x0a, 0x0c, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x66, 0x69,
+	0x72, 0x65, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x08, 0xc2, 0xff, 0x8e, 0x02, 0x03, 0xaa, 0x02,
+	0x00, 0x48, 0x00, 0x52, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x46, 0x69, 0x72, 0x65, 0x41, 0x74, 0x88,
+	0x01, 0x01, 0x3a, 0x07, 0xc2, 0xff, 0x8e, 0x02, 0x02, 0x52, 0x00, 0x42, 0x0f, 0x0a, 0x0d, 0x5f,
+	0x6e, 0x65, 0x78, 0x74, 0x5f, 0x66, 0x69, 0x72, 0x65, 0x5f, 0x61, 0x74, 0x1a, 0x74, 0x0a, 0x0b,
+	0x52, 0x65, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x12, 0x4b, 0x0a, 0x0c, 0x6e,
+	0x65, 0x78, 0x74, 0x5f, 0x66, 0x69, 0x72, 0x65, 0x5f, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x08, 0xc2,
+	0xff, 0x8e, 0x02, 0x03, 0xaa, 0x02, 0x00, 0x48, 0x00, 0x52, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x46,
+	0x69, 0x72, 0x65, 0x41, 0x74, 0x88, 0x01, 0x01, 0x3a, 0x07, 0xc2, 0xff, 0x8e, 0x02, 0x02, 0x52,
+	0x00, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x66, 0x69, 0x72, 0x65, 0x5f,
+	0x61, 0x74, 0x1a, 0x38, 0x0a, 0x07, 0x46, 0x61, 0x75, 0x6c, 0x74, 0x65, 0x64, 0x12, 0x24, 0x0a,
+	0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0e, 0xba, 0x48,
+	0x03, 0xc8, 0x01, 0x01, 0xc2, 0xff, 0x8e, 0x02, 0x03, 0xf2, 0x01, 0x00, 0x52, 0x05, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x3a, 0x07, 0xc2, 0xff, 0x8e, 0x02, 0x02, 0x52, 0x00, 0x3a, 0x07, 0xc2, 0xff,
+	0x8e, 0x02, 0x02, 0x5a, 0x00, 0x42, 0x06, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x22, 0x82, 0x02,
+	0x0a, 0x0c, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x45,
+	0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x6a, 0x35, 0x2e, 0x73, 0x74, 0x61, 0x74, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x42, 0x0d, 0xba, 0x48,
+	0x03, 0xc8, 0x01, 0x01, 0xc2, 0xff, 0x8e, 0x02, 0x02, 0x52, 0x00, 0x52, 0x08, 0x6d, 0x65, 0x74,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x3f, 0x0a, 0x04, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x6f, 0x35, 0x2e, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72,
+	0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x4b, 0x65, 0x79, 0x73, 0x42,
+	0x0f, 0xba, 0x48, 0x03, 0xc8, 0x01, 0x01, 0xc2, 0xff, 0x8e, 0x02, 0x04, 0x52, 0x02, 0x08, 0x01,
+	0x52, 0x04, 0x6b, 0x65, 0x79, 0x73, 0x12, 0x51, 0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6f, 0x35, 0x2e, 0x74, 0x72, 0x69, 0x67, 0x67,
+	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x42, 0x1a, 0xba, 0x48, 0x03, 0xc8, 0x01, 0x01, 0xc2, 0xff,
+	0x8e, 0x02, 0x02, 0x62, 0x00, 0x8a, 0xf7, 0x98, 0xc6, 0x02, 0x07, 0xaa, 0x01, 0x04, 0x52, 0x02,
+	0x08, 0x01, 0x52, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x3a, 0x17, 0xc2, 0xff, 0x8e, 0x02, 0x02,
+	0x52, 0x00, 0xea, 0x85, 0x8f, 0x02, 0x0b, 0x0a, 0x07, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72,
+	0x10, 0x03, 0x22, 0x74, 0x0a, 0x0c, 0x43, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x4b, 0x65,
+	0x79, 0x73, 0x12, 0x4a, 0x0a, 0x0b, 0x63, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x29, 0xba, 0x48, 0x15, 0x72, 0x13, 0x32, 0x11,
+	0x5e, 0x5b, 0x30, 0x2d, 0x39, 0x41, 0x2d, 0x5a, 0x61, 0x2d, 0x7a, 0x5d, 0x7b, 0x32, 0x32, 0x7d,
+	0x24, 0xc2, 0xff, 0x8e, 0x02, 0x05, 0xb2, 0x02, 0x02, 0x08, 0x03, 0xea, 0x85, 0x8f, 0x02, 0x02,
+	0x08, 0x01, 0x52, 0x0a, 0x63, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x49, 0x64, 0x3a, 0x18,
+	0xc2, 0xff, 0x8e, 0x02, 0x02, 0x52, 0x00, 0xea, 0x85, 0x8f, 0x02, 0x0c, 0x0a, 0x08, 0x63, 0x61,
+	0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x10, 0x01, 0x22, 0xca, 0x01, 0x0a, 0x0c, 0x43, 0x61, 0x6c,
+	0x65, 0x6e, 0x64, 0x61, 0x72, 0x44, 0x61, 0x74, 0x61, 0x12, 0x22, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0e, 0xba, 0x48, 0x03, 0xc8, 0x01, 0x01, 0xc2,
+	0xff, 0x8e, 0x02, 0x03, 0xf2, 0x01, 0x00, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x47, 0x0a,
+	0x0e, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x64, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6a, 0x35, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73,
+	0x2e, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x61, 0x74, 0x65, 0x42, 0x08, 0xc2,
+	0xff, 0x8e, 0x02, 0x03, 0xaa, 0x01, 0x00, 0x52, 0x0d, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65,
+	0x64, 0x44, 0x61, 0x74, 0x65, 0x73, 0x12, 0x33, 0x0a, 0x10, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64,
+	0x65, 0x5f, 0x77, 0x65, 0x65, 0x6b, 0x65, 0x6e, 0x64, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08,
+	0x42, 0x08, 0xc2, 0xff, 0x8e, 0x02, 0x03, 0x8a, 0x02, 0x00, 0x52, 0x0f, 0x65, 0x78, 0x63, 0x6c,
+	0x75, 0x64, 0x65, 0x57, 0x65, 0x65, 0x6b, 0x65, 0x6e, 0x64, 0x73, 0x3a, 0x18, 0xc2, 0xff, 0x8e,
+	0x02, 0x02, 0x52, 0x00, 0xea, 0x85, 0x8f, 0x02, 0x0c, 0x0a, 0x08, 0x63, 0x61, 0x6c, 0x65, 0x6e,
+	0x64, 0x61, 0x72, 0x10, 0x04, 0x22, 0xca, 0x02, 0x0a, 0x0d, 0x43, 0x61, 0x6c, 0x65, 0x6e, 0x64,
+	0x61, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x45, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64,
+	0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x6a, 0x35, 0x2e, 0x73,
+	0x74, 0x61, 0x74, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x74,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0x42, 0x0d, 0xba, 0x48, 0x03, 0xc8, 0x01, 0x01, 0xc2, 0xff, 0x8e,
+	0x02, 0x02, 0x52, 0x00, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x40,
+	0x0a, 0x04, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6f,
+	0x35, 0x2e, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x6c,
+	0x65, 0x6e, 0x64, 0x61, 0x72, 0x4b, 0x65, 0x79, 0x73, 0x42, 0x0f, 0xba, 0x48, 0x03, 0xc8, 0x01,
+	0x01, 0xc2, 0xff, 0x8e, 0x02, 0x04, 0x52, 0x02, 0x08, 0x01, 0x52, 0x04, 0x6b, 0x65, 0x79, 0x73,
+	0x12, 0x3e, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b,
+	0x2e, 0x6f, 0x35, 0x2e, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43,
+	0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x44, 0x61, 0x74, 0x61, 0x42, 0x0d, 0xba, 0x48, 0x03,
+	0xc8, 0x01, 0x01, 0xc2, 0xff, 0x8e, 0x02, 0x02, 0x52, 0x00, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61,
+	0x12, 0x56, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x1d, 0x2e, 0x6f, 0x35, 0x2e, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x2e, 0x76, 0x31,
+	0x2e, 0x43, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x42,
+	0x1f, 0xba, 0x48, 0x08, 0xc8, 0x01, 0x01, 0x82, 0x01, 0x02, 0x10, 0x01, 0xc2, 0xff, 0x8e, 0x02,
+	0x02, 0x5a, 0x00, 0x8a, 0xf7, 0x98, 0xc6, 0x02, 0x07, 0xa2, 0x01, 0x04, 0x52, 0x02, 0x08, 0x01,
+	0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x3a, 0x18, 0xc2, 0xff, 0x8e, 0x02, 0x02, 0x52,
+	0x00, 0xea, 0x85, 0x8f, 0x02, 0x0c, 0x0a, 0x08, 0x63, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72,
+	0x10, 0x02, 0x22, 0x97, 0x05, 0x0a, 0x11, 0x43, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x4d, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x6f, 0x35, 0x2e, 0x74,
+	0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x6c, 0x65, 0x6e, 0x64,
+	0x61, 0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x2e, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x42, 0x07, 0xc2, 0xff, 0x8e, 0x02, 0x02, 0x52, 0x00, 0x48, 0x00, 0x52, 0x07,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x12, 0x4d, 0x0a, 0x07, 0x75, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x6f, 0x35, 0x2e, 0x74, 0x72,
+	0x69, 0x67, 0x67, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61,
+	0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x64, 0x42, 0x07, 0xc2, 0xff, 0x8e, 0x02, 0x02, 0x52, 0x00, 0x48, 0x00, 0x52, 0x07, 0x75,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x12, 0x50, 0x0a, 0x08, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76,
+	0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x6f, 0x35, 0x2e, 0x74, 0x72,
+	0x69, 0x67, 0x67, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61,
+	0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x2e, 0x41, 0x72, 0x63, 0x68, 0x69,
+	0x76, 0x65, 0x64, 0x42, 0x07, 0xc2, 0xff, 0x8e, 0x02, 0x02, 0x52, 0x00, 0x48, 0x00, 0x52, 0x08,
+	0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x1a, 0xb4, 0x01, 0x0a, 0x07, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x12, 0x22, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
 	0x28, 0x09, 0x42, 0x0e, 0xba, 0x48, 0x03, 0xc8, 0x01, 0x01, 0xc2, 0xff, 0x8e, 0x02, 0x03, 0xf2,
-	0x01, 0x00, 0x52, 0x04, 0x63, 0x72, 0x6f, 0x6e, 0x12, 0x5a, 0x0a, 0x10, 0x72, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x5f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6a, 0x35, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x69, 0x6e,
-	0x67, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4d, 0x65, 0x74, 0x61,
-	0x64, 0x61, 0x74, 0x61, 0x42, 0x0d, 0xba, 0x48, 0x03, 0xc8, 0x01, 0x01, 0xc2, 0xff, 0x8e, 0x02,
-	0x02, 0x52, 0x00, 0x52, 0x0f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4d, 0x65, 0x74, 0x61,
-	0x64, 0x61, 0x74, 0x61, 0x3a, 0x07, 0xc2, 0xff, 0x8e, 0x02, 0x02, 0x52, 0x00, 0x1a, 0xf0, 0x01,
-	0x0a, 0x07, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x12, 0x31, 0x0a, 0x0c, 0x74, 0x72, 0x69,
-	0x67, 0x67, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
-	0x0e, 0xba, 0x48, 0x03, 0xc8, 0x01, 0x01, 0xc2, 0xff, 0x8e, 0x02, 0x03, 0xf2, 0x01, 0x00, 0x52,
-	0x0b, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x29, 0x0a, 0x08,
-	0x61, 0x70, 0x70, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0e,
-	0xba, 0x48, 0x03, 0xc8, 0x01, 0x01, 0xc2, 0xff, 0x8e, 0x02, 0x03, 0xf2, 0x01, 0x00, 0x52, 0x07,
-	0x61, 0x70, 0x70, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x22, 0x0a, 0x04, 0x63, 0x72, 0x6f, 0x6e, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0e, 0xba, 0x48, 0x03, 0xc8, 0x01, 0x01, 0xc2, 0xff, 0x8e,
-	0x02, 0x03, 0xf2, 0x01, 0x00, 0x52, 0x04, 0x63, 0x72, 0x6f, 0x6e, 0x12, 0x5a, 0x0a, 0x10, 0x72,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6a, 0x35, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4d,
-	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x42, 0x0d, 0xba, 0x48, 0x03, 0xc8, 0x01, 0x01, 0xc2,
-	0xff, 0x8e, 0x02, 0x02, 0x52, 0x00, 0x52, 0x0f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4d,
-	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x3a, 0x07, 0xc2, 0xff, 0x8e, 0x02, 0x02, 0x52, 0x00,
-	0x1a, 0x11, 0x0a, 0x06, 0x50, 0x61, 0x75, 0x73, 0x65, 0x64, 0x3a, 0x07, 0xc2, 0xff, 0x8e, 0x02,
-	0x02, 0x52, 0x00, 0x1a, 0x14, 0x0a, 0x09, 0x41, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x64,
-	0x3a, 0x07, 0xc2, 0xff, 0x8e, 0x02, 0x02, 0x52, 0x00, 0x1a, 0x6b, 0x0a, 0x11, 0x4d, 0x61, 0x6e,
-	0x75, 0x61, 0x6c, 0x6c, 0x79, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x65, 0x64, 0x12, 0x4d,
-	0x0a, 0x0c, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x01,
+	0x01, 0x00, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x47, 0x0a, 0x0e, 0x65, 0x78, 0x63, 0x6c,
+	0x75, 0x64, 0x65, 0x64, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x16, 0x2e, 0x6a, 0x35, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x64, 0x61, 0x74, 0x65,
+	0x2e, 0x76, 0x31, 0x2e, 0x44, 0x61, 0x74, 0x65, 0x42, 0x08, 0xc2, 0xff, 0x8e, 0x02, 0x03, 0xaa,
+	0x01, 0x00, 0x52, 0x0d, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x64, 0x44, 0x61, 0x74, 0x65,
+	0x73, 0x12, 0x33, 0x0a, 0x10, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x77, 0x65, 0x65,
+	0x6b, 0x65, 0x6e, 0x64, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x42, 0x08, 0xc2, 0xff, 0x8e,
+	0x02, 0x03, 0x8a, 0x02, 0x00, 0x52, 0x0f, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x57, 0x65,
+	0x65, 0x6b, 0x65, 0x6e, 0x64, 0x73, 0x3a, 0x07, 0xc2, 0xff, 0x8e, 0x02, 0x02, 0x52, 0x00, 0x1a,
+	0xb4, 0x01, 0x0a, 0x07, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x12, 0x22, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0e, 0xba, 0x48, 0x03, 0xc8, 0x01,
+	0x01, 0xc2, 0xff, 0x8e, 0x02, 0x03, 0xf2, 0x01, 0x00, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x47, 0x0a, 0x0e, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x64, 0x5f, 0x64, 0x61, 0x74, 0x65,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6a, 0x35, 0x2e, 0x74, 0x79, 0x70,
+	0x65, 0x73, 0x2e, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x61, 0x74, 0x65, 0x42,
+	0x08, 0xc2, 0xff, 0x8e, 0x02, 0x03, 0xaa, 0x01, 0x00, 0x52, 0x0d, 0x65, 0x78, 0x63, 0x6c, 0x75,
+	0x64, 0x65, 0x64, 0x44, 0x61, 0x74, 0x65, 0x73, 0x12, 0x33, 0x0a, 0x10, 0x65, 0x78, 0x63, 0x6c,
+	0x75, 0x64, 0x65, 0x5f, 0x77, 0x65, 0x65, 0x6b, 0x65, 0x6e, 0x64, 0x73, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x08, 0x42, 0x08, 0xc2, 0xff, 0x8e, 0x02, 0x03, 0x8a, 0x02, 0x00, 0x52, 0x0f, 0x65, 0x78,
+	0x63, 0x6c, 0x75, 0x64, 0x65, 0x57, 0x65, 0x65, 0x6b, 0x65, 0x6e, 0x64, 0x73, 0x3a, 0x07, 0xc2,
+	0xff, 0x8e, 0x02, 0x02, 0x52, 0x00, 0x1a, 0x13, 0x0a, 0x08, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76,
+	0x65, 0x64, 0x3a, 0x07, 0xc2, 0xff, 0x8e, 0x02, 0x02, 0x52, 0x00, 0x3a, 0x07, 0xc2, 0xff, 0x8e,
+	0x02, 0x02, 0x5a, 0x00, 0x42, 0x06, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x22, 0x86, 0x02, 0x0a,
+	0x0d, 0x43, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x45,
+	0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x6a, 0x35, 0x2e, 0x73, 0x74, 0x61, 0x74, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x42, 0x0d, 0xba, 0x48,
+	0x03, 0xc8, 0x01, 0x01, 0xc2, 0xff, 0x8e, 0x02, 0x02, 0x52, 0x00, 0x52, 0x08, 0x6d, 0x65, 0x74,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x40, 0x0a, 0x04, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6f, 0x35, 0x2e, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72,
+	0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x4b, 0x65, 0x79, 0x73,
+	0x42, 0x0f, 0xba, 0x48, 0x03, 0xc8, 0x01, 0x01, 0xc2, 0xff, 0x8e, 0x02, 0x04, 0x52, 0x02, 0x08,
+	0x01, 0x52, 0x04, 0x6b, 0x65, 0x79, 0x73, 0x12, 0x52, 0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6f, 0x35, 0x2e, 0x74, 0x72, 0x69, 0x67,
+	0x67, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x42, 0x1a, 0xba, 0x48, 0x03, 0xc8, 0x01, 0x01,
+	0xc2, 0xff, 0x8e, 0x02, 0x02, 0x62, 0x00, 0x8a, 0xf7, 0x98, 0xc6, 0x02, 0x07, 0xaa, 0x01, 0x04,
+	0x52, 0x02, 0x08, 0x01, 0x52, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x3a, 0x18, 0xc2, 0xff, 0x8e,
+	0x02, 0x02, 0x52, 0x00, 0xea, 0x85, 0x8f, 0x02, 0x0c, 0x0a, 0x08, 0x63, 0x61, 0x6c, 0x65, 0x6e,
+	0x64, 0x61, 0x72, 0x10, 0x03, 0x22, 0xee, 0x22, 0x0a, 0x0a, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x54, 0x79, 0x70, 0x65, 0x12, 0x43, 0x0a, 0x06, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6f, 0x35, 0x2e, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65,
+	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x2e,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x07, 0xc2, 0xff, 0x8e, 0x02, 0x02, 0x52, 0x00, 0x48,
+	0x00, 0x52, 0x06, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x12, 0x43, 0x0a, 0x06, 0x75, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6f, 0x35, 0x2e, 0x74,
+	0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x54, 0x79, 0x70, 0x65, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x07, 0xc2, 0xff, 0x8e,
+	0x02, 0x02, 0x52, 0x00, 0x48, 0x00, 0x52, 0x06, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x46,
+	0x0a, 0x07, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x21, 0x2e, 0x6f, 0x35, 0x2e, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e,
+	0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x2e, 0x41, 0x72, 0x63, 0x68, 0x69,
+	0x76, 0x65, 0x42, 0x07, 0xc2, 0xff, 0x8e, 0x02, 0x02, 0x52, 0x00, 0x48, 0x00, 0x52, 0x07, 0x61,
+	0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x12, 0x5c, 0x0a, 0x0f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x5f, 0x63, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x28, 0x2e, 0x6f, 0x35, 0x2e, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e,
+	0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x43, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x42, 0x07, 0xc2, 0xff, 0x8e, 0x02, 0x02,
+	0x52, 0x00, 0x48, 0x00, 0x52, 0x0e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x61, 0x6c, 0x65,
+	0x6e, 0x64, 0x61, 0x72, 0x12, 0x5c, 0x0a, 0x0f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x63,
+	0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e,
+	0x6f, 0x35, 0x2e, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43,
+	0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x42, 0x07, 0xc2, 0xff, 0x8e, 0x02, 0x02, 0x52, 0x00,
+	0x48, 0x00, 0x52, 0x0e, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x61, 0x6c, 0x65, 0x6e, 0x64,
+	0x61, 0x72, 0x12, 0x5f, 0x0a, 0x10, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x5f, 0x63, 0x61,
+	0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x6f,
+	0x35, 0x2e, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x2e, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x43,
+	0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x42, 0x07, 0xc2, 0xff, 0x8e, 0x02, 0x02, 0x52, 0x00,
+	0x48, 0x00, 0x52, 0x0f, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x43, 0x61, 0x6c, 0x65, 0x6e,
+	0x64, 0x61, 0x72, 0x12, 0x3d, 0x0a, 0x04, 0x73, 0x79, 0x6e, 0x63, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1e, 0x2e, 0x6f, 0x35, 0x2e, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x2e, 0x76,
+	0x31, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x2e, 0x53, 0x79, 0x6e,
+	0x63, 0x42, 0x07, 0xc2, 0xff, 0x8e, 0x02, 0x02, 0x52, 0x00, 0x48, 0x00, 0x52, 0x04, 0x73, 0x79,
+	0x6e, 0x63, 0x12, 0x40, 0x0a, 0x05, 0x70, 0x61, 0x75, 0x73, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1f, 0x2e, 0x6f, 0x35, 0x2e, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x2e, 0x76,
+	0x31, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x2e, 0x50, 0x61, 0x75,
+	0x73, 0x65, 0x42, 0x07, 0xc2, 0xff, 0x8e, 0x02, 0x02, 0x52, 0x00, 0x48, 0x00, 0x52, 0x05, 0x70,
+	0x61, 0x75, 0x73, 0x65, 0x12, 0x43, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6f, 0x35, 0x2e, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65,
+	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x2e,
+	0x52, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x42, 0x07, 0xc2, 0xff, 0x8e, 0x02, 0x02, 0x52, 0x00, 0x48,
+	0x00, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x12, 0x5f, 0x0a, 0x10, 0x6d, 0x61, 0x6e,
+	0x75, 0x61, 0x6c, 0x6c, 0x79, 0x5f, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x18, 0x0a, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x6f, 0x35, 0x2e, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72,
+	0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x2e, 0x4d,
+	0x61, 0x6e, 0x75, 0x61, 0x6c, 0x6c, 0x79, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x42, 0x07,
+	0xc2, 0xff, 0x8e, 0x02, 0x02, 0x52, 0x00, 0x48, 0x00, 0x52, 0x0f, 0x6d, 0x61, 0x6e, 0x75, 0x61,
+	0x6c, 0x6c, 0x79, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x12, 0x46, 0x0a, 0x07, 0x72, 0x65,
+	0x73, 0x74, 0x6f, 0x72, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x6f, 0x35,
+	0x2e, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x2e, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x42, 0x07,
+	0xc2, 0xff, 0x8e, 0x02, 0x02, 0x52, 0x00, 0x48, 0x00, 0x52, 0x07, 0x72, 0x65, 0x73, 0x74, 0x6f,
+	0x72, 0x65, 0x1a, 0xcc, 0x08, 0x0a, 0x06, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x12, 0x46, 0x0a,
+	0x0a, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x22, 0xba, 0x48, 0x15, 0x72, 0x13, 0x32, 0x11, 0x5e, 0x5b, 0x30, 0x2d, 0x39, 0x41,
+	0x2d, 0x5a, 0x61, 0x2d, 0x7a, 0x5d, 0x7b, 0x32, 0x32, 0x7d, 0x24, 0xc2, 0xff, 0x8e, 0x02, 0x05,
+	0xb2, 0x02, 0x02, 0x08, 0x03, 0x48, 0x00, 0x52, 0x09, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72,
+	0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x31, 0x0a, 0x0c, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72,
+	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0e, 0xba, 0x48, 0x03,
+	0xc8, 0x01, 0x01, 0xc2, 0xff, 0x8e, 0x02, 0x03, 0xf2, 0x01, 0x00, 0x52, 0x0b, 0x74, 0x72, 0x69,
+	0x67, 0x67, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x29, 0x0a, 0x08, 0x61, 0x70, 0x70, 0x5f,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0e, 0xba, 0x48, 0x03, 0xc8,
+	0x01, 0x01, 0xc2, 0xff, 0x8e, 0x02, 0x03, 0xf2, 0x01, 0x00, 0x52, 0x07, 0x61, 0x70, 0x70, 0x4e,
+	0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x04, 0x63, 0x72, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x08, 0xc2, 0xff, 0x8e, 0x02, 0x03, 0xf2, 0x01, 0x00, 0x52, 0x04, 0x63, 0x72, 0x6f,
+	0x6e, 0x12, 0x48, 0x0a, 0x08, 0x63, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x75, 0x70, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x1c, 0x2e, 0x6f, 0x35, 0x2e, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72,
+	0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x74, 0x63, 0x68, 0x55, 0x70, 0x50, 0x6f, 0x6c, 0x69, 0x63,
+	0x79, 0x42, 0x0f, 0xba, 0x48, 0x05, 0x82, 0x01, 0x02, 0x10, 0x01, 0xc2, 0xff, 0x8e, 0x02, 0x02,
+	0x5a, 0x00, 0x52, 0x07, 0x63, 0x61, 0x74, 0x63, 0x68, 0x55, 0x70, 0x12, 0x40, 0x0a, 0x06, 0x72,
+	0x75, 0x6e, 0x5f, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x08, 0xc2, 0xff, 0x8e, 0x02, 0x03, 0xaa, 0x02,
+	0x00, 0x48, 0x01, 0x52, 0x05, 0x72, 0x75, 0x6e, 0x41, 0x74, 0x88, 0x01, 0x01, 0x12, 0x41, 0x0a,
+	0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x17, 0x2e, 0x6f, 0x35, 0x2e, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e,
+	0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x42, 0x07, 0xc2, 0xff, 0x8e, 0x02, 0x02, 0x52,
+	0x00, 0x48, 0x02, 0x52, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x88, 0x01, 0x01,
+	0x12, 0x48, 0x0a, 0x0a, 0x6e, 0x6f, 0x74, 0x5f, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x18, 0x08,
 	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
 	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
-	0x42, 0x0e, 0xba, 0x48, 0x03, 0xc8, 0x01, 0x01, 0xc2, 0xff, 0x8e, 0x02, 0x03, 0xaa, 0x02, 0x00,
-	0x52, 0x0b, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x54, 0x69, 0x6d, 0x65, 0x3a, 0x07, 0xc2,
-	0xff, 0x8e, 0x02, 0x02, 0x52, 0x00, 0x1a, 0x63, 0x0a, 0x09, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65,
-	0x72, 0x65, 0x64, 0x12, 0x4d, 0x0a, 0x0c, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x5f, 0x74,
-	0x69, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
-	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
-	0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x0e, 0xba, 0x48, 0x03, 0xc8, 0x01, 0x01, 0xc2, 0xff, 0x8e,
-	0x02, 0x03, 0xaa, 0x02, 0x00, 0x52, 0x0b, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x54, 0x69,
-	0x6d, 0x65, 0x3a, 0x07, 0xc2, 0xff, 0x8e, 0x02, 0x02, 0x52, 0x00, 0x1a, 0x13, 0x0a, 0x08, 0x41,
-	0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x3a, 0x07, 0xc2, 0xff, 0x8e, 0x02, 0x02, 0x52, 0x00,
-	0x3a, 0x07, 0xc2, 0xff, 0x8e, 0x02, 0x02, 0x5a, 0x00, 0x42, 0x06, 0x0a, 0x04, 0x74, 0x79, 0x70,
-	0x65, 0x22, 0x82, 0x02, 0x0a, 0x0c, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x45, 0x76, 0x65,
-	0x6e, 0x74, 0x12, 0x45, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x6a, 0x35, 0x2e, 0x73, 0x74, 0x61, 0x74, 0x65, 0x2e,
-	0x76, 0x31, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
-	0x42, 0x0d, 0xba, 0x48, 0x03, 0xc8, 0x01, 0x01, 0xc2, 0xff, 0x8e, 0x02, 0x02, 0x52, 0x00, 0x52,
-	0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x3f, 0x0a, 0x04, 0x6b, 0x65, 0x79,
-	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x6f, 0x35, 0x2e, 0x74, 0x72, 0x69,
-	0x67, 0x67, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x4b,
-	0x65, 0x79, 0x73, 0x42, 0x0f, 0xba, 0x48, 0x03, 0xc8, 0x01, 0x01, 0xc2, 0xff, 0x8e, 0x02, 0x04,
-	0x52, 0x02, 0x08, 0x01, 0x52, 0x04, 0x6b, 0x65, 0x79, 0x73, 0x12, 0x51, 0x0a, 0x05, 0x65, 0x76,
-	0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6f, 0x35, 0x2e, 0x74,
-	0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65,
-	0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x42, 0x1a, 0xba, 0x48, 0x03, 0xc8,
-	0x01, 0x01, 0xc2, 0xff, 0x8e, 0x02, 0x02, 0x62, 0x00, 0x8a, 0xf7, 0x98, 0xc6, 0x02, 0x07, 0xaa,
-	0x01, 0x04, 0x52, 0x02, 0x08, 0x01, 0x52, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x3a, 0x17, 0xc2,
-	0xff, 0x8e, 0x02, 0x02, 0x52, 0x00, 0xea, 0x85, 0x8f, 0x02, 0x0b, 0x0a, 0x07, 0x74, 0x72, 0x69,
-	0x67, 0x67, 0x65, 0x72, 0x10, 0x03, 0x22, 0xef, 0x05, 0x0a, 0x0a, 0x41, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x43, 0x0a, 0x06, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6f, 0x35, 0x2e, 0x74, 0x72, 0x69, 0x67, 0x67,
-	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65,
-	0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x07, 0xc2, 0xff, 0x8e, 0x02, 0x02, 0x52, 0x00,
-	0x48, 0x00, 0x52, 0x06, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x12, 0x43, 0x0a, 0x06, 0x75, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6f, 0x35, 0x2e,
-	0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x54, 0x79, 0x70, 0x65, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x07, 0xc2, 0xff,
-	0x8e, 0x02, 0x02, 0x52, 0x00, 0x48, 0x00, 0x52, 0x06, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12,
-	0x46, 0x0a, 0x07, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x21, 0x2e, 0x6f, 0x35, 0x2e, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x2e, 0x76, 0x31,
-	0x2e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x2e, 0x41, 0x72, 0x63, 0x68,
-	0x69, 0x76, 0x65, 0x42, 0x07, 0xc2, 0xff, 0x8e, 0x02, 0x02, 0x52, 0x00, 0x48, 0x00, 0x52, 0x07,
-	0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x1a, 0xe4, 0x01, 0x0a, 0x06, 0x43, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x12, 0x46, 0x0a, 0x0a, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x5f, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x22, 0xba, 0x48, 0x15, 0x72, 0x13, 0x32, 0x11, 0x5e,
-	0x5b, 0x30, 0x2d, 0x39, 0x41, 0x2d, 0x5a, 0x61, 0x2d, 0x7a, 0x5d, 0x7b, 0x32, 0x32, 0x7d, 0x24,
-	0xc2, 0xff, 0x8e, 0x02, 0x05, 0xb2, 0x02, 0x02, 0x08, 0x03, 0x48, 0x00, 0x52, 0x09, 0x74, 0x72,
-	0x69, 0x67, 0x67, 0x65, 0x72, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x31, 0x0a, 0x0c, 0x74, 0x72,
-	0x69, 0x67, 0x67, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x42, 0x0e, 0xba, 0x48, 0x03, 0xc8, 0x01, 0x01, 0xc2, 0xff, 0x8e, 0x02, 0x03, 0xf2, 0x01, 0x00,
-	0x52, 0x0b, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x29, 0x0a,
-	0x08, 0x61, 0x70, 0x70, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x42, 0x08, 0xc2, 0xff, 0x8e, 0x02, 0x03, 0xaa, 0x02, 0x00, 0x48, 0x03, 0x52, 0x09, 0x6e, 0x6f,
+	0x74, 0x42, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x88, 0x01, 0x01, 0x12, 0x46, 0x0a, 0x09, 0x6e, 0x6f,
+	0x74, 0x5f, 0x61, 0x66, 0x74, 0x65, 0x72, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x08, 0xc2, 0xff, 0x8e, 0x02, 0x03,
+	0xaa, 0x02, 0x00, 0x48, 0x04, 0x52, 0x08, 0x6e, 0x6f, 0x74, 0x41, 0x66, 0x74, 0x65, 0x72, 0x88,
+	0x01, 0x01, 0x12, 0x2a, 0x0a, 0x09, 0x6d, 0x61, 0x78, 0x5f, 0x66, 0x69, 0x72, 0x65, 0x73, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x05, 0x42, 0x08, 0xc2, 0xff, 0x8e, 0x02, 0x03, 0xfa, 0x01, 0x00, 0x48,
+	0x05, 0x52, 0x08, 0x6d, 0x61, 0x78, 0x46, 0x69, 0x72, 0x65, 0x73, 0x88, 0x01, 0x01, 0x12, 0x24,
+	0x0a, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x08, 0xc2, 0xff, 0x8e, 0x02, 0x03, 0xf2, 0x01, 0x00, 0x52, 0x08, 0x74, 0x69, 0x6d, 0x65,
+	0x7a, 0x6f, 0x6e, 0x65, 0x12, 0x48, 0x0a, 0x0a, 0x64, 0x73, 0x74, 0x5f, 0x70, 0x6f, 0x6c, 0x69,
+	0x63, 0x79, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x6f, 0x35, 0x2e, 0x74, 0x72,
+	0x69, 0x67, 0x67, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x53, 0x54, 0x50, 0x6f, 0x6c, 0x69,
+	0x63, 0x79, 0x42, 0x0f, 0xba, 0x48, 0x05, 0x82, 0x01, 0x02, 0x10, 0x01, 0xc2, 0xff, 0x8e, 0x02,
+	0x02, 0x5a, 0x00, 0x52, 0x09, 0x64, 0x73, 0x74, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x2f,
+	0x0a, 0x0e, 0x6a, 0x69, 0x74, 0x74, 0x65, 0x72, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73,
+	0x18, 0x0d, 0x20, 0x01, 0x28, 0x05, 0x42, 0x08, 0xc2, 0xff, 0x8e, 0x02, 0x03, 0xfa, 0x01, 0x00,
+	0x52, 0x0d, 0x6a, 0x69, 0x74, 0x74, 0x65, 0x72, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12,
+	0x48, 0x0a, 0x0b, 0x63, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x0e,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x22, 0xba, 0x48, 0x15, 0x72, 0x13, 0x32, 0x11, 0x5e, 0x5b, 0x30,
+	0x2d, 0x39, 0x41, 0x2d, 0x5a, 0x61, 0x2d, 0x7a, 0x5d, 0x7b, 0x32, 0x32, 0x7d, 0x24, 0xc2, 0xff,
+	0x8e, 0x02, 0x05, 0xb2, 0x02, 0x02, 0x08, 0x03, 0x48, 0x06, 0x52, 0x0a, 0x63, 0x61, 0x6c, 0x65,
+	0x6e, 0x64, 0x61, 0x72, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x51, 0x0a, 0x0d, 0x63, 0x61, 0x6c,
+	0x65, 0x6e, 0x64, 0x61, 0x72, 0x5f, 0x72, 0x6f, 0x6c, 0x6c, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x1b, 0x2e, 0x6f, 0x35, 0x2e, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x2e, 0x76, 0x31,
+	0x2e, 0x43, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x52, 0x6f, 0x6c, 0x6c, 0x42, 0x0f, 0xba,
+	0x48, 0x05, 0x82, 0x01, 0x02, 0x10, 0x01, 0xc2, 0xff, 0x8e, 0x02, 0x02, 0x5a, 0x00, 0x52, 0x0c,
+	0x63, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x52, 0x6f, 0x6c, 0x6c, 0x12, 0x3c, 0x0a, 0x07,
+	0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e,
+	0x6a, 0x35, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x61, 0x6e, 0x79, 0x2e, 0x76, 0x31, 0x2e,
+	0x41, 0x6e, 0x79, 0x42, 0x07, 0xc2, 0xff, 0x8e, 0x02, 0x02, 0x4a, 0x00, 0x48, 0x07, 0x52, 0x07,
+	0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x88, 0x01, 0x01, 0x3a, 0x07, 0xc2, 0xff, 0x8e, 0x02,
+	0x02, 0x52, 0x00, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x5f,
+	0x69, 0x64, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x72, 0x75, 0x6e, 0x5f, 0x61, 0x74, 0x42, 0x0b, 0x0a,
+	0x09, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x6e,
+	0x6f, 0x74, 0x5f, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x6e, 0x6f,
+	0x74, 0x5f, 0x61, 0x66, 0x74, 0x65, 0x72, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x6d, 0x61, 0x78, 0x5f,
+	0x66, 0x69, 0x72, 0x65, 0x73, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x63, 0x61, 0x6c, 0x65, 0x6e, 0x64,
+	0x61, 0x72, 0x5f, 0x69, 0x64, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61,
+	0x64, 0x1a, 0xbb, 0x08, 0x0a, 0x06, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x44, 0x0a, 0x0a,
+	0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x25, 0xba, 0x48, 0x18, 0xc8, 0x01, 0x01, 0x72, 0x13, 0x32, 0x11, 0x5e, 0x5b, 0x30, 0x2d,
+	0x39, 0x41, 0x2d, 0x5a, 0x61, 0x2d, 0x7a, 0x5d, 0x7b, 0x32, 0x32, 0x7d, 0x24, 0xc2, 0xff, 0x8e,
+	0x02, 0x05, 0xb2, 0x02, 0x02, 0x08, 0x03, 0x52, 0x09, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72,
+	0x49, 0x64, 0x12, 0x31, 0x0a, 0x0c, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x5f, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0e, 0xba, 0x48, 0x03, 0xc8, 0x01, 0x01,
+	0xc2, 0xff, 0x8e, 0x02, 0x03, 0xf2, 0x01, 0x00, 0x52, 0x0b, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65,
+	0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x29, 0x0a, 0x08, 0x61, 0x70, 0x70, 0x5f, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0e, 0xba, 0x48, 0x03, 0xc8, 0x01, 0x01, 0xc2,
+	0xff, 0x8e, 0x02, 0x03, 0xf2, 0x01, 0x00, 0x52, 0x07, 0x61, 0x70, 0x70, 0x4e, 0x61, 0x6d, 0x65,
+	0x12, 0x1c, 0x0a, 0x04, 0x63, 0x72, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08,
+	0xc2, 0xff, 0x8e, 0x02, 0x03, 0xf2, 0x01, 0x00, 0x52, 0x04, 0x63, 0x72, 0x6f, 0x6e, 0x12, 0x48,
+	0x0a, 0x08, 0x63, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x75, 0x70, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x1c, 0x2e, 0x6f, 0x35, 0x2e, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x2e, 0x76, 0x31,
+	0x2e, 0x43, 0x61, 0x74, 0x63, 0x68, 0x55, 0x70, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x42, 0x0f,
+	0xba, 0x48, 0x05, 0x82, 0x01, 0x02, 0x10, 0x01, 0xc2, 0xff, 0x8e, 0x02, 0x02, 0x5a, 0x00, 0x52,
+	0x07, 0x63, 0x61, 0x74, 0x63, 0x68, 0x55, 0x70, 0x12, 0x40, 0x0a, 0x06, 0x72, 0x75, 0x6e, 0x5f,
+	0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x42, 0x08, 0xc2, 0xff, 0x8e, 0x02, 0x03, 0xaa, 0x02, 0x00, 0x48, 0x00,
+	0x52, 0x05, 0x72, 0x75, 0x6e, 0x41, 0x74, 0x88, 0x01, 0x01, 0x12, 0x41, 0x0a, 0x08, 0x69, 0x6e,
+	0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6f,
+	0x35, 0x2e, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x74,
+	0x65, 0x72, 0x76, 0x61, 0x6c, 0x42, 0x07, 0xc2, 0xff, 0x8e, 0x02, 0x02, 0x52, 0x00, 0x48, 0x01,
+	0x52, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x88, 0x01, 0x01, 0x12, 0x48, 0x0a,
+	0x0a, 0x6e, 0x6f, 0x74, 0x5f, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x08, 0xc2,
+	0xff, 0x8e, 0x02, 0x03, 0xaa, 0x02, 0x00, 0x48, 0x02, 0x52, 0x09, 0x6e, 0x6f, 0x74, 0x42, 0x65,
+	0x66, 0x6f, 0x72, 0x65, 0x88, 0x01, 0x01, 0x12, 0x46, 0x0a, 0x09, 0x6e, 0x6f, 0x74, 0x5f, 0x61,
+	0x66, 0x74, 0x65, 0x72, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x08, 0xc2, 0xff, 0x8e, 0x02, 0x03, 0xaa, 0x02, 0x00,
+	0x48, 0x03, 0x52, 0x08, 0x6e, 0x6f, 0x74, 0x41, 0x66, 0x74, 0x65, 0x72, 0x88, 0x01, 0x01, 0x12,
+	0x2a, 0x0a, 0x09, 0x6d, 0x61, 0x78, 0x5f, 0x66, 0x69, 0x72, 0x65, 0x73, 0x18, 0x0a, 0x20, 0x01,
+	0x28, 0x05, 0x42, 0x08, 0xc2, 0xff, 0x8e, 0x02, 0x03, 0xfa, 0x01, 0x00, 0x48, 0x04, 0x52, 0x08,
+	0x6d, 0x61, 0x78, 0x46, 0x69, 0x72, 0x65, 0x73, 0x88, 0x01, 0x01, 0x12, 0x24, 0x0a, 0x08, 0x74,
+	0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xc2,
+	0xff, 0x8e, 0x02, 0x03, 0xf2, 0x01, 0x00, 0x52, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e,
+	0x65, 0x12, 0x48, 0x0a, 0x0a, 0x64, 0x73, 0x74, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18,
+	0x0c, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x6f, 0x35, 0x2e, 0x74, 0x72, 0x69, 0x67, 0x67,
+	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x53, 0x54, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x42,
+	0x0f, 0xba, 0x48, 0x05, 0x82, 0x01, 0x02, 0x10, 0x01, 0xc2, 0xff, 0x8e, 0x02, 0x02, 0x5a, 0x00,
+	0x52, 0x09, 0x64, 0x73, 0x74, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x2f, 0x0a, 0x0e, 0x6a,
+	0x69, 0x74, 0x74, 0x65, 0x72, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x0d, 0x20,
+	0x01, 0x28, 0x05, 0x42, 0x08, 0xc2, 0xff, 0x8e, 0x02, 0x03, 0xfa, 0x01, 0x00, 0x52, 0x0d, 0x6a,
+	0x69, 0x74, 0x74, 0x65, 0x72, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x48, 0x0a, 0x0b,
+	0x63, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x0e, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x22, 0xba, 0x48, 0x15, 0x72, 0x13, 0x32, 0x11, 0x5e, 0x5b, 0x30, 0x2d, 0x39, 0x41,
+	0x2d, 0x5a, 0x61, 0x2d, 0x7a, 0x5d, 0x7b, 0x32, 0x32, 0x7d, 0x24, 0xc2, 0xff, 0x8e, 0x02, 0x05,
+	0xb2, 0x02, 0x02, 0x08, 0x03, 0x48, 0x05, 0x52, 0x0a, 0x63, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61,
+	0x72, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x51, 0x0a, 0x0d, 0x63, 0x61, 0x6c, 0x65, 0x6e, 0x64,
+	0x61, 0x72, 0x5f, 0x72, 0x6f, 0x6c, 0x6c, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e,
+	0x6f, 0x35, 0x2e, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61,
+	0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x52, 0x6f, 0x6c, 0x6c, 0x42, 0x0f, 0xba, 0x48, 0x05, 0x82,
+	0x01, 0x02, 0x10, 0x01, 0xc2, 0xff, 0x8e, 0x02, 0x02, 0x5a, 0x00, 0x52, 0x0c, 0x63, 0x61, 0x6c,
+	0x65, 0x6e, 0x64, 0x61, 0x72, 0x52, 0x6f, 0x6c, 0x6c, 0x12, 0x3c, 0x0a, 0x07, 0x70, 0x61, 0x79,
+	0x6c, 0x6f, 0x61, 0x64, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6a, 0x35, 0x2e,
+	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x61, 0x6e, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x6e, 0x79,
+	0x42, 0x07, 0xc2, 0xff, 0x8e, 0x02, 0x02, 0x4a, 0x00, 0x48, 0x06, 0x52, 0x07, 0x70, 0x61, 0x79,
+	0x6c, 0x6f, 0x61, 0x64, 0x88, 0x01, 0x01, 0x3a, 0x07, 0xc2, 0xff, 0x8e, 0x02, 0x02, 0x52, 0x00,
+	0x42, 0x09, 0x0a, 0x07, 0x5f, 0x72, 0x75, 0x6e, 0x5f, 0x61, 0x74, 0x42, 0x0b, 0x0a, 0x09, 0x5f,
+	0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x6e, 0x6f, 0x74,
+	0x5f, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x6e, 0x6f, 0x74, 0x5f,
+	0x61, 0x66, 0x74, 0x65, 0x72, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x66, 0x69,
+	0x72, 0x65, 0x73, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x63, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72,
+	0x5f, 0x69, 0x64, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x1a,
+	0x41, 0x0a, 0x07, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x12, 0x2d, 0x0a, 0x0a, 0x74, 0x72,
+	0x69, 0x67, 0x67, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0e,
+	0xba, 0x48, 0x03, 0xc8, 0x01, 0x01, 0xc2, 0xff, 0x8e, 0x02, 0x03, 0xf2, 0x01, 0x00, 0x52, 0x09,
+	0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x49, 0x64, 0x3a, 0x07, 0xc2, 0xff, 0x8e, 0x02, 0x02,
+	0x52, 0x00, 0x1a, 0x95, 0x02, 0x0a, 0x0e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x61, 0x6c,
+	0x65, 0x6e, 0x64, 0x61, 0x72, 0x12, 0x48, 0x0a, 0x0b, 0x63, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61,
+	0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x22, 0xba, 0x48, 0x15, 0x72,
+	0x13, 0x32, 0x11, 0x5e, 0x5b, 0x30, 0x2d, 0x39, 0x41, 0x2d, 0x5a, 0x61, 0x2d, 0x7a, 0x5d, 0x7b,
+	0x32, 0x32, 0x7d, 0x24, 0xc2, 0xff, 0x8e, 0x02, 0x05, 0xb2, 0x02, 0x02, 0x08, 0x03, 0x48, 0x00,
+	0x52, 0x0a, 0x63, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12,
+	0x22, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0e, 0xba,
+	0x48, 0x03, 0xc8, 0x01, 0x01, 0xc2, 0xff, 0x8e, 0x02, 0x03, 0xf2, 0x01, 0x00, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x47, 0x0a, 0x0e, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x64, 0x5f,
+	0x64, 0x61, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6a, 0x35,
+	0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x44,
+	0x61, 0x74, 0x65, 0x42, 0x08, 0xc2, 0xff, 0x8e, 0x02, 0x03, 0xaa, 0x01, 0x00, 0x52, 0x0d, 0x65,
+	0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x64, 0x44, 0x61, 0x74, 0x65, 0x73, 0x12, 0x33, 0x0a, 0x10,
+	0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x77, 0x65, 0x65, 0x6b, 0x65, 0x6e, 0x64, 0x73,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x42, 0x08, 0xc2, 0xff, 0x8e, 0x02, 0x03, 0x8a, 0x02, 0x00,
+	0x52, 0x0f, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x57, 0x65, 0x65, 0x6b, 0x65, 0x6e, 0x64,
+	0x73, 0x3a, 0x07, 0xc2, 0xff, 0x8e, 0x02, 0x02, 0x52, 0x00, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x63,
+	0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x5f, 0x69, 0x64, 0x1a, 0x83, 0x02, 0x0a, 0x0e, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x12, 0x46, 0x0a,
+	0x0b, 0x63, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x25, 0xba, 0x48, 0x18, 0xc8, 0x01, 0x01, 0x72, 0x13, 0x32, 0x11, 0x5e, 0x5b,
+	0x30, 0x2d, 0x39, 0x41, 0x2d, 0x5a, 0x61, 0x2d, 0x7a, 0x5d, 0x7b, 0x32, 0x32, 0x7d, 0x24, 0xc2,
+	0xff, 0x8e, 0x02, 0x05, 0xb2, 0x02, 0x02, 0x08, 0x03, 0x52, 0x0a, 0x63, 0x61, 0x6c, 0x65, 0x6e,
+	0x64, 0x61, 0x72, 0x49, 0x64, 0x12, 0x22, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x0e, 0xba, 0x48, 0x03, 0xc8, 0x01, 0x01, 0xc2, 0xff, 0x8e, 0x02, 0x03,
+	0xf2, 0x01, 0x00, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x47, 0x0a, 0x0e, 0x65, 0x78, 0x63,
+	0x6c, 0x75, 0x64, 0x65, 0x64, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x16, 0x2e, 0x6a, 0x35, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x64, 0x61, 0x74,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x61, 0x74, 0x65, 0x42, 0x08, 0xc2, 0xff, 0x8e, 0x02, 0x03,
+	0xaa, 0x01, 0x00, 0x52, 0x0d, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x64, 0x44, 0x61, 0x74,
+	0x65, 0x73, 0x12, 0x33, 0x0a, 0x10, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x77, 0x65,
+	0x65, 0x6b, 0x65, 0x6e, 0x64, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x42, 0x08, 0xc2, 0xff,
+	0x8e, 0x02, 0x03, 0x8a, 0x02, 0x00, 0x52, 0x0f, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x57,
+	0x65, 0x65, 0x6b, 0x65, 0x6e, 0x64, 0x73, 0x3a, 0x07, 0xc2, 0xff, 0x8e, 0x02, 0x02, 0x52, 0x00,
+	0x1a, 0x62, 0x0a, 0x0f, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x43, 0x61, 0x6c, 0x65, 0x6e,
+	0x64, 0x61, 0x72, 0x12, 0x46, 0x0a, 0x0b, 0x63, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x25, 0xba, 0x48, 0x18, 0xc8, 0x01, 0x01,
+	0x72, 0x13, 0x32, 0x11, 0x5e, 0x5b, 0x30, 0x2d, 0x39, 0x41, 0x2d, 0x5a, 0x61, 0x2d, 0x7a, 0x5d,
+	0x7b, 0x32, 0x32, 0x7d, 0x24, 0xc2, 0xff, 0x8e, 0x02, 0x05, 0xb2, 0x02, 0x02, 0x08, 0x03, 0x52,
+	0x0a, 0x63, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x49, 0x64, 0x3a, 0x07, 0xc2, 0xff, 0x8e,
+	0x02, 0x02, 0x52, 0x00, 0x1a, 0x82, 0x01, 0x0a, 0x04, 0x53, 0x79, 0x6e, 0x63, 0x12, 0x29, 0x0a,
+	0x08, 0x61, 0x70, 0x70, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
 	0x0e, 0xba, 0x48, 0x03, 0xc8, 0x01, 0x01, 0xc2, 0xff, 0x8e, 0x02, 0x03, 0xf2, 0x01, 0x00, 0x52,
-	0x07, 0x61, 0x70, 0x70, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x04, 0x63, 0x72, 0x6f, 0x6e,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xc2, 0xff, 0x8e, 0x02, 0x03, 0xf2, 0x01, 0x00,
-	0x52, 0x04, 0x63, 0x72, 0x6f, 0x6e, 0x3a, 0x07, 0xc2, 0xff, 0x8e, 0x02, 0x02, 0x52, 0x00, 0x42,
-	0x0d, 0x0a, 0x0b, 0x5f, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x1a, 0xd3,
-	0x01, 0x0a, 0x06, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x44, 0x0a, 0x0a, 0x74, 0x72, 0x69,
-	0x67, 0x67, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x25, 0xba,
-	0x48, 0x18, 0xc8, 0x01, 0x01, 0x72, 0x13, 0x32, 0x11, 0x5e, 0x5b, 0x30, 0x2d, 0x39, 0x41, 0x2d,
+	0x07, 0x61, 0x70, 0x70, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x46, 0x0a, 0x08, 0x74, 0x72, 0x69, 0x67,
+	0x67, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6f, 0x35, 0x2e,
+	0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x69, 0x67, 0x67,
+	0x65, 0x72, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x08, 0xc2, 0xff,
+	0x8e, 0x02, 0x03, 0xaa, 0x01, 0x00, 0x52, 0x08, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x73,
+	0x3a, 0x07, 0xc2, 0xff, 0x8e, 0x02, 0x02, 0x52, 0x00, 0x1a, 0x56, 0x0a, 0x05, 0x50, 0x61, 0x75,
+	0x73, 0x65, 0x12, 0x44, 0x0a, 0x0a, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x25, 0xba, 0x48, 0x18, 0xc8, 0x01, 0x01, 0x72, 0x13,
+	0x32, 0x11, 0x5e, 0x5b, 0x30, 0x2d, 0x39, 0x41, 0x2d, 0x5a, 0x61, 0x2d, 0x7a, 0x5d, 0x7b, 0x32,
+	0x32, 0x7d, 0x24, 0xc2, 0xff, 0x8e, 0x02, 0x05, 0xb2, 0x02, 0x02, 0x08, 0x03, 0x52, 0x09, 0x74,
+	0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x49, 0x64, 0x3a, 0x07, 0xc2, 0xff, 0x8e, 0x02, 0x02, 0x52,
+	0x00, 0x1a, 0x57, 0x0a, 0x06, 0x52, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x12, 0x44, 0x0a, 0x0a, 0x74,
+	0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x25, 0xba, 0x48, 0x18, 0xc8, 0x01, 0x01, 0x72, 0x13, 0x32, 0x11, 0x5e, 0x5b, 0x30, 0x2d, 0x39,
+	0x41, 0x2d, 0x5a, 0x61, 0x2d, 0x7a, 0x5d, 0x7b, 0x32, 0x32, 0x7d, 0x24, 0xc2, 0xff, 0x8e, 0x02,
+	0x05, 0xb2, 0x02, 0x02, 0x08, 0x03, 0x52, 0x09, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x49,
+	0x64, 0x3a, 0x07, 0xc2, 0xff, 0x8e, 0x02, 0x02, 0x52, 0x00, 0x1a, 0xbf, 0x01, 0x0a, 0x0f, 0x4d,
+	0x61, 0x6e, 0x75, 0x61, 0x6c, 0x6c, 0x79, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x12, 0x44,
+	0x0a, 0x0a, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x25, 0xba, 0x48, 0x18, 0xc8, 0x01, 0x01, 0x72, 0x13, 0x32, 0x11, 0x5e, 0x5b,
+	0x30, 0x2d, 0x39, 0x41, 0x2d, 0x5a, 0x61, 0x2d, 0x7a, 0x5d, 0x7b, 0x32, 0x32, 0x7d, 0x24, 0xc2,
+	0xff, 0x8e, 0x02, 0x05, 0xb2, 0x02, 0x02, 0x08, 0x03, 0x52, 0x09, 0x74, 0x72, 0x69, 0x67, 0x67,
+	0x65, 0x72, 0x49, 0x64, 0x12, 0x4c, 0x0a, 0x0c, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x5f,
+	0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x08, 0xc2, 0xff, 0x8e, 0x02, 0x03, 0xaa, 0x02, 0x00,
+	0x48, 0x00, 0x52, 0x0b, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x54, 0x69, 0x6d, 0x65, 0x88,
+	0x01, 0x01, 0x3a, 0x07, 0xc2, 0xff, 0x8e, 0x02, 0x02, 0x52, 0x00, 0x42, 0x0f, 0x0a, 0x0d, 0x5f,
+	0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x1a, 0xa6, 0x01, 0x0a,
+	0x07, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x12, 0x44, 0x0a, 0x0a, 0x74, 0x72, 0x69, 0x67,
+	0x67, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x25, 0xba, 0x48,
+	0x18, 0xc8, 0x01, 0x01, 0x72, 0x13, 0x32, 0x11, 0x5e, 0x5b, 0x30, 0x2d, 0x39, 0x41, 0x2d, 0x5a,
+	0x61, 0x2d, 0x7a, 0x5d, 0x7b, 0x32, 0x32, 0x7d, 0x24, 0xc2, 0xff, 0x8e, 0x02, 0x05, 0xb2, 0x02,
+	0x02, 0x08, 0x03, 0x52, 0x09, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x49, 0x64, 0x12, 0x26,
+	0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0e,
+	0xba, 0x48, 0x03, 0xc8, 0x01, 0x01, 0xc2, 0xff, 0x8e, 0x02, 0x03, 0xf2, 0x01, 0x00, 0x52, 0x06,
+	0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x24, 0x0a, 0x08, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61,
+	0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x42, 0x08, 0xc2, 0xff, 0x8e, 0x02, 0x03, 0x8a,
+	0x02, 0x00, 0x52, 0x08, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x3a, 0x07, 0xc2, 0xff,
+	0x8e, 0x02, 0x02, 0x52, 0x00, 0x3a, 0x07, 0xc2, 0xff, 0x8e, 0x02, 0x02, 0x5a, 0x00, 0x42, 0x06,
+	0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x22, 0xd5, 0x07, 0x0a, 0x11, 0x54, 0x72, 0x69, 0x67, 0x67,
+	0x65, 0x72, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x31, 0x0a, 0x0c,
+	0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x0e, 0xba, 0x48, 0x03, 0xc8, 0x01, 0x01, 0xc2, 0xff, 0x8e, 0x02, 0x03, 0xf2,
+	0x01, 0x00, 0x52, 0x0b, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12,
+	0x1c, 0x0a, 0x04, 0x63, 0x72, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xc2,
+	0xff, 0x8e, 0x02, 0x03, 0xf2, 0x01, 0x00, 0x52, 0x04, 0x63, 0x72, 0x6f, 0x6e, 0x12, 0x48, 0x0a,
+	0x08, 0x63, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x75, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x1c, 0x2e, 0x6f, 0x35, 0x2e, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e,
+	0x43, 0x61, 0x74, 0x63, 0x68, 0x55, 0x70, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x42, 0x0f, 0xba,
+	0x48, 0x05, 0x82, 0x01, 0x02, 0x10, 0x01, 0xc2, 0xff, 0x8e, 0x02, 0x02, 0x5a, 0x00, 0x52, 0x07,
+	0x63, 0x61, 0x74, 0x63, 0x68, 0x55, 0x70, 0x12, 0x40, 0x0a, 0x06, 0x72, 0x75, 0x6e, 0x5f, 0x61,
+	0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x42, 0x08, 0xc2, 0xff, 0x8e, 0x02, 0x03, 0xaa, 0x02, 0x00, 0x48, 0x00, 0x52,
+	0x05, 0x72, 0x75, 0x6e, 0x41, 0x74, 0x88, 0x01, 0x01, 0x12, 0x41, 0x0a, 0x08, 0x69, 0x6e, 0x74,
+	0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6f, 0x35,
+	0x2e, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x74, 0x65,
+	0x72, 0x76, 0x61, 0x6c, 0x42, 0x07, 0xc2, 0xff, 0x8e, 0x02, 0x02, 0x52, 0x00, 0x48, 0x01, 0x52,
+	0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x88, 0x01, 0x01, 0x12, 0x48, 0x0a, 0x0a,
+	0x6e, 0x6f, 0x74, 0x5f, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x08, 0xc2, 0xff,
+	0x8e, 0x02, 0x03, 0xaa, 0x02, 0x00, 0x48, 0x02, 0x52, 0x09, 0x6e, 0x6f, 0x74, 0x42, 0x65, 0x66,
+	0x6f, 0x72, 0x65, 0x88, 0x01, 0x01, 0x12, 0x46, 0x0a, 0x09, 0x6e, 0x6f, 0x74, 0x5f, 0x61, 0x66,
+	0x74, 0x65, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x08, 0xc2, 0xff, 0x8e, 0x02, 0x03, 0xaa, 0x02, 0x00, 0x48,
+	0x03, 0x52, 0x08, 0x6e, 0x6f, 0x74, 0x41, 0x66, 0x74, 0x65, 0x72, 0x88, 0x01, 0x01, 0x12, 0x2a,
+	0x0a, 0x09, 0x6d, 0x61, 0x78, 0x5f, 0x66, 0x69, 0x72, 0x65, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x05, 0x42, 0x08, 0xc2, 0xff, 0x8e, 0x02, 0x03, 0xfa, 0x01, 0x00, 0x48, 0x04, 0x52, 0x08, 0x6d,
+	0x61, 0x78, 0x46, 0x69, 0x72, 0x65, 0x73, 0x88, 0x01, 0x01, 0x12, 0x24, 0x0a, 0x08, 0x74, 0x69,
+	0x6d, 0x65, 0x7a, 0x6f, 0x6e, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xc2, 0xff,
+	0x8e, 0x02, 0x03, 0xf2, 0x01, 0x00, 0x52, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e, 0x65,
+	0x12, 0x48, 0x0a, 0x0a, 0x64, 0x73, 0x74, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x0a,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x6f, 0x35, 0x2e, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65,
+	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x53, 0x54, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x42, 0x0f,
+	0xba, 0x48, 0x05, 0x82, 0x01, 0x02, 0x10, 0x01, 0xc2, 0xff, 0x8e, 0x02, 0x02, 0x5a, 0x00, 0x52,
+	0x09, 0x64, 0x73, 0x74, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x2f, 0x0a, 0x0e, 0x6a, 0x69,
+	0x74, 0x74, 0x65, 0x72, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x0b, 0x20, 0x01,
+	0x28, 0x05, 0x42, 0x08, 0xc2, 0xff, 0x8e, 0x02, 0x03, 0xfa, 0x01, 0x00, 0x52, 0x0d, 0x6a, 0x69,
+	0x74, 0x74, 0x65, 0x72, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x48, 0x0a, 0x0b, 0x63,
+	0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x22, 0xba, 0x48, 0x15, 0x72, 0x13, 0x32, 0x11, 0x5e, 0x5b, 0x30, 0x2d, 0x39, 0x41, 0x2d,
 	0x5a, 0x61, 0x2d, 0x7a, 0x5d, 0x7b, 0x32, 0x32, 0x7d, 0x24, 0xc2, 0xff, 0x8e, 0x02, 0x05, 0xb2,
-	0x02, 0x02, 0x08, 0x03, 0x52, 0x09, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x49, 0x64, 0x12,
-	0x31, 0x0a, 0x0c, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0e, 0xba, 0x48, 0x03, 0xc8, 0x01, 0x01, 0xc2, 0xff, 0x8e,
-	0x02, 0x03, 0xf2, 0x01, 0x00, 0x52, 0x0b, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x4e, 0x61,
-	0x6d, 0x65, 0x12, 0x29, 0x0a, 0x08, 0x61, 0x70, 0x70, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x09, 0x42, 0x0e, 0xba, 0x48, 0x03, 0xc8, 0x01, 0x01, 0xc2, 0xff, 0x8e, 0x02,
-	0x03, 0xf2, 0x01, 0x00, 0x52, 0x07, 0x61, 0x70, 0x70, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a,
-	0x04, 0x63, 0x72, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xc2, 0xff, 0x8e,
-	0x02, 0x03, 0xf2, 0x01, 0x00, 0x52, 0x04, 0x63, 0x72, 0x6f, 0x6e, 0x3a, 0x07, 0xc2, 0xff, 0x8e,
-	0x02, 0x02, 0x52, 0x00, 0x1a, 0x41, 0x0a, 0x07, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x12,
-	0x2d, 0x0a, 0x0a, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x42, 0x0e, 0xba, 0x48, 0x03, 0xc8, 0x01, 0x01, 0xc2, 0xff, 0x8e, 0x02, 0x03,
-	0xf2, 0x01, 0x00, 0x52, 0x09, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x49, 0x64, 0x3a, 0x07,
-	0xc2, 0xff, 0x8e, 0x02, 0x02, 0x52, 0x00, 0x3a, 0x07, 0xc2, 0xff, 0x8e, 0x02, 0x02, 0x5a, 0x00,
-	0x42, 0x06, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x2a, 0x82, 0x01, 0x0a, 0x0d, 0x54, 0x72, 0x69,
-	0x67, 0x67, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1e, 0x0a, 0x1a, 0x54, 0x52,
-	0x49, 0x47, 0x47, 0x45, 0x52, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x53,
-	0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x19, 0x0a, 0x15, 0x54, 0x52,
-	0x49, 0x47, 0x47, 0x45, 0x52, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x41, 0x43, 0x54,
-	0x49, 0x56, 0x45, 0x10, 0x01, 0x12, 0x19, 0x0a, 0x15, 0x54, 0x52, 0x49, 0x47, 0x47, 0x45, 0x52,
-	0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x50, 0x41, 0x55, 0x53, 0x45, 0x44, 0x10, 0x02,
-	0x12, 0x1b, 0x0a, 0x17, 0x54, 0x52, 0x49, 0x47, 0x47, 0x45, 0x52, 0x5f, 0x53, 0x54, 0x41, 0x54,
-	0x55, 0x53, 0x5f, 0x41, 0x52, 0x43, 0x48, 0x49, 0x56, 0x45, 0x44, 0x10, 0x03, 0x42, 0x39, 0x5a,
-	0x37, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x70, 0x65, 0x6e, 0x74,
-	0x6f, 0x70, 0x73, 0x2f, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x2f, 0x67, 0x65, 0x6e, 0x2f,
-	0x6f, 0x35, 0x2f, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x2f, 0x76, 0x31, 0x2f, 0x74, 0x72,
-	0x69, 0x67, 0x67, 0x65, 0x72, 0x5f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x02, 0x02, 0x08, 0x03, 0x48, 0x05, 0x52, 0x0a, 0x63, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72,
+	0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x51, 0x0a, 0x0d, 0x63, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61,
+	0x72, 0x5f, 0x72, 0x6f, 0x6c, 0x6c, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x6f,
+	0x35, 0x2e, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x6c,
+	0x65, 0x6e, 0x64, 0x61, 0x72, 0x52, 0x6f, 0x6c, 0x6c, 0x42, 0x0f, 0xba, 0x48, 0x05, 0x82, 0x01,
+	0x02, 0x10, 0x01, 0xc2, 0xff, 0x8e, 0x02, 0x02, 0x5a, 0x00, 0x52, 0x0c, 0x63, 0x61, 0x6c, 0x65,
+	0x6e, 0x64, 0x61, 0x72, 0x52, 0x6f, 0x6c, 0x6c, 0x12, 0x3c, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c,
+	0x6f, 0x61, 0x64, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6a, 0x35, 0x2e, 0x74,
+	0x79, 0x70, 0x65, 0x73, 0x2e, 0x61, 0x6e, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x6e, 0x79, 0x42,
+	0x07, 0xc2, 0xff, 0x8e, 0x02, 0x02, 0x4a, 0x00, 0x48, 0x06, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6c,
+	0x6f, 0x61, 0x64, 0x88, 0x01, 0x01, 0x3a, 0x07, 0xc2, 0xff, 0x8e, 0x02, 0x02, 0x52, 0x00, 0x42,
+	0x09, 0x0a, 0x07, 0x5f, 0x72, 0x75, 0x6e, 0x5f, 0x61, 0x74, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x69,
+	0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x6e, 0x6f, 0x74, 0x5f,
+	0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x6e, 0x6f, 0x74, 0x5f, 0x61,
+	0x66, 0x74, 0x65, 0x72, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x66, 0x69, 0x72,
+	0x65, 0x73, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x63, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x5f,
+	0x69, 0x64, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x22, 0x8c,
+	0x01, 0x0a, 0x08, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x12, 0x33, 0x0a, 0x0d, 0x65,
+	0x76, 0x65, 0x72, 0x79, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x42, 0x0e, 0xba, 0x48, 0x03, 0xc8, 0x01, 0x01, 0xc2, 0xff, 0x8e, 0x02, 0x03, 0xfa,
+	0x01, 0x00, 0x52, 0x0c, 0x65, 0x76, 0x65, 0x72, 0x79, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73,
+	0x12, 0x42, 0x0a, 0x06, 0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x0e, 0xba, 0x48,
+	0x03, 0xc8, 0x01, 0x01, 0xc2, 0xff, 0x8e, 0x02, 0x03, 0xaa, 0x02, 0x00, 0x52, 0x06, 0x61, 0x6e,
+	0x63, 0x68, 0x6f, 0x72, 0x3a, 0x07, 0xc2, 0xff, 0x8e, 0x02, 0x02, 0x52, 0x00, 0x2a, 0xd8, 0x01,
+	0x0a, 0x0d, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
+	0x1e, 0x0a, 0x1a, 0x54, 0x52, 0x49, 0x47, 0x47, 0x45, 0x52, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55,
+	0x53, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12,
+	0x19, 0x0a, 0x15, 0x54, 0x52, 0x49, 0x47, 0x47, 0x45, 0x52, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55,
+	0x53, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x56, 0x45, 0x10, 0x01, 0x12, 0x19, 0x0a, 0x15, 0x54, 0x52,
+	0x49, 0x47, 0x47, 0x45, 0x52, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x50, 0x41, 0x55,
+	0x53, 0x45, 0x44, 0x10, 0x02, 0x12, 0x1b, 0x0a, 0x17, 0x54, 0x52, 0x49, 0x47, 0x47, 0x45, 0x52,
+	0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x41, 0x52, 0x43, 0x48, 0x49, 0x56, 0x45, 0x44,
+	0x10, 0x03, 0x12, 0x1c, 0x0a, 0x18, 0x54, 0x52, 0x49, 0x47, 0x47, 0x45, 0x52, 0x5f, 0x53, 0x54,
+	0x41, 0x54, 0x55, 0x53, 0x5f, 0x43, 0x4f, 0x4d, 0x50, 0x4c, 0x45, 0x54, 0x45, 0x44, 0x10, 0x04,
+	0x12, 0x1a, 0x0a, 0x16, 0x54, 0x52, 0x49, 0x47, 0x47, 0x45, 0x52, 0x5f, 0x53, 0x54, 0x41, 0x54,
+	0x55, 0x53, 0x5f, 0x45, 0x58, 0x50, 0x49, 0x52, 0x45, 0x44, 0x10, 0x05, 0x12, 0x1a, 0x0a, 0x16,
+	0x54, 0x52, 0x49, 0x47, 0x47, 0x45, 0x52, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x46,
+	0x41, 0x55, 0x4c, 0x54, 0x45, 0x44, 0x10, 0x06, 0x2a, 0x6b, 0x0a, 0x0e, 0x43, 0x61, 0x6c, 0x65,
+	0x6e, 0x64, 0x61, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1f, 0x0a, 0x1b, 0x43, 0x41,
+	0x4c, 0x45, 0x4e, 0x44, 0x41, 0x52, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e,
+	0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1a, 0x0a, 0x16, 0x43,
+	0x41, 0x4c, 0x45, 0x4e, 0x44, 0x41, 0x52, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x41,
+	0x43, 0x54, 0x49, 0x56, 0x45, 0x10, 0x01, 0x12, 0x1c, 0x0a, 0x18, 0x43, 0x41, 0x4c, 0x45, 0x4e,
+	0x44, 0x41, 0x52, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x41, 0x52, 0x43, 0x48, 0x49,
+	0x56, 0x45, 0x44, 0x10, 0x02, 0x2a, 0x6a, 0x0a, 0x0c, 0x43, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61,
+	0x72, 0x52, 0x6f, 0x6c, 0x6c, 0x12, 0x1d, 0x0a, 0x19, 0x43, 0x41, 0x4c, 0x45, 0x4e, 0x44, 0x41,
+	0x52, 0x5f, 0x52, 0x4f, 0x4c, 0x4c, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49,
+	0x45, 0x44, 0x10, 0x00, 0x12, 0x16, 0x0a, 0x12, 0x43, 0x41, 0x4c, 0x45, 0x4e, 0x44, 0x41, 0x52,
+	0x5f, 0x52, 0x4f, 0x4c, 0x4c, 0x5f, 0x53, 0x4b, 0x49, 0x50, 0x10, 0x01, 0x12, 0x23, 0x0a, 0x1f,
+	0x43, 0x41, 0x4c, 0x45, 0x4e, 0x44, 0x41, 0x52, 0x5f, 0x52, 0x4f, 0x4c, 0x4c, 0x5f, 0x4e, 0x45,
+	0x58, 0x54, 0x5f, 0x42, 0x55, 0x53, 0x49, 0x4e, 0x45, 0x53, 0x53, 0x5f, 0x44, 0x41, 0x59, 0x10,
+	0x02, 0x2a, 0x6c, 0x0a, 0x09, 0x44, 0x53, 0x54, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x1a,
+	0x0a, 0x16, 0x44, 0x53, 0x54, 0x5f, 0x50, 0x4f, 0x4c, 0x49, 0x43, 0x59, 0x5f, 0x55, 0x4e, 0x53,
+	0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x13, 0x0a, 0x0f, 0x44, 0x53,
+	0x54, 0x5f, 0x50, 0x4f, 0x4c, 0x49, 0x43, 0x59, 0x5f, 0x53, 0x4b, 0x49, 0x50, 0x10, 0x01, 0x12,
+	0x14, 0x0a, 0x10, 0x44, 0x53, 0x54, 0x5f, 0x50, 0x4f, 0x4c, 0x49, 0x43, 0x59, 0x5f, 0x53, 0x48,
+	0x49, 0x46, 0x54, 0x10, 0x02, 0x12, 0x18, 0x0a, 0x14, 0x44, 0x53, 0x54, 0x5f, 0x50, 0x4f, 0x4c,
+	0x49, 0x43, 0x59, 0x5f, 0x46, 0x49, 0x52, 0x45, 0x5f, 0x42, 0x4f, 0x54, 0x48, 0x10, 0x03, 0x2a,
+	0x89, 0x01, 0x0a, 0x0d, 0x43, 0x61, 0x74, 0x63, 0x68, 0x55, 0x70, 0x50, 0x6f, 0x6c, 0x69, 0x63,
+	0x79, 0x12, 0x1f, 0x0a, 0x1b, 0x43, 0x41, 0x54, 0x43, 0x48, 0x5f, 0x55, 0x50, 0x5f, 0x50, 0x4f,
+	0x4c, 0x49, 0x43, 0x59, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44,
+	0x10, 0x00, 0x12, 0x1c, 0x0a, 0x18, 0x43, 0x41, 0x54, 0x43, 0x48, 0x5f, 0x55, 0x50, 0x5f, 0x50,
+	0x4f, 0x4c, 0x49, 0x43, 0x59, 0x5f, 0x46, 0x49, 0x52, 0x45, 0x5f, 0x41, 0x4c, 0x4c, 0x10, 0x01,
+	0x12, 0x1f, 0x0a, 0x1b, 0x43, 0x41, 0x54, 0x43, 0x48, 0x5f, 0x55, 0x50, 0x5f, 0x50, 0x4f, 0x4c,
+	0x49, 0x43, 0x59, 0x5f, 0x46, 0x49, 0x52, 0x45, 0x5f, 0x4c, 0x41, 0x54, 0x45, 0x53, 0x54, 0x10,
+	0x02, 0x12, 0x18, 0x0a, 0x14, 0x43, 0x41, 0x54, 0x43, 0x48, 0x5f, 0x55, 0x50, 0x5f, 0x50, 0x4f,
+	0x4c, 0x49, 0x43, 0x59, 0x5f, 0x53, 0x4b, 0x49, 0x50, 0x10, 0x03, 0x42, 0x39, 0x5a, 0x37, 0x67,
+	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x70, 0x65, 0x6e, 0x74, 0x6f, 0x70,
+	0x73, 0x2f, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x6f, 0x35,
+	0x2f, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x2f, 0x76, 0x31, 0x2f, 0x74, 0x72, 0x69, 0x67,
+	0x67, 0x65, 0x72, 0x5f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1361,59 +4447,175 @@ func file_o5_trigger_v1_trigger_j5s_proto_rawDescGZIP() []byte {
 	return file_o5_trigger_v1_trigger_j5s_proto_rawDescData
 }
 
-var file_o5_trigger_v1_trigger_j5s_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_o5_trigger_v1_trigger_j5s_proto_msgTypes = make([]protoimpl.MessageInfo, 16)
+var file_o5_trigger_v1_trigger_j5s_proto_enumTypes = make([]protoimpl.EnumInfo, 5)
+var file_o5_trigger_v1_trigger_j5s_proto_msgTypes = make([]protoimpl.MessageInfo, 39)
 var file_o5_trigger_v1_trigger_j5s_proto_goTypes = []interface{}{
 	(TriggerStatus)(0),                         // 0: o5.trigger.v1.TriggerStatus
-	(*TriggerKeys)(nil),                        // 1: o5.trigger.v1.TriggerKeys
-	(*TriggerData)(nil),                        // 2: o5.trigger.v1.TriggerData
-	(*TriggerState)(nil),                       // 3: o5.trigger.v1.TriggerState
-	(*TriggerEventType)(nil),                   // 4: o5.trigger.v1.TriggerEventType
-	(*TriggerEvent)(nil),                       // 5: o5.trigger.v1.TriggerEvent
-	(*ActionType)(nil),                         // 6: o5.trigger.v1.ActionType
-	(*TriggerEventType_Created)(nil),           // 7: o5.trigger.v1.TriggerEventType.Created
-	(*TriggerEventType_Updated)(nil),           // 8: o5.trigger.v1.TriggerEventType.Updated
-	(*TriggerEventType_Paused)(nil),            // 9: o5.trigger.v1.TriggerEventType.Paused
-	(*TriggerEventType_Activated)(nil),         // 10: o5.trigger.v1.TriggerEventType.Activated
-	(*TriggerEventType_ManuallyTriggered)(nil), // 11: o5.trigger.v1.TriggerEventType.ManuallyTriggered
-	(*TriggerEventType_Triggered)(nil),         // 12: o5.trigger.v1.TriggerEventType.Triggered
-	(*TriggerEventType_Archived)(nil),          // 13: o5.trigger.v1.TriggerEventType.Archived
-	(*ActionType_Create)(nil),                  // 14: o5.trigger.v1.ActionType.Create
-	(*ActionType_Update)(nil),                  // 15: o5.trigger.v1.ActionType.Update
-	(*ActionType_Archive)(nil),                 // 16: o5.trigger.v1.ActionType.Archive
-	(*messaging_j5pb.RequestMetadata)(nil),     // 17: j5.messaging.v1.RequestMetadata
-	(*psm_j5pb.StateMetadata)(nil),             // 18: j5.state.v1.StateMetadata
-	(*psm_j5pb.EventMetadata)(nil),             // 19: j5.state.v1.EventMetadata
-	(*timestamppb.Timestamp)(nil),              // 20: google.protobuf.Timestamp
+	(CalendarStatus)(0),                        // 1: o5.trigger.v1.CalendarStatus
+	(CalendarRoll)(0),                          // 2: o5.trigger.v1.CalendarRoll
+	(DSTPolicy)(0),                             // 3: o5.trigger.v1.DSTPolicy
+	(CatchUpPolicy)(0),                         // 4: o5.trigger.v1.CatchUpPolicy
+	(*TriggerKeys)(nil),                        // 5: o5.trigger.v1.TriggerKeys
+	(*TriggerData)(nil),                        // 6: o5.trigger.v1.TriggerData
+	(*TriggerState)(nil),                       // 7: o5.trigger.v1.TriggerState
+	(*TriggerEventType)(nil),                   // 8: o5.trigger.v1.TriggerEventType
+	(*TriggerEvent)(nil),                       // 9: o5.trigger.v1.TriggerEvent
+	(*CalendarKeys)(nil),                       // 10: o5.trigger.v1.CalendarKeys
+	(*CalendarData)(nil),                       // 11: o5.trigger.v1.CalendarData
+	(*CalendarState)(nil),                      // 12: o5.trigger.v1.CalendarState
+	(*CalendarEventType)(nil),                  // 13: o5.trigger.v1.CalendarEventType
+	(*CalendarEvent)(nil),                      // 14: o5.trigger.v1.CalendarEvent
+	(*ActionType)(nil),                         // 15: o5.trigger.v1.ActionType
+	(*TriggerDefinition)(nil),                  // 16: o5.trigger.v1.TriggerDefinition
+	(*Interval)(nil),                           // 17: o5.trigger.v1.Interval
+	(*TriggerEventType_Created)(nil),           // 18: o5.trigger.v1.TriggerEventType.Created
+	(*TriggerEventType_Updated)(nil),           // 19: o5.trigger.v1.TriggerEventType.Updated
+	(*TriggerEventType_Paused)(nil),            // 20: o5.trigger.v1.TriggerEventType.Paused
+	(*TriggerEventType_Activated)(nil),         // 21: o5.trigger.v1.TriggerEventType.Activated
+	(*TriggerEventType_ManuallyTriggered)(nil), // 22: o5.trigger.v1.TriggerEventType.ManuallyTriggered
+	(*TriggerEventType_Triggered)(nil),         // 23: o5.trigger.v1.TriggerEventType.Triggered
+	(*TriggerEventType_Archived)(nil),          // 24: o5.trigger.v1.TriggerEventType.Archived
+	(*TriggerEventType_Completed)(nil),         // 25: o5.trigger.v1.TriggerEventType.Completed
+	(*TriggerEventType_Expired)(nil),           // 26: o5.trigger.v1.TriggerEventType.Expired
+	(*TriggerEventType_Restored)(nil),          // 27: o5.trigger.v1.TriggerEventType.Restored
+	(*TriggerEventType_Rescheduled)(nil),       // 28: o5.trigger.v1.TriggerEventType.Rescheduled
+	(*TriggerEventType_Faulted)(nil),           // 29: o5.trigger.v1.TriggerEventType.Faulted
+	(*CalendarEventType_Created)(nil),          // 30: o5.trigger.v1.CalendarEventType.Created
+	(*CalendarEventType_Updated)(nil),          // 31: o5.trigger.v1.CalendarEventType.Updated
+	(*CalendarEventType_Archived)(nil),         // 32: o5.trigger.v1.CalendarEventType.Archived
+	(*ActionType_Create)(nil),                  // 33: o5.trigger.v1.ActionType.Create
+	(*ActionType_Update)(nil),                  // 34: o5.trigger.v1.ActionType.Update
+	(*ActionType_Archive)(nil),                 // 35: o5.trigger.v1.ActionType.Archive
+	(*ActionType_CreateCalendar)(nil),          // 36: o5.trigger.v1.ActionType.CreateCalendar
+	(*ActionType_UpdateCalendar)(nil),          // 37: o5.trigger.v1.ActionType.UpdateCalendar
+	(*ActionType_ArchiveCalendar)(nil),         // 38: o5.trigger.v1.ActionType.ArchiveCalendar
+	(*ActionType_Sync)(nil),                    // 39: o5.trigger.v1.ActionType.Sync
+	(*ActionType_Pause)(nil),                   // 40: o5.trigger.v1.ActionType.Pause
+	(*ActionType_Resume)(nil),                  // 41: o5.trigger.v1.ActionType.Resume
+	(*ActionType_ManuallyTrigger)(nil),         // 42: o5.trigger.v1.ActionType.ManuallyTrigger
+	(*ActionType_Restore)(nil),                 // 43: o5.trigger.v1.ActionType.Restore
+	(*messaging_j5pb.RequestMetadata)(nil),     // 44: j5.messaging.v1.RequestMetadata
+	(*timestamppb.Timestamp)(nil),              // 45: google.protobuf.Timestamp
+	(*any_j5t.Any)(nil),                        // 46: j5.types.any.v1.Any
+	(*psm_j5pb.StateMetadata)(nil),             // 47: j5.state.v1.StateMetadata
+	(*psm_j5pb.EventMetadata)(nil),             // 48: j5.state.v1.EventMetadata
+	(*date_j5t.Date)(nil),                      // 49: j5.types.date.v1.Date
 }
 var file_o5_trigger_v1_trigger_j5s_proto_depIdxs = []int32{
-	17, // 0: o5.trigger.v1.TriggerData.request_metadata:type_name -> j5.messaging.v1.RequestMetadata
-	18, // 1: o5.trigger.v1.TriggerState.metadata:type_name -> j5.state.v1.StateMetadata
-	1,  // 2: o5.trigger.v1.TriggerState.keys:type_name -> o5.trigger.v1.TriggerKeys
-	2,  // 3: o5.trigger.v1.TriggerState.data:type_name -> o5.trigger.v1.TriggerData
-	0,  // 4: o5.trigger.v1.TriggerState.status:type_name -> o5.trigger.v1.TriggerStatus
-	7,  // 5: o5.trigger.v1.TriggerEventType.created:type_name -> o5.trigger.v1.TriggerEventType.Created
-	8,  // 6: o5.trigger.v1.TriggerEventType.updated:type_name -> o5.trigger.v1.TriggerEventType.Updated
-	9,  // 7: o5.trigger.v1.TriggerEventType.paused:type_name -> o5.trigger.v1.TriggerEventType.Paused
-	10, // 8: o5.trigger.v1.TriggerEventType.activated:type_name -> o5.trigger.v1.TriggerEventType.Activated
-	11, // 9: o5.trigger.v1.TriggerEventType.manually_triggered:type_name -> o5.trigger.v1.TriggerEventType.ManuallyTriggered
-	12, // 10: o5.trigger.v1.TriggerEventType.triggered:type_name -> o5.trigger.v1.TriggerEventType.Triggered
-	13, // 11: o5.trigger.v1.TriggerEventType.archived:type_name -> o5.trigger.v1.TriggerEventType.Archived
-	19, // 12: o5.trigger.v1.TriggerEvent.metadata:type_name -> j5.state.v1.EventMetadata
-	1,  // 13: o5.trigger.v1.TriggerEvent.keys:type_name -> o5.trigger.v1.TriggerKeys
-	4,  // 14: o5.trigger.v1.TriggerEvent.event:type_name -> o5.trigger.v1.TriggerEventType
-	14, // 15: o5.trigger.v1.ActionType.create:type_name -> o5.trigger.v1.ActionType.Create
-	15, // 16: o5.trigger.v1.ActionType.update:type_name -> o5.trigger.v1.ActionType.Update
-	16, // 17: o5.trigger.v1.ActionType.archive:type_name -> o5.trigger.v1.ActionType.Archive
-	17, // 18: o5.trigger.v1.TriggerEventType.Created.request_metadata:type_name -> j5.messaging.v1.RequestMetadata
-	17, // 19: o5.trigger.v1.TriggerEventType.Updated.request_metadata:type_name -> j5.messaging.v1.RequestMetadata
-	20, // 20: o5.trigger.v1.TriggerEventType.ManuallyTriggered.trigger_time:type_name -> google.protobuf.Timestamp
-	20, // 21: o5.trigger.v1.TriggerEventType.Triggered.trigger_time:type_name -> google.protobuf.Timestamp
-	22, // [22:22] is the sub-list for method output_type
-	22, // [22:22] is the sub-list for method input_type
-	22, // [22:22] is the sub-list for extension type_name
-	22, // [22:22] is the sub-list for extension extendee
-	0,  // [0:22] is the sub-list for field type_name
+	44,  // 0: o5.trigger.v1.TriggerData.request_metadata:type_name -> j5.messaging.v1.RequestMetadata
+	4,   // 1: o5.trigger.v1.TriggerData.catch_up:type_name -> o5.trigger.v1.CatchUpPolicy
+	45,  // 2: o5.trigger.v1.TriggerData.run_at:type_name -> google.protobuf.Timestamp
+	17,  // 3: o5.trigger.v1.TriggerData.interval:type_name -> o5.trigger.v1.Interval
+	45,  // 4: o5.trigger.v1.TriggerData.not_before:type_name -> google.protobuf.Timestamp
+	45,  // 5: o5.trigger.v1.TriggerData.not_after:type_name -> google.protobuf.Timestamp
+	3,   // 6: o5.trigger.v1.TriggerData.dst_policy:type_name -> o5.trigger.v1.DSTPolicy
+	2,   // 7: o5.trigger.v1.TriggerData.calendar_roll:type_name -> o5.trigger.v1.CalendarRoll
+	46,  // 8: o5.trigger.v1.TriggerData.payload:type_name -> j5.types.any.v1.Any
+	45,  // 9: o5.trigger.v1.TriggerData.last_fired_at:type_name -> google.protobuf.Timestamp
+	45,  // 10: o5.trigger.v1.TriggerData.next_fire_at:type_name -> google.protobuf.Timestamp
+	47,  // 11: o5.trigger.v1.TriggerState.metadata:type_name -> j5.state.v1.StateMetadata
+	5,   // 12: o5.trigger.v1.TriggerState.keys:type_name -> o5.trigger.v1.TriggerKeys
+	6,   // 13: o5.trigger.v1.TriggerState.data:type_name -> o5.trigger.v1.TriggerData
+	0,   // 14: o5.trigger.v1.TriggerState.status:type_name -> o5.trigger.v1.TriggerStatus
+	18,  // 15: o5.trigger.v1.TriggerEventType.created:type_name -> o5.trigger.v1.TriggerEventType.Created
+	19,  // 16: o5.trigger.v1.TriggerEventType.updated:type_name -> o5.trigger.v1.TriggerEventType.Updated
+	20,  // 17: o5.trigger.v1.TriggerEventType.paused:type_name -> o5.trigger.v1.TriggerEventType.Paused
+	21,  // 18: o5.trigger.v1.TriggerEventType.activated:type_name -> o5.trigger.v1.TriggerEventType.Activated
+	22,  // 19: o5.trigger.v1.TriggerEventType.manually_triggered:type_name -> o5.trigger.v1.TriggerEventType.ManuallyTriggered
+	23,  // 20: o5.trigger.v1.TriggerEventType.triggered:type_name -> o5.trigger.v1.TriggerEventType.Triggered
+	24,  // 21: o5.trigger.v1.TriggerEventType.archived:type_name -> o5.trigger.v1.TriggerEventType.Archived
+	25,  // 22: o5.trigger.v1.TriggerEventType.completed:type_name -> o5.trigger.v1.TriggerEventType.Completed
+	26,  // 23: o5.trigger.v1.TriggerEventType.expired:type_name -> o5.trigger.v1.TriggerEventType.Expired
+	27,  // 24: o5.trigger.v1.TriggerEventType.restored:type_name -> o5.trigger.v1.TriggerEventType.Restored
+	28,  // 25: o5.trigger.v1.TriggerEventType.rescheduled:type_name -> o5.trigger.v1.TriggerEventType.Rescheduled
+	29,  // 26: o5.trigger.v1.TriggerEventType.faulted:type_name -> o5.trigger.v1.TriggerEventType.Faulted
+	48,  // 27: o5.trigger.v1.TriggerEvent.metadata:type_name -> j5.state.v1.EventMetadata
+	5,   // 28: o5.trigger.v1.TriggerEvent.keys:type_name -> o5.trigger.v1.TriggerKeys
+	8,   // 29: o5.trigger.v1.TriggerEvent.event:type_name -> o5.trigger.v1.TriggerEventType
+	49,  // 30: o5.trigger.v1.CalendarData.excluded_dates:type_name -> j5.types.date.v1.Date
+	47,  // 31: o5.trigger.v1.CalendarState.metadata:type_name -> j5.state.v1.StateMetadata
+	10,  // 32: o5.trigger.v1.CalendarState.keys:type_name -> o5.trigger.v1.CalendarKeys
+	11,  // 33: o5.trigger.v1.CalendarState.data:type_name -> o5.trigger.v1.CalendarData
+	1,   // 34: o5.trigger.v1.CalendarState.status:type_name -> o5.trigger.v1.CalendarStatus
+	30,  // 35: o5.trigger.v1.CalendarEventType.created:type_name -> o5.trigger.v1.CalendarEventType.Created
+	31,  // 36: o5.trigger.v1.CalendarEventType.updated:type_name -> o5.trigger.v1.CalendarEventType.Updated
+	32,  // 37: o5.trigger.v1.CalendarEventType.archived:type_name -> o5.trigger.v1.CalendarEventType.Archived
+	48,  // 38: o5.trigger.v1.CalendarEvent.metadata:type_name -> j5.state.v1.EventMetadata
+	10,  // 39: o5.trigger.v1.CalendarEvent.keys:type_name -> o5.trigger.v1.CalendarKeys
+	13,  // 40: o5.trigger.v1.CalendarEvent.event:type_name -> o5.trigger.v1.CalendarEventType
+	33,  // 41: o5.trigger.v1.ActionType.create:type_name -> o5.trigger.v1.ActionType.Create
+	34,  // 42: o5.trigger.v1.ActionType.update:type_name -> o5.trigger.v1.ActionType.Update
+	35,  // 43: o5.trigger.v1.ActionType.archive:type_name -> o5.trigger.v1.ActionType.Archive
+	36,  // 44: o5.trigger.v1.ActionType.create_calendar:type_name -> o5.trigger.v1.ActionType.CreateCalendar
+	37,  // 45: o5.trigger.v1.ActionType.update_calendar:type_name -> o5.trigger.v1.ActionType.UpdateCalendar
+	38,  // 46: o5.trigger.v1.ActionType.archive_calendar:type_name -> o5.trigger.v1.ActionType.ArchiveCalendar
+	39,  // 47: o5.trigger.v1.ActionType.sync:type_name -> o5.trigger.v1.ActionType.Sync
+	40,  // 48: o5.trigger.v1.ActionType.pause:type_name -> o5.trigger.v1.ActionType.Pause
+	41,  // 49: o5.trigger.v1.ActionType.resume:type_name -> o5.trigger.v1.ActionType.Resume
+	42,  // 50: o5.trigger.v1.ActionType.manually_trigger:type_name -> o5.trigger.v1.ActionType.ManuallyTrigger
+	43,  // 51: o5.trigger.v1.ActionType.restore:type_name -> o5.trigger.v1.ActionType.Restore
+	4,   // 52: o5.trigger.v1.TriggerDefinition.catch_up:type_name -> o5.trigger.v1.CatchUpPolicy
+	45,  // 53: o5.trigger.v1.TriggerDefinition.run_at:type_name -> google.protobuf.Timestamp
+	17,  // 54: o5.trigger.v1.TriggerDefinition.interval:type_name -> o5.trigger.v1.Interval
+	45,  // 55: o5.trigger.v1.TriggerDefinition.not_before:type_name -> google.protobuf.Timestamp
+	45,  // 56: o5.trigger.v1.TriggerDefinition.not_after:type_name -> google.protobuf.Timestamp
+	3,   // 57: o5.trigger.v1.TriggerDefinition.dst_policy:type_name -> o5.trigger.v1.DSTPolicy
+	2,   // 58: o5.trigger.v1.TriggerDefinition.calendar_roll:type_name -> o5.trigger.v1.CalendarRoll
+	46,  // 59: o5.trigger.v1.TriggerDefinition.payload:type_name -> j5.types.any.v1.Any
+	45,  // 60: o5.trigger.v1.Interval.anchor:type_name -> google.protobuf.Timestamp
+	44,  // 61: o5.trigger.v1.TriggerEventType.Created.request_metadata:type_name -> j5.messaging.v1.RequestMetadata
+	4,   // 62: o5.trigger.v1.TriggerEventType.Created.catch_up:type_name -> o5.trigger.v1.CatchUpPolicy
+	45,  // 63: o5.trigger.v1.TriggerEventType.Created.run_at:type_name -> google.protobuf.Timestamp
+	17,  // 64: o5.trigger.v1.TriggerEventType.Created.interval:type_name -> o5.trigger.v1.Interval
+	45,  // 65: o5.trigger.v1.TriggerEventType.Created.not_before:type_name -> google.protobuf.Timestamp
+	45,  // 66: o5.trigger.v1.TriggerEventType.Created.not_after:type_name -> google.protobuf.Timestamp
+	3,   // 67: o5.trigger.v1.TriggerEventType.Created.dst_policy:type_name -> o5.trigger.v1.DSTPolicy
+	2,   // 68: o5.trigger.v1.TriggerEventType.Created.calendar_roll:type_name -> o5.trigger.v1.CalendarRoll
+	46,  // 69: o5.trigger.v1.TriggerEventType.Created.payload:type_name -> j5.types.any.v1.Any
+	45,  // 70: o5.trigger.v1.TriggerEventType.Created.next_fire_at:type_name -> google.protobuf.Timestamp
+	44,  // 71: o5.trigger.v1.TriggerEventType.Updated.request_metadata:type_name -> j5.messaging.v1.RequestMetadata
+	4,   // 72: o5.trigger.v1.TriggerEventType.Updated.catch_up:type_name -> o5.trigger.v1.CatchUpPolicy
+	45,  // 73: o5.trigger.v1.TriggerEventType.Updated.run_at:type_name -> google.protobuf.Timestamp
+	17,  // 74: o5.trigger.v1.TriggerEventType.Updated.interval:type_name -> o5.trigger.v1.Interval
+	45,  // 75: o5.trigger.v1.TriggerEventType.Updated.not_before:type_name -> google.protobuf.Timestamp
+	45,  // 76: o5.trigger.v1.TriggerEventType.Updated.not_after:type_name -> google.protobuf.Timestamp
+	3,   // 77: o5.trigger.v1.TriggerEventType.Updated.dst_policy:type_name -> o5.trigger.v1.DSTPolicy
+	2,   // 78: o5.trigger.v1.TriggerEventType.Updated.calendar_roll:type_name -> o5.trigger.v1.CalendarRoll
+	46,  // 79: o5.trigger.v1.TriggerEventType.Updated.payload:type_name -> j5.types.any.v1.Any
+	45,  // 80: o5.trigger.v1.TriggerEventType.Updated.next_fire_at:type_name -> google.protobuf.Timestamp
+	45,  // 81: o5.trigger.v1.TriggerEventType.Activated.next_fire_at:type_name -> google.protobuf.Timestamp
+	45,  // 82: o5.trigger.v1.TriggerEventType.ManuallyTriggered.trigger_time:type_name -> google.protobuf.Timestamp
+	45,  // 83: o5.trigger.v1.TriggerEventType.Triggered.trigger_time:type_name -> google.protobuf.Timestamp
+	45,  // 84: o5.trigger.v1.TriggerEventType.Triggered.next_fire_at:type_name -> google.protobuf.Timestamp
+	45,  // 85: o5.trigger.v1.TriggerEventType.Restored.next_fire_at:type_name -> google.protobuf.Timestamp
+	45,  // 86: o5.trigger.v1.TriggerEventType.Rescheduled.next_fire_at:type_name -> google.protobuf.Timestamp
+	49,  // 87: o5.trigger.v1.CalendarEventType.Created.excluded_dates:type_name -> j5.types.date.v1.Date
+	49,  // 88: o5.trigger.v1.CalendarEventType.Updated.excluded_dates:type_name -> j5.types.date.v1.Date
+	4,   // 89: o5.trigger.v1.ActionType.Create.catch_up:type_name -> o5.trigger.v1.CatchUpPolicy
+	45,  // 90: o5.trigger.v1.ActionType.Create.run_at:type_name -> google.protobuf.Timestamp
+	17,  // 91: o5.trigger.v1.ActionType.Create.interval:type_name -> o5.trigger.v1.Interval
+	45,  // 92: o5.trigger.v1.ActionType.Create.not_before:type_name -> google.protobuf.Timestamp
+	45,  // 93: o5.trigger.v1.ActionType.Create.not_after:type_name -> google.protobuf.Timestamp
+	3,   // 94: o5.trigger.v1.ActionType.Create.dst_policy:type_name -> o5.trigger.v1.DSTPolicy
+	2,   // 95: o5.trigger.v1.ActionType.Create.calendar_roll:type_name -> o5.trigger.v1.CalendarRoll
+	46,  // 96: o5.trigger.v1.ActionType.Create.payload:type_name -> j5.types.any.v1.Any
+	4,   // 97: o5.trigger.v1.ActionType.Update.catch_up:type_name -> o5.trigger.v1.CatchUpPolicy
+	45,  // 98: o5.trigger.v1.ActionType.Update.run_at:type_name -> google.protobuf.Timestamp
+	17,  // 99: o5.trigger.v1.ActionType.Update.interval:type_name -> o5.trigger.v1.Interval
+	45,  // 100: o5.trigger.v1.ActionType.Update.not_before:type_name -> google.protobuf.Timestamp
+	45,  // 101: o5.trigger.v1.ActionType.Update.not_after:type_name -> google.protobuf.Timestamp
+	3,   // 102: o5.trigger.v1.ActionType.Update.dst_policy:type_name -> o5.trigger.v1.DSTPolicy
+	2,   // 103: o5.trigger.v1.ActionType.Update.calendar_roll:type_name -> o5.trigger.v1.CalendarRoll
+	46,  // 104: o5.trigger.v1.ActionType.Update.payload:type_name -> j5.types.any.v1.Any
+	49,  // 105: o5.trigger.v1.ActionType.CreateCalendar.excluded_dates:type_name -> j5.types.date.v1.Date
+	49,  // 106: o5.trigger.v1.ActionType.UpdateCalendar.excluded_dates:type_name -> j5.types.date.v1.Date
+	16,  // 107: o5.trigger.v1.ActionType.Sync.triggers:type_name -> o5.trigger.v1.TriggerDefinition
+	45,  // 108: o5.trigger.v1.ActionType.ManuallyTrigger.trigger_time:type_name -> google.protobuf.Timestamp
+	109, // [109:109] is the sub-list for method output_type
+	109, // [109:109] is the sub-list for method input_type
+	109, // [109:109] is the sub-list for extension type_name
+	109, // [109:109] is the sub-list for extension extendee
+	0,   // [0:109] is the sub-list for field type_name
 }
 
 func init() { file_o5_trigger_v1_trigger_j5s_proto_init() }
@@ -1483,7 +4685,7 @@ func file_o5_trigger_v1_trigger_j5s_proto_init() {
 			}
 		}
 		file_o5_trigger_v1_trigger_j5s_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ActionType); i {
+			switch v := v.(*CalendarKeys); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1495,7 +4697,7 @@ func file_o5_trigger_v1_trigger_j5s_proto_init() {
 			}
 		}
 		file_o5_trigger_v1_trigger_j5s_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TriggerEventType_Created); i {
+			switch v := v.(*CalendarData); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1507,7 +4709,7 @@ func file_o5_trigger_v1_trigger_j5s_proto_init() {
 			}
 		}
 		file_o5_trigger_v1_trigger_j5s_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TriggerEventType_Updated); i {
+			switch v := v.(*CalendarState); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1519,7 +4721,7 @@ func file_o5_trigger_v1_trigger_j5s_proto_init() {
 			}
 		}
 		file_o5_trigger_v1_trigger_j5s_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TriggerEventType_Paused); i {
+			switch v := v.(*CalendarEventType); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1531,7 +4733,7 @@ func file_o5_trigger_v1_trigger_j5s_proto_init() {
 			}
 		}
 		file_o5_trigger_v1_trigger_j5s_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TriggerEventType_Activated); i {
+			switch v := v.(*CalendarEvent); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1543,7 +4745,7 @@ func file_o5_trigger_v1_trigger_j5s_proto_init() {
 			}
 		}
 		file_o5_trigger_v1_trigger_j5s_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TriggerEventType_ManuallyTriggered); i {
+			switch v := v.(*ActionType); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1555,7 +4757,7 @@ func file_o5_trigger_v1_trigger_j5s_proto_init() {
 			}
 		}
 		file_o5_trigger_v1_trigger_j5s_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TriggerEventType_Triggered); i {
+			switch v := v.(*TriggerDefinition); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1567,7 +4769,7 @@ func file_o5_trigger_v1_trigger_j5s_proto_init() {
 			}
 		}
 		file_o5_trigger_v1_trigger_j5s_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TriggerEventType_Archived); i {
+			switch v := v.(*Interval); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1579,7 +4781,7 @@ func file_o5_trigger_v1_trigger_j5s_proto_init() {
 			}
 		}
 		file_o5_trigger_v1_trigger_j5s_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ActionType_Create); i {
+			switch v := v.(*TriggerEventType_Created); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1591,7 +4793,7 @@ func file_o5_trigger_v1_trigger_j5s_proto_init() {
 			}
 		}
 		file_o5_trigger_v1_trigger_j5s_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ActionType_Update); i {
+			switch v := v.(*TriggerEventType_Updated); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1603,6 +4805,186 @@ func file_o5_trigger_v1_trigger_j5s_proto_init() {
 			}
 		}
 		file_o5_trigger_v1_trigger_j5s_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TriggerEventType_Paused); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_o5_trigger_v1_trigger_j5s_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TriggerEventType_Activated); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_o5_trigger_v1_trigger_j5s_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TriggerEventType_ManuallyTriggered); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_o5_trigger_v1_trigger_j5s_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TriggerEventType_Triggered); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_o5_trigger_v1_trigger_j5s_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TriggerEventType_Archived); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_o5_trigger_v1_trigger_j5s_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TriggerEventType_Completed); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_o5_trigger_v1_trigger_j5s_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TriggerEventType_Expired); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_o5_trigger_v1_trigger_j5s_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TriggerEventType_Restored); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_o5_trigger_v1_trigger_j5s_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TriggerEventType_Rescheduled); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_o5_trigger_v1_trigger_j5s_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TriggerEventType_Faulted); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_o5_trigger_v1_trigger_j5s_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CalendarEventType_Created); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_o5_trigger_v1_trigger_j5s_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CalendarEventType_Updated); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_o5_trigger_v1_trigger_j5s_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CalendarEventType_Archived); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_o5_trigger_v1_trigger_j5s_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ActionType_Create); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_o5_trigger_v1_trigger_j5s_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ActionType_Update); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_o5_trigger_v1_trigger_j5s_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ActionType_Archive); i {
 			case 0:
 				return &v.state
@@ -1614,7 +4996,104 @@ func file_o5_trigger_v1_trigger_j5s_proto_init() {
 				return nil
 			}
 		}
+		file_o5_trigger_v1_trigger_j5s_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ActionType_CreateCalendar); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_o5_trigger_v1_trigger_j5s_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ActionType_UpdateCalendar); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_o5_trigger_v1_trigger_j5s_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ActionType_ArchiveCalendar); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_o5_trigger_v1_trigger_j5s_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ActionType_Sync); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_o5_trigger_v1_trigger_j5s_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ActionType_Pause); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_o5_trigger_v1_trigger_j5s_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ActionType_Resume); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_o5_trigger_v1_trigger_j5s_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ActionType_ManuallyTrigger); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_o5_trigger_v1_trigger_j5s_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ActionType_Restore); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
+	file_o5_trigger_v1_trigger_j5s_proto_msgTypes[1].OneofWrappers = []interface{}{}
 	file_o5_trigger_v1_trigger_j5s_proto_msgTypes[3].OneofWrappers = []interface{}{
 		(*TriggerEventType_Created_)(nil),
 		(*TriggerEventType_Updated_)(nil),
@@ -1623,20 +5102,48 @@ func file_o5_trigger_v1_trigger_j5s_proto_init() {
 		(*TriggerEventType_ManuallyTriggered_)(nil),
 		(*TriggerEventType_Triggered_)(nil),
 		(*TriggerEventType_Archived_)(nil),
+		(*TriggerEventType_Completed_)(nil),
+		(*TriggerEventType_Expired_)(nil),
+		(*TriggerEventType_Restored_)(nil),
+		(*TriggerEventType_Rescheduled_)(nil),
+		(*TriggerEventType_Faulted_)(nil),
+	}
+	file_o5_trigger_v1_trigger_j5s_proto_msgTypes[8].OneofWrappers = []interface{}{
+		(*CalendarEventType_Created_)(nil),
+		(*CalendarEventType_Updated_)(nil),
+		(*CalendarEventType_Archived_)(nil),
 	}
-	file_o5_trigger_v1_trigger_j5s_proto_msgTypes[5].OneofWrappers = []interface{}{
+	file_o5_trigger_v1_trigger_j5s_proto_msgTypes[10].OneofWrappers = []interface{}{
 		(*ActionType_Create_)(nil),
 		(*ActionType_Update_)(nil),
 		(*ActionType_Archive_)(nil),
+		(*ActionType_CreateCalendar_)(nil),
+		(*ActionType_UpdateCalendar_)(nil),
+		(*ActionType_ArchiveCalendar_)(nil),
+		(*ActionType_Sync_)(nil),
+		(*ActionType_Pause_)(nil),
+		(*ActionType_Resume_)(nil),
+		(*ActionType_ManuallyTrigger_)(nil),
+		(*ActionType_Restore_)(nil),
 	}
+	file_o5_trigger_v1_trigger_j5s_proto_msgTypes[11].OneofWrappers = []interface{}{}
 	file_o5_trigger_v1_trigger_j5s_proto_msgTypes[13].OneofWrappers = []interface{}{}
+	file_o5_trigger_v1_trigger_j5s_proto_msgTypes[14].OneofWrappers = []interface{}{}
+	file_o5_trigger_v1_trigger_j5s_proto_msgTypes[16].OneofWrappers = []interface{}{}
+	file_o5_trigger_v1_trigger_j5s_proto_msgTypes[18].OneofWrappers = []interface{}{}
+	file_o5_trigger_v1_trigger_j5s_proto_msgTypes[22].OneofWrappers = []interface{}{}
+	file_o5_trigger_v1_trigger_j5s_proto_msgTypes[23].OneofWrappers = []interface{}{}
+	file_o5_trigger_v1_trigger_j5s_proto_msgTypes[28].OneofWrappers = []interface{}{}
+	file_o5_trigger_v1_trigger_j5s_proto_msgTypes[29].OneofWrappers = []interface{}{}
+	file_o5_trigger_v1_trigger_j5s_proto_msgTypes[31].OneofWrappers = []interface{}{}
+	file_o5_trigger_v1_trigger_j5s_proto_msgTypes[37].OneofWrappers = []interface{}{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_o5_trigger_v1_trigger_j5s_proto_rawDesc,
-			NumEnums:      1,
-			NumMessages:   16,
+			NumEnums:      5,
+			NumMessages:   39,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -53,6 +53,11 @@ const (
 	TriggerEvent_Type_ManuallyTriggered TriggerEventTypeKey = "manuallyTriggered"
 	TriggerEvent_Type_Triggered         TriggerEventTypeKey = "triggered"
 	TriggerEvent_Type_Archived          TriggerEventTypeKey = "archived"
+	TriggerEvent_Type_Completed         TriggerEventTypeKey = "completed"
+	TriggerEvent_Type_Expired           TriggerEventTypeKey = "expired"
+	TriggerEvent_Type_Restored          TriggerEventTypeKey = "restored"
+	TriggerEvent_Type_Rescheduled       TriggerEventTypeKey = "rescheduled"
+	TriggerEvent_Type_Faulted           TriggerEventTypeKey = "faulted"
 )
 
 func (x *TriggerEventType) TypeKey() (TriggerEventTypeKey, bool) {
@@ -71,6 +76,16 @@ func (x *TriggerEventType) TypeKey() (TriggerEventTypeKey, bool) {
 		return TriggerEvent_Type_Triggered, true
 	case *TriggerEventType_Archived_:
 		return TriggerEvent_Type_Archived, true
+	case *TriggerEventType_Completed_:
+		return TriggerEvent_Type_Completed, true
+	case *TriggerEventType_Expired_:
+		return TriggerEvent_Type_Expired, true
+	case *TriggerEventType_Restored_:
+		return TriggerEvent_Type_Restored, true
+	case *TriggerEventType_Rescheduled_:
+		return TriggerEvent_Type_Rescheduled, true
+	case *TriggerEventType_Faulted_:
+		return TriggerEvent_Type_Faulted, true
 	default:
 		return "", false
 	}
@@ -97,6 +112,16 @@ func (x *TriggerEventType) Set(val IsTriggerEventTypeWrappedType) {
 		x.Type = &TriggerEventType_Triggered_{Triggered: v}
 	case *TriggerEventType_Archived:
 		x.Type = &TriggerEventType_Archived_{Archived: v}
+	case *TriggerEventType_Completed:
+		x.Type = &TriggerEventType_Completed_{Completed: v}
+	case *TriggerEventType_Expired:
+		x.Type = &TriggerEventType_Expired_{Expired: v}
+	case *TriggerEventType_Restored:
+		x.Type = &TriggerEventType_Restored_{Restored: v}
+	case *TriggerEventType_Rescheduled:
+		x.Type = &TriggerEventType_Rescheduled_{Rescheduled: v}
+	case *TriggerEventType_Faulted:
+		x.Type = &TriggerEventType_Faulted_{Faulted: v}
 	}
 }
 func (x *TriggerEventType) Get() IsTriggerEventTypeWrappedType {
@@ -115,6 +140,16 @@ func (x *TriggerEventType) Get() IsTriggerEventTypeWrappedType {
 		return v.Triggered
 	case *TriggerEventType_Archived_:
 		return v.Archived
+	case *TriggerEventType_Completed_:
+		return v.Completed
+	case *TriggerEventType_Expired_:
+		return v.Expired
+	case *TriggerEventType_Restored_:
+		return v.Restored
+	case *TriggerEventType_Rescheduled_:
+		return v.Rescheduled
+	case *TriggerEventType_Faulted_:
+		return v.Faulted
 	default:
 		return nil
 	}
@@ -140,6 +175,21 @@ func (x *TriggerEventType_Triggered) TriggerEventTypeKey() TriggerEventTypeKey {
 func (x *TriggerEventType_Archived) TriggerEventTypeKey() TriggerEventTypeKey {
 	return TriggerEvent_Type_Archived
 }
+func (x *TriggerEventType_Completed) TriggerEventTypeKey() TriggerEventTypeKey {
+	return TriggerEvent_Type_Completed
+}
+func (x *TriggerEventType_Expired) TriggerEventTypeKey() TriggerEventTypeKey {
+	return TriggerEvent_Type_Expired
+}
+func (x *TriggerEventType_Restored) TriggerEventTypeKey() TriggerEventTypeKey {
+	return TriggerEvent_Type_Restored
+}
+func (x *TriggerEventType_Rescheduled) TriggerEventTypeKey() TriggerEventTypeKey {
+	return TriggerEvent_Type_Rescheduled
+}
+func (x *TriggerEventType_Faulted) TriggerEventTypeKey() TriggerEventTypeKey {
+	return TriggerEvent_Type_Faulted
+}
 func (msg *TriggerEventType) Clone() any {
 	return proto.Clone(msg).(*TriggerEventType)
 }
@@ -227,6 +277,61 @@ func (msg *TriggerEventType_Archived) J5Object() j5reflect.Object {
 	return j5reflect.MustReflect(msg.ProtoReflect()).(j5reflect.Object)
 }
 
+func (msg *TriggerEventType_Completed) Clone() any {
+	return proto.Clone(msg).(*TriggerEventType_Completed)
+}
+func (msg *TriggerEventType_Completed) J5Reflect() j5reflect.Root {
+	return j5reflect.MustReflect(msg.ProtoReflect())
+}
+
+func (msg *TriggerEventType_Completed) J5Object() j5reflect.Object {
+	return j5reflect.MustReflect(msg.ProtoReflect()).(j5reflect.Object)
+}
+
+func (msg *TriggerEventType_Expired) Clone() any {
+	return proto.Clone(msg).(*TriggerEventType_Expired)
+}
+func (msg *TriggerEventType_Expired) J5Reflect() j5reflect.Root {
+	return j5reflect.MustReflect(msg.ProtoReflect())
+}
+
+func (msg *TriggerEventType_Expired) J5Object() j5reflect.Object {
+	return j5reflect.MustReflect(msg.ProtoReflect()).(j5reflect.Object)
+}
+
+func (msg *TriggerEventType_Restored) Clone() any {
+	return proto.Clone(msg).(*TriggerEventType_Restored)
+}
+func (msg *TriggerEventType_Restored) J5Reflect() j5reflect.Root {
+	return j5reflect.MustReflect(msg.ProtoReflect())
+}
+
+func (msg *TriggerEventType_Restored) J5Object() j5reflect.Object {
+	return j5reflect.MustReflect(msg.ProtoReflect()).(j5reflect.Object)
+}
+
+func (msg *TriggerEventType_Rescheduled) Clone() any {
+	return proto.Clone(msg).(*TriggerEventType_Rescheduled)
+}
+func (msg *TriggerEventType_Rescheduled) J5Reflect() j5reflect.Root {
+	return j5reflect.MustReflect(msg.ProtoReflect())
+}
+
+func (msg *TriggerEventType_Rescheduled) J5Object() j5reflect.Object {
+	return j5reflect.MustReflect(msg.ProtoReflect()).(j5reflect.Object)
+}
+
+func (msg *TriggerEventType_Faulted) Clone() any {
+	return proto.Clone(msg).(*TriggerEventType_Faulted)
+}
+func (msg *TriggerEventType_Faulted) J5Reflect() j5reflect.Root {
+	return j5reflect.MustReflect(msg.ProtoReflect())
+}
+
+func (msg *TriggerEventType_Faulted) J5Object() j5reflect.Object {
+	return j5reflect.MustReflect(msg.ProtoReflect()).(j5reflect.Object)
+}
+
 func (msg *TriggerEvent) Clone() any {
 	return proto.Clone(msg).(*TriggerEvent)
 }
@@ -238,13 +343,166 @@ func (msg *TriggerEvent) J5Object() j5reflect.Object {
 	return j5reflect.MustReflect(msg.ProtoReflect()).(j5reflect.Object)
 }
 
+func (msg *CalendarKeys) Clone() any {
+	return proto.Clone(msg).(*CalendarKeys)
+}
+func (msg *CalendarKeys) J5Reflect() j5reflect.Root {
+	return j5reflect.MustReflect(msg.ProtoReflect())
+}
+
+func (msg *CalendarKeys) J5Object() j5reflect.Object {
+	return j5reflect.MustReflect(msg.ProtoReflect()).(j5reflect.Object)
+}
+
+func (msg *CalendarData) Clone() any {
+	return proto.Clone(msg).(*CalendarData)
+}
+func (msg *CalendarData) J5Reflect() j5reflect.Root {
+	return j5reflect.MustReflect(msg.ProtoReflect())
+}
+
+func (msg *CalendarData) J5Object() j5reflect.Object {
+	return j5reflect.MustReflect(msg.ProtoReflect()).(j5reflect.Object)
+}
+
+func (msg *CalendarState) Clone() any {
+	return proto.Clone(msg).(*CalendarState)
+}
+func (msg *CalendarState) J5Reflect() j5reflect.Root {
+	return j5reflect.MustReflect(msg.ProtoReflect())
+}
+
+func (msg *CalendarState) J5Object() j5reflect.Object {
+	return j5reflect.MustReflect(msg.ProtoReflect()).(j5reflect.Object)
+}
+
+// CalendarEventType is a oneof wrapper
+type CalendarEventTypeKey string
+
+const (
+	CalendarEvent_Type_Created  CalendarEventTypeKey = "created"
+	CalendarEvent_Type_Updated  CalendarEventTypeKey = "updated"
+	CalendarEvent_Type_Archived CalendarEventTypeKey = "archived"
+)
+
+func (x *CalendarEventType) TypeKey() (CalendarEventTypeKey, bool) {
+	switch x.Type.(type) {
+	case *CalendarEventType_Created_:
+		return CalendarEvent_Type_Created, true
+	case *CalendarEventType_Updated_:
+		return CalendarEvent_Type_Updated, true
+	case *CalendarEventType_Archived_:
+		return CalendarEvent_Type_Archived, true
+	default:
+		return "", false
+	}
+}
+
+type IsCalendarEventTypeWrappedType interface {
+	CalendarEventTypeKey() CalendarEventTypeKey
+	proto.Message
+}
+
+func (x *CalendarEventType) Set(val IsCalendarEventTypeWrappedType) {
+	switch v := val.(type) {
+	case *CalendarEventType_Created:
+		x.Type = &CalendarEventType_Created_{Created: v}
+	case *CalendarEventType_Updated:
+		x.Type = &CalendarEventType_Updated_{Updated: v}
+	case *CalendarEventType_Archived:
+		x.Type = &CalendarEventType_Archived_{Archived: v}
+	}
+}
+func (x *CalendarEventType) Get() IsCalendarEventTypeWrappedType {
+	switch v := x.Type.(type) {
+	case *CalendarEventType_Created_:
+		return v.Created
+	case *CalendarEventType_Updated_:
+		return v.Updated
+	case *CalendarEventType_Archived_:
+		return v.Archived
+	default:
+		return nil
+	}
+}
+func (x *CalendarEventType_Created) CalendarEventTypeKey() CalendarEventTypeKey {
+	return CalendarEvent_Type_Created
+}
+func (x *CalendarEventType_Updated) CalendarEventTypeKey() CalendarEventTypeKey {
+	return CalendarEvent_Type_Updated
+}
+func (x *CalendarEventType_Archived) CalendarEventTypeKey() CalendarEventTypeKey {
+	return CalendarEvent_Type_Archived
+}
+func (msg *CalendarEventType) Clone() any {
+	return proto.Clone(msg).(*CalendarEventType)
+}
+
+type IsCalendarEventType_Type = isCalendarEventType_Type
+
+func (msg *CalendarEventType) J5Reflect() j5reflect.Root {
+	return j5reflect.MustReflect(msg.ProtoReflect())
+}
+
+func (msg *CalendarEventType_Created) Clone() any {
+	return proto.Clone(msg).(*CalendarEventType_Created)
+}
+func (msg *CalendarEventType_Created) J5Reflect() j5reflect.Root {
+	return j5reflect.MustReflect(msg.ProtoReflect())
+}
+
+func (msg *CalendarEventType_Created) J5Object() j5reflect.Object {
+	return j5reflect.MustReflect(msg.ProtoReflect()).(j5reflect.Object)
+}
+
+func (msg *CalendarEventType_Updated) Clone() any {
+	return proto.Clone(msg).(*CalendarEventType_Updated)
+}
+func (msg *CalendarEventType_Updated) J5Reflect() j5reflect.Root {
+	return j5reflect.MustReflect(msg.ProtoReflect())
+}
+
+func (msg *CalendarEventType_Updated) J5Object() j5reflect.Object {
+	return j5reflect.MustReflect(msg.ProtoReflect()).(j5reflect.Object)
+}
+
+func (msg *CalendarEventType_Archived) Clone() any {
+	return proto.Clone(msg).(*CalendarEventType_Archived)
+}
+func (msg *CalendarEventType_Archived) J5Reflect() j5reflect.Root {
+	return j5reflect.MustReflect(msg.ProtoReflect())
+}
+
+func (msg *CalendarEventType_Archived) J5Object() j5reflect.Object {
+	return j5reflect.MustReflect(msg.ProtoReflect()).(j5reflect.Object)
+}
+
+func (msg *CalendarEvent) Clone() any {
+	return proto.Clone(msg).(*CalendarEvent)
+}
+func (msg *CalendarEvent) J5Reflect() j5reflect.Root {
+	return j5reflect.MustReflect(msg.ProtoReflect())
+}
+
+func (msg *CalendarEvent) J5Object() j5reflect.Object {
+	return j5reflect.MustReflect(msg.ProtoReflect()).(j5reflect.Object)
+}
+
 // ActionType is a oneof wrapper
 type ActionTypeKey string
 
 const (
-	Action_Type_Create  ActionTypeKey = "create"
-	Action_Type_Update  ActionTypeKey = "update"
-	Action_Type_Archive ActionTypeKey = "archive"
+	Action_Type_Create          ActionTypeKey = "create"
+	Action_Type_Update          ActionTypeKey = "update"
+	Action_Type_Archive         ActionTypeKey = "archive"
+	Action_Type_CreateCalendar  ActionTypeKey = "createCalendar"
+	Action_Type_UpdateCalendar  ActionTypeKey = "updateCalendar"
+	Action_Type_ArchiveCalendar ActionTypeKey = "archiveCalendar"
+	Action_Type_Sync            ActionTypeKey = "sync"
+	Action_Type_Pause           ActionTypeKey = "pause"
+	Action_Type_Resume          ActionTypeKey = "resume"
+	Action_Type_ManuallyTrigger ActionTypeKey = "manuallyTrigger"
+	Action_Type_Restore         ActionTypeKey = "restore"
 )
 
 func (x *ActionType) TypeKey() (ActionTypeKey, bool) {
@@ -255,6 +513,22 @@ func (x *ActionType) TypeKey() (ActionTypeKey, bool) {
 		return Action_Type_Update, true
 	case *ActionType_Archive_:
 		return Action_Type_Archive, true
+	case *ActionType_CreateCalendar_:
+		return Action_Type_CreateCalendar, true
+	case *ActionType_UpdateCalendar_:
+		return Action_Type_UpdateCalendar, true
+	case *ActionType_ArchiveCalendar_:
+		return Action_Type_ArchiveCalendar, true
+	case *ActionType_Sync_:
+		return Action_Type_Sync, true
+	case *ActionType_Pause_:
+		return Action_Type_Pause, true
+	case *ActionType_Resume_:
+		return Action_Type_Resume, true
+	case *ActionType_ManuallyTrigger_:
+		return Action_Type_ManuallyTrigger, true
+	case *ActionType_Restore_:
+		return Action_Type_Restore, true
 	default:
 		return "", false
 	}
@@ -273,6 +547,22 @@ func (x *ActionType) Set(val IsActionTypeWrappedType) {
 		x.Type = &ActionType_Update_{Update: v}
 	case *ActionType_Archive:
 		x.Type = &ActionType_Archive_{Archive: v}
+	case *ActionType_CreateCalendar:
+		x.Type = &ActionType_CreateCalendar_{CreateCalendar: v}
+	case *ActionType_UpdateCalendar:
+		x.Type = &ActionType_UpdateCalendar_{UpdateCalendar: v}
+	case *ActionType_ArchiveCalendar:
+		x.Type = &ActionType_ArchiveCalendar_{ArchiveCalendar: v}
+	case *ActionType_Sync:
+		x.Type = &ActionType_Sync_{Sync: v}
+	case *ActionType_Pause:
+		x.Type = &ActionType_Pause_{Pause: v}
+	case *ActionType_Resume:
+		x.Type = &ActionType_Resume_{Resume: v}
+	case *ActionType_ManuallyTrigger:
+		x.Type = &ActionType_ManuallyTrigger_{ManuallyTrigger: v}
+	case *ActionType_Restore:
+		x.Type = &ActionType_Restore_{Restore: v}
 	}
 }
 func (x *ActionType) Get() IsActionTypeWrappedType {
@@ -283,6 +573,22 @@ func (x *ActionType) Get() IsActionTypeWrappedType {
 		return v.Update
 	case *ActionType_Archive_:
 		return v.Archive
+	case *ActionType_CreateCalendar_:
+		return v.CreateCalendar
+	case *ActionType_UpdateCalendar_:
+		return v.UpdateCalendar
+	case *ActionType_ArchiveCalendar_:
+		return v.ArchiveCalendar
+	case *ActionType_Sync_:
+		return v.Sync
+	case *ActionType_Pause_:
+		return v.Pause
+	case *ActionType_Resume_:
+		return v.Resume
+	case *ActionType_ManuallyTrigger_:
+		return v.ManuallyTrigger
+	case *ActionType_Restore_:
+		return v.Restore
 	default:
 		return nil
 	}
@@ -296,6 +602,30 @@ func (x *ActionType_Update) ActionTypeKey() ActionTypeKey {
 func (x *ActionType_Archive) ActionTypeKey() ActionTypeKey {
 	return Action_Type_Archive
 }
+func (x *ActionType_CreateCalendar) ActionTypeKey() ActionTypeKey {
+	return Action_Type_CreateCalendar
+}
+func (x *ActionType_UpdateCalendar) ActionTypeKey() ActionTypeKey {
+	return Action_Type_UpdateCalendar
+}
+func (x *ActionType_ArchiveCalendar) ActionTypeKey() ActionTypeKey {
+	return Action_Type_ArchiveCalendar
+}
+func (x *ActionType_Sync) ActionTypeKey() ActionTypeKey {
+	return Action_Type_Sync
+}
+func (x *ActionType_Pause) ActionTypeKey() ActionTypeKey {
+	return Action_Type_Pause
+}
+func (x *ActionType_Resume) ActionTypeKey() ActionTypeKey {
+	return Action_Type_Resume
+}
+func (x *ActionType_ManuallyTrigger) ActionTypeKey() ActionTypeKey {
+	return Action_Type_ManuallyTrigger
+}
+func (x *ActionType_Restore) ActionTypeKey() ActionTypeKey {
+	return Action_Type_Restore
+}
 func (msg *ActionType) Clone() any {
 	return proto.Clone(msg).(*ActionType)
 }
@@ -339,12 +669,125 @@ func (msg *ActionType_Archive) J5Object() j5reflect.Object {
 	return j5reflect.MustReflect(msg.ProtoReflect()).(j5reflect.Object)
 }
 
+func (msg *ActionType_CreateCalendar) Clone() any {
+	return proto.Clone(msg).(*ActionType_CreateCalendar)
+}
+func (msg *ActionType_CreateCalendar) J5Reflect() j5reflect.Root {
+	return j5reflect.MustReflect(msg.ProtoReflect())
+}
+
+func (msg *ActionType_CreateCalendar) J5Object() j5reflect.Object {
+	return j5reflect.MustReflect(msg.ProtoReflect()).(j5reflect.Object)
+}
+
+func (msg *ActionType_UpdateCalendar) Clone() any {
+	return proto.Clone(msg).(*ActionType_UpdateCalendar)
+}
+func (msg *ActionType_UpdateCalendar) J5Reflect() j5reflect.Root {
+	return j5reflect.MustReflect(msg.ProtoReflect())
+}
+
+func (msg *ActionType_UpdateCalendar) J5Object() j5reflect.Object {
+	return j5reflect.MustReflect(msg.ProtoReflect()).(j5reflect.Object)
+}
+
+func (msg *ActionType_ArchiveCalendar) Clone() any {
+	return proto.Clone(msg).(*ActionType_ArchiveCalendar)
+}
+func (msg *ActionType_ArchiveCalendar) J5Reflect() j5reflect.Root {
+	return j5reflect.MustReflect(msg.ProtoReflect())
+}
+
+func (msg *ActionType_ArchiveCalendar) J5Object() j5reflect.Object {
+	return j5reflect.MustReflect(msg.ProtoReflect()).(j5reflect.Object)
+}
+
+func (msg *ActionType_Sync) Clone() any {
+	return proto.Clone(msg).(*ActionType_Sync)
+}
+func (msg *ActionType_Sync) J5Reflect() j5reflect.Root {
+	return j5reflect.MustReflect(msg.ProtoReflect())
+}
+
+func (msg *ActionType_Sync) J5Object() j5reflect.Object {
+	return j5reflect.MustReflect(msg.ProtoReflect()).(j5reflect.Object)
+}
+
+func (msg *ActionType_Pause) Clone() any {
+	return proto.Clone(msg).(*ActionType_Pause)
+}
+func (msg *ActionType_Pause) J5Reflect() j5reflect.Root {
+	return j5reflect.MustReflect(msg.ProtoReflect())
+}
+
+func (msg *ActionType_Pause) J5Object() j5reflect.Object {
+	return j5reflect.MustReflect(msg.ProtoReflect()).(j5reflect.Object)
+}
+
+func (msg *ActionType_Resume) Clone() any {
+	return proto.Clone(msg).(*ActionType_Resume)
+}
+func (msg *ActionType_Resume) J5Reflect() j5reflect.Root {
+	return j5reflect.MustReflect(msg.ProtoReflect())
+}
+
+func (msg *ActionType_Resume) J5Object() j5reflect.Object {
+	return j5reflect.MustReflect(msg.ProtoReflect()).(j5reflect.Object)
+}
+
+func (msg *ActionType_ManuallyTrigger) Clone() any {
+	return proto.Clone(msg).(*ActionType_ManuallyTrigger)
+}
+func (msg *ActionType_ManuallyTrigger) J5Reflect() j5reflect.Root {
+	return j5reflect.MustReflect(msg.ProtoReflect())
+}
+
+func (msg *ActionType_ManuallyTrigger) J5Object() j5reflect.Object {
+	return j5reflect.MustReflect(msg.ProtoReflect()).(j5reflect.Object)
+}
+
+func (msg *ActionType_Restore) Clone() any {
+	return proto.Clone(msg).(*ActionType_Restore)
+}
+func (msg *ActionType_Restore) J5Reflect() j5reflect.Root {
+	return j5reflect.MustReflect(msg.ProtoReflect())
+}
+
+func (msg *ActionType_Restore) J5Object() j5reflect.Object {
+	return j5reflect.MustReflect(msg.ProtoReflect()).(j5reflect.Object)
+}
+
+func (msg *TriggerDefinition) Clone() any {
+	return proto.Clone(msg).(*TriggerDefinition)
+}
+func (msg *TriggerDefinition) J5Reflect() j5reflect.Root {
+	return j5reflect.MustReflect(msg.ProtoReflect())
+}
+
+func (msg *TriggerDefinition) J5Object() j5reflect.Object {
+	return j5reflect.MustReflect(msg.ProtoReflect()).(j5reflect.Object)
+}
+
+func (msg *Interval) Clone() any {
+	return proto.Clone(msg).(*Interval)
+}
+func (msg *Interval) J5Reflect() j5reflect.Root {
+	return j5reflect.MustReflect(msg.ProtoReflect())
+}
+
+func (msg *Interval) J5Object() j5reflect.Object {
+	return j5reflect.MustReflect(msg.ProtoReflect()).(j5reflect.Object)
+}
+
 // TriggerStatus
 const (
 	TriggerStatus_UNSPECIFIED TriggerStatus = 0
 	TriggerStatus_ACTIVE      TriggerStatus = 1
 	TriggerStatus_PAUSED      TriggerStatus = 2
 	TriggerStatus_ARCHIVED    TriggerStatus = 3
+	TriggerStatus_COMPLETED   TriggerStatus = 4
+	TriggerStatus_EXPIRED     TriggerStatus = 5
+	TriggerStatus_FAULTED     TriggerStatus = 6
 )
 
 var (
@@ -353,12 +796,18 @@ var (
 		1: "ACTIVE",
 		2: "PAUSED",
 		3: "ARCHIVED",
+		4: "COMPLETED",
+		5: "EXPIRED",
+		6: "FAULTED",
 	}
 	TriggerStatus_value_short = map[string]int32{
 		"UNSPECIFIED": 0,
 		"ACTIVE":      1,
 		"PAUSED":      2,
 		"ARCHIVED":    3,
+		"COMPLETED":   4,
+		"EXPIRED":     5,
+		"FAULTED":     6,
 	}
 	TriggerStatus_value_either = map[string]int32{
 		"UNSPECIFIED":                0,
@@ -369,6 +818,12 @@ var (
 		"TRIGGER_STATUS_PAUSED":      2,
 		"ARCHIVED":                   3,
 		"TRIGGER_STATUS_ARCHIVED":    3,
+		"COMPLETED":                  4,
+		"TRIGGER_STATUS_COMPLETED":   4,
+		"EXPIRED":                    5,
+		"TRIGGER_STATUS_EXPIRED":     5,
+		"FAULTED":                    6,
+		"TRIGGER_STATUS_FAULTED":     6,
 	}
 )
 
@@ -393,3 +848,213 @@ func (x *TriggerStatus) Scan(value interface{}) error {
 	*x = TriggerStatus(val)
 	return nil
 }
+
+// CalendarStatus
+const (
+	CalendarStatus_UNSPECIFIED CalendarStatus = 0
+	CalendarStatus_ACTIVE      CalendarStatus = 1
+	CalendarStatus_ARCHIVED    CalendarStatus = 2
+)
+
+var (
+	CalendarStatus_name_short = map[int32]string{
+		0: "UNSPECIFIED",
+		1: "ACTIVE",
+		2: "ARCHIVED",
+	}
+	CalendarStatus_value_short = map[string]int32{
+		"UNSPECIFIED": 0,
+		"ACTIVE":      1,
+		"ARCHIVED":    2,
+	}
+	CalendarStatus_value_either = map[string]int32{
+		"UNSPECIFIED":                 0,
+		"CALENDAR_STATUS_UNSPECIFIED": 0,
+		"ACTIVE":                      1,
+		"CALENDAR_STATUS_ACTIVE":      1,
+		"ARCHIVED":                    2,
+		"CALENDAR_STATUS_ARCHIVED":    2,
+	}
+)
+
+// ShortString returns the un-prefixed string representation of the enum value
+func (x CalendarStatus) ShortString() string {
+	return CalendarStatus_name_short[int32(x)]
+}
+func (x CalendarStatus) Value() (driver.Value, error) {
+	return []uint8(x.ShortString()), nil
+}
+func (x *CalendarStatus) Scan(value interface{}) error {
+	var strVal string
+	switch vt := value.(type) {
+	case []uint8:
+		strVal = string(vt)
+	case string:
+		strVal = vt
+	default:
+		return fmt.Errorf("invalid type %T", value)
+	}
+	val := CalendarStatus_value_either[strVal]
+	*x = CalendarStatus(val)
+	return nil
+}
+
+// CalendarRoll
+const (
+	CalendarRoll_UNSPECIFIED       CalendarRoll = 0
+	CalendarRoll_SKIP              CalendarRoll = 1
+	CalendarRoll_NEXT_BUSINESS_DAY CalendarRoll = 2
+)
+
+var (
+	CalendarRoll_name_short = map[int32]string{
+		0: "UNSPECIFIED",
+		1: "SKIP",
+		2: "NEXT_BUSINESS_DAY",
+	}
+	CalendarRoll_value_short = map[string]int32{
+		"UNSPECIFIED":       0,
+		"SKIP":              1,
+		"NEXT_BUSINESS_DAY": 2,
+	}
+	CalendarRoll_value_either = map[string]int32{
+		"UNSPECIFIED":                     0,
+		"CALENDAR_ROLL_UNSPECIFIED":       0,
+		"SKIP":                            1,
+		"CALENDAR_ROLL_SKIP":              1,
+		"NEXT_BUSINESS_DAY":               2,
+		"CALENDAR_ROLL_NEXT_BUSINESS_DAY": 2,
+	}
+)
+
+// ShortString returns the un-prefixed string representation of the enum value
+func (x CalendarRoll) ShortString() string {
+	return CalendarRoll_name_short[int32(x)]
+}
+func (x CalendarRoll) Value() (driver.Value, error) {
+	return []uint8(x.ShortString()), nil
+}
+func (x *CalendarRoll) Scan(value interface{}) error {
+	var strVal string
+	switch vt := value.(type) {
+	case []uint8:
+		strVal = string(vt)
+	case string:
+		strVal = vt
+	default:
+		return fmt.Errorf("invalid type %T", value)
+	}
+	val := CalendarRoll_value_either[strVal]
+	*x = CalendarRoll(val)
+	return nil
+}
+
+// DSTPolicy
+const (
+	DSTPolicy_UNSPECIFIED DSTPolicy = 0
+	DSTPolicy_SKIP        DSTPolicy = 1
+	DSTPolicy_SHIFT       DSTPolicy = 2
+	DSTPolicy_FIRE_BOTH   DSTPolicy = 3
+)
+
+var (
+	DSTPolicy_name_short = map[int32]string{
+		0: "UNSPECIFIED",
+		1: "SKIP",
+		2: "SHIFT",
+		3: "FIRE_BOTH",
+	}
+	DSTPolicy_value_short = map[string]int32{
+		"UNSPECIFIED": 0,
+		"SKIP":        1,
+		"SHIFT":       2,
+		"FIRE_BOTH":   3,
+	}
+	DSTPolicy_value_either = map[string]int32{
+		"UNSPECIFIED":            0,
+		"DST_POLICY_UNSPECIFIED": 0,
+		"SKIP":                   1,
+		"DST_POLICY_SKIP":        1,
+		"SHIFT":                  2,
+		"DST_POLICY_SHIFT":       2,
+		"FIRE_BOTH":              3,
+		"DST_POLICY_FIRE_BOTH":   3,
+	}
+)
+
+// ShortString returns the un-prefixed string representation of the enum value
+func (x DSTPolicy) ShortString() string {
+	return DSTPolicy_name_short[int32(x)]
+}
+func (x DSTPolicy) Value() (driver.Value, error) {
+	return []uint8(x.ShortString()), nil
+}
+func (x *DSTPolicy) Scan(value interface{}) error {
+	var strVal string
+	switch vt := value.(type) {
+	case []uint8:
+		strVal = string(vt)
+	case string:
+		strVal = vt
+	default:
+		return fmt.Errorf("invalid type %T", value)
+	}
+	val := DSTPolicy_value_either[strVal]
+	*x = DSTPolicy(val)
+	return nil
+}
+
+// CatchUpPolicy
+const (
+	CatchUpPolicy_UNSPECIFIED CatchUpPolicy = 0
+	CatchUpPolicy_FIRE_ALL    CatchUpPolicy = 1
+	CatchUpPolicy_FIRE_LATEST CatchUpPolicy = 2
+	CatchUpPolicy_SKIP        CatchUpPolicy = 3
+)
+
+var (
+	CatchUpPolicy_name_short = map[int32]string{
+		0: "UNSPECIFIED",
+		1: "FIRE_ALL",
+		2: "FIRE_LATEST",
+		3: "SKIP",
+	}
+	CatchUpPolicy_value_short = map[string]int32{
+		"UNSPECIFIED": 0,
+		"FIRE_ALL":    1,
+		"FIRE_LATEST": 2,
+		"SKIP":        3,
+	}
+	CatchUpPolicy_value_either = map[string]int32{
+		"UNSPECIFIED":                 0,
+		"CATCH_UP_POLICY_UNSPECIFIED": 0,
+		"FIRE_ALL":                    1,
+		"CATCH_UP_POLICY_FIRE_ALL":    1,
+		"FIRE_LATEST":                 2,
+		"CATCH_UP_POLICY_FIRE_LATEST": 2,
+		"SKIP":                        3,
+		"CATCH_UP_POLICY_SKIP":        3,
+	}
+)
+
+// ShortString returns the un-prefixed string representation of the enum value
+func (x CatchUpPolicy) ShortString() string {
+	return CatchUpPolicy_name_short[int32(x)]
+}
+func (x CatchUpPolicy) Value() (driver.Value, error) {
+	return []uint8(x.ShortString()), nil
+}
+func (x *CatchUpPolicy) Scan(value interface{}) error {
+	var strVal string
+	switch vt := value.(type) {
+	case []uint8:
+		strVal = string(vt)
+	case string:
+		strVal = vt
+	default:
+		return fmt.Errorf("invalid type %T", value)
+	}
+	val := CatchUpPolicy_value_either[strVal]
+	*x = CatchUpPolicy(val)
+	return nil
+}
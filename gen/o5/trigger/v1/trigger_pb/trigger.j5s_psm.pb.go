@@ -68,6 +68,11 @@ const (
 	TriggerPSMEventManuallyTriggered TriggerPSMEventKey = "manually_triggered"
 	TriggerPSMEventTriggered         TriggerPSMEventKey = "triggered"
 	TriggerPSMEventArchived          TriggerPSMEventKey = "archived"
+	TriggerPSMEventCompleted         TriggerPSMEventKey = "completed"
+	TriggerPSMEventExpired           TriggerPSMEventKey = "expired"
+	TriggerPSMEventRestored          TriggerPSMEventKey = "restored"
+	TriggerPSMEventRescheduled       TriggerPSMEventKey = "rescheduled"
+	TriggerPSMEventFaulted           TriggerPSMEventKey = "faulted"
 )
 
 // EXTEND TriggerKeys with the psm.IKeyset interface
@@ -182,6 +187,16 @@ func (msg *TriggerEvent) UnwrapPSMEvent() TriggerPSMEvent {
 		return v.Triggered
 	case *TriggerEventType_Archived_:
 		return v.Archived
+	case *TriggerEventType_Completed_:
+		return v.Completed
+	case *TriggerEventType_Expired_:
+		return v.Expired
+	case *TriggerEventType_Restored_:
+		return v.Restored
+	case *TriggerEventType_Rescheduled_:
+		return v.Rescheduled
+	case *TriggerEventType_Faulted_:
+		return v.Faulted
 	default:
 		return nil
 	}
@@ -207,6 +222,16 @@ func (msg *TriggerEvent) SetPSMEvent(inner TriggerPSMEvent) error {
 		msg.Event.Type = &TriggerEventType_Triggered_{Triggered: v}
 	case *TriggerEventType_Archived:
 		msg.Event.Type = &TriggerEventType_Archived_{Archived: v}
+	case *TriggerEventType_Completed:
+		msg.Event.Type = &TriggerEventType_Completed_{Completed: v}
+	case *TriggerEventType_Expired:
+		msg.Event.Type = &TriggerEventType_Expired_{Expired: v}
+	case *TriggerEventType_Restored:
+		msg.Event.Type = &TriggerEventType_Restored_{Restored: v}
+	case *TriggerEventType_Rescheduled:
+		msg.Event.Type = &TriggerEventType_Rescheduled_{Rescheduled: v}
+	case *TriggerEventType_Faulted:
+		msg.Event.Type = &TriggerEventType_Faulted_{Faulted: v}
 	default:
 		return fmt.Errorf("invalid type %T for TriggerEventType", v)
 	}
@@ -295,6 +320,61 @@ func (*TriggerEventType_Archived) PSMEventKey() TriggerPSMEventKey {
 	return TriggerPSMEventArchived
 }
 
+// EXTEND TriggerEventType_Completed with the TriggerPSMEvent interface
+
+// PSMIsSet is a helper for != nil, which does not work with generic parameters
+func (msg *TriggerEventType_Completed) PSMIsSet() bool {
+	return msg != nil
+}
+
+func (*TriggerEventType_Completed) PSMEventKey() TriggerPSMEventKey {
+	return TriggerPSMEventCompleted
+}
+
+// EXTEND TriggerEventType_Expired with the TriggerPSMEvent interface
+
+// PSMIsSet is a helper for != nil, which does not work with generic parameters
+func (msg *TriggerEventType_Expired) PSMIsSet() bool {
+	return msg != nil
+}
+
+func (*TriggerEventType_Expired) PSMEventKey() TriggerPSMEventKey {
+	return TriggerPSMEventExpired
+}
+
+// EXTEND TriggerEventType_Restored with the TriggerPSMEvent interface
+
+// PSMIsSet is a helper for != nil, which does not work with generic parameters
+func (msg *TriggerEventType_Restored) PSMIsSet() bool {
+	return msg != nil
+}
+
+func (*TriggerEventType_Restored) PSMEventKey() TriggerPSMEventKey {
+	return TriggerPSMEventRestored
+}
+
+// EXTEND TriggerEventType_Rescheduled with the TriggerPSMEvent interface
+
+// PSMIsSet is a helper for != nil, which does not work with generic parameters
+func (msg *TriggerEventType_Rescheduled) PSMIsSet() bool {
+	return msg != nil
+}
+
+func (*TriggerEventType_Rescheduled) PSMEventKey() TriggerPSMEventKey {
+	return TriggerPSMEventRescheduled
+}
+
+// EXTEND TriggerEventType_Faulted with the TriggerPSMEvent interface
+
+// PSMIsSet is a helper for != nil, which does not work with generic parameters
+func (msg *TriggerEventType_Faulted) PSMIsSet() bool {
+	return msg != nil
+}
+
+func (*TriggerEventType_Faulted) PSMEventKey() TriggerPSMEventKey {
+	return TriggerPSMEventFaulted
+}
+
 func TriggerPSMBuilder() *psm.StateMachineConfig[
 	*TriggerKeys,    // implements psm.IKeyset
 	*TriggerState,   // implements psm.IState
@@ -678,3 +758,608 @@ func (event *TriggerEvent) EventPublishMetadata() *psm_j5pb.EventPublishMetadata
 		},
 	}
 }
+
+// PSM CalendarPSM
+
+type CalendarPSM = psm.StateMachine[
+	*CalendarKeys,    // implements psm.IKeyset
+	*CalendarState,   // implements psm.IState
+	CalendarStatus,   // implements psm.IStatusEnum
+	*CalendarData,    // implements psm.IStateData
+	*CalendarEvent,   // implements psm.IEvent
+	CalendarPSMEvent, // implements psm.IInnerEvent
+]
+
+type CalendarPSMDB = psm.DBStateMachine[
+	*CalendarKeys,    // implements psm.IKeyset
+	*CalendarState,   // implements psm.IState
+	CalendarStatus,   // implements psm.IStatusEnum
+	*CalendarData,    // implements psm.IStateData
+	*CalendarEvent,   // implements psm.IEvent
+	CalendarPSMEvent, // implements psm.IInnerEvent
+]
+
+type CalendarPSMEventSpec = psm.EventSpec[
+	*CalendarKeys,    // implements psm.IKeyset
+	*CalendarState,   // implements psm.IState
+	CalendarStatus,   // implements psm.IStatusEnum
+	*CalendarData,    // implements psm.IStateData
+	*CalendarEvent,   // implements psm.IEvent
+	CalendarPSMEvent, // implements psm.IInnerEvent
+]
+
+type CalendarPSMHookBaton = psm.HookBaton[
+	*CalendarKeys,    // implements psm.IKeyset
+	*CalendarState,   // implements psm.IState
+	CalendarStatus,   // implements psm.IStatusEnum
+	*CalendarData,    // implements psm.IStateData
+	*CalendarEvent,   // implements psm.IEvent
+	CalendarPSMEvent, // implements psm.IInnerEvent
+]
+
+type CalendarPSMFullBaton = psm.CallbackBaton[
+	*CalendarKeys,    // implements psm.IKeyset
+	*CalendarState,   // implements psm.IState
+	CalendarStatus,   // implements psm.IStatusEnum
+	*CalendarData,    // implements psm.IStateData
+	*CalendarEvent,   // implements psm.IEvent
+	CalendarPSMEvent, // implements psm.IInnerEvent
+]
+
+type CalendarPSMEventKey = string
+
+const (
+	CalendarPSMEventNil      CalendarPSMEventKey = "<nil>"
+	CalendarPSMEventCreated  CalendarPSMEventKey = "created"
+	CalendarPSMEventUpdated  CalendarPSMEventKey = "updated"
+	CalendarPSMEventArchived CalendarPSMEventKey = "archived"
+)
+
+// EXTEND CalendarKeys with the psm.IKeyset interface
+
+// PSMIsSet is a helper for != nil, which does not work with generic parameters
+func (msg *CalendarKeys) PSMIsSet() bool {
+	return msg != nil
+}
+
+// PSMFullName returns the full name of state machine with package prefix
+func (msg *CalendarKeys) PSMFullName() string {
+	return "o5.trigger.v1.calendar"
+}
+func (msg *CalendarKeys) PSMKeyValues() (map[string]any, error) {
+	keyset := map[string]any{
+		"calendar_id": msg.CalendarId,
+	}
+	return keyset, nil
+}
+
+// EXTEND CalendarState with the psm.IState interface
+
+// PSMIsSet is a helper for != nil, which does not work with generic parameters
+func (msg *CalendarState) PSMIsSet() bool {
+	return msg != nil
+}
+
+func (msg *CalendarState) PSMMetadata() *psm_j5pb.StateMetadata {
+	if msg.Metadata == nil {
+		msg.Metadata = &psm_j5pb.StateMetadata{}
+	}
+	return msg.Metadata
+}
+
+func (msg *CalendarState) PSMKeys() *CalendarKeys {
+	return msg.Keys
+}
+
+func (msg *CalendarState) SetStatus(status CalendarStatus) {
+	msg.Status = status
+}
+
+func (msg *CalendarState) SetPSMKeys(inner *CalendarKeys) {
+	msg.Keys = inner
+}
+
+func (msg *CalendarState) PSMData() *CalendarData {
+	if msg.Data == nil {
+		msg.Data = &CalendarData{}
+	}
+	return msg.Data
+}
+
+// EXTEND CalendarData with the psm.IStateData interface
+
+// PSMIsSet is a helper for != nil, which does not work with generic parameters
+func (msg *CalendarData) PSMIsSet() bool {
+	return msg != nil
+}
+
+// EXTEND CalendarEvent with the psm.IEvent interface
+
+// PSMIsSet is a helper for != nil, which does not work with generic parameters
+func (msg *CalendarEvent) PSMIsSet() bool {
+	return msg != nil
+}
+
+func (msg *CalendarEvent) PSMMetadata() *psm_j5pb.EventMetadata {
+	if msg.Metadata == nil {
+		msg.Metadata = &psm_j5pb.EventMetadata{}
+	}
+	return msg.Metadata
+}
+
+func (msg *CalendarEvent) PSMKeys() *CalendarKeys {
+	return msg.Keys
+}
+
+func (msg *CalendarEvent) SetPSMKeys(inner *CalendarKeys) {
+	msg.Keys = inner
+}
+
+// PSMEventKey returns the CalendarPSMEventPSMEventKey for the event, implementing psm.IEvent
+func (msg *CalendarEvent) PSMEventKey() CalendarPSMEventKey {
+	tt := msg.UnwrapPSMEvent()
+	if tt == nil {
+		return CalendarPSMEventNil
+	}
+	return tt.PSMEventKey()
+}
+
+// UnwrapPSMEvent implements psm.IEvent, returning the inner event message
+func (msg *CalendarEvent) UnwrapPSMEvent() CalendarPSMEvent {
+	if msg == nil {
+		return nil
+	}
+	if msg.Event == nil {
+		return nil
+	}
+	switch v := msg.Event.Type.(type) {
+	case *CalendarEventType_Created_:
+		return v.Created
+	case *CalendarEventType_Updated_:
+		return v.Updated
+	case *CalendarEventType_Archived_:
+		return v.Archived
+	default:
+		return nil
+	}
+}
+
+// SetPSMEvent sets the inner event message from a concrete type, implementing psm.IEvent
+func (msg *CalendarEvent) SetPSMEvent(inner CalendarPSMEvent) error {
+	if msg.Event == nil {
+		msg.Event = &CalendarEventType{}
+	}
+	switch v := inner.(type) {
+	case *CalendarEventType_Created:
+		msg.Event.Type = &CalendarEventType_Created_{Created: v}
+	case *CalendarEventType_Updated:
+		msg.Event.Type = &CalendarEventType_Updated_{Updated: v}
+	case *CalendarEventType_Archived:
+		msg.Event.Type = &CalendarEventType_Archived_{Archived: v}
+	default:
+		return fmt.Errorf("invalid type %T for CalendarEventType", v)
+	}
+	return nil
+}
+
+type CalendarPSMEvent interface {
+	psm.IInnerEvent
+	PSMEventKey() CalendarPSMEventKey
+}
+
+// EXTEND CalendarEventType_Created with the CalendarPSMEvent interface
+
+// PSMIsSet is a helper for != nil, which does not work with generic parameters
+func (msg *CalendarEventType_Created) PSMIsSet() bool {
+	return msg != nil
+}
+
+func (*CalendarEventType_Created) PSMEventKey() CalendarPSMEventKey {
+	return CalendarPSMEventCreated
+}
+
+// EXTEND CalendarEventType_Updated with the CalendarPSMEvent interface
+
+// PSMIsSet is a helper for != nil, which does not work with generic parameters
+func (msg *CalendarEventType_Updated) PSMIsSet() bool {
+	return msg != nil
+}
+
+func (*CalendarEventType_Updated) PSMEventKey() CalendarPSMEventKey {
+	return CalendarPSMEventUpdated
+}
+
+// EXTEND CalendarEventType_Archived with the CalendarPSMEvent interface
+
+// PSMIsSet is a helper for != nil, which does not work with generic parameters
+func (msg *CalendarEventType_Archived) PSMIsSet() bool {
+	return msg != nil
+}
+
+func (*CalendarEventType_Archived) PSMEventKey() CalendarPSMEventKey {
+	return CalendarPSMEventArchived
+}
+
+func CalendarPSMBuilder() *psm.StateMachineConfig[
+	*CalendarKeys,    // implements psm.IKeyset
+	*CalendarState,   // implements psm.IState
+	CalendarStatus,   // implements psm.IStatusEnum
+	*CalendarData,    // implements psm.IStateData
+	*CalendarEvent,   // implements psm.IEvent
+	CalendarPSMEvent, // implements psm.IInnerEvent
+] {
+	return &psm.StateMachineConfig[
+		*CalendarKeys,    // implements psm.IKeyset
+		*CalendarState,   // implements psm.IState
+		CalendarStatus,   // implements psm.IStatusEnum
+		*CalendarData,    // implements psm.IStateData
+		*CalendarEvent,   // implements psm.IEvent
+		CalendarPSMEvent, // implements psm.IInnerEvent
+	]{}
+}
+
+// CalendarPSMMutation runs at the start of a transition to merge the event information into the state data object. The state object is mutable in this context.
+func CalendarPSMMutation[SE CalendarPSMEvent](cb func(*CalendarData, SE) error) psm.TransitionMutation[
+	*CalendarKeys,    // implements psm.IKeyset
+	*CalendarState,   // implements psm.IState
+	CalendarStatus,   // implements psm.IStatusEnum
+	*CalendarData,    // implements psm.IStateData
+	*CalendarEvent,   // implements psm.IEvent
+	CalendarPSMEvent, // implements psm.IInnerEvent
+	SE,               // Specific event type for the transition
+] {
+	return psm.TransitionMutation[
+		*CalendarKeys,    // implements psm.IKeyset
+		*CalendarState,   // implements psm.IState
+		CalendarStatus,   // implements psm.IStatusEnum
+		*CalendarData,    // implements psm.IStateData
+		*CalendarEvent,   // implements psm.IEvent
+		CalendarPSMEvent, // implements psm.IInnerEvent
+		SE,               // Specific event type for the transition
+	](cb)
+}
+
+// CalendarPSMLogicHook runs after the mutation is complete. This hook can trigger side effects, including chained events, which are additional events processed by the state machine. Use this for Business Logic which determines the 'next step' in processing.
+func CalendarPSMLogicHook[
+	SE CalendarPSMEvent,
+](
+	cb func(
+		context.Context,
+		CalendarPSMHookBaton,
+		*CalendarState,
+		SE,
+	) error) psm.TransitionHook[
+	*CalendarKeys,    // implements psm.IKeyset
+	*CalendarState,   // implements psm.IState
+	CalendarStatus,   // implements psm.IStatusEnum
+	*CalendarData,    // implements psm.IStateData
+	*CalendarEvent,   // implements psm.IEvent
+	CalendarPSMEvent, // implements psm.IInnerEvent
+] {
+	eventType := (*new(SE)).PSMEventKey()
+	return psm.TransitionHook[
+		*CalendarKeys,    // implements psm.IKeyset
+		*CalendarState,   // implements psm.IState
+		CalendarStatus,   // implements psm.IStatusEnum
+		*CalendarData,    // implements psm.IStateData
+		*CalendarEvent,   // implements psm.IEvent
+		CalendarPSMEvent, // implements psm.IInnerEvent
+	]{
+		Callback: func(ctx context.Context, tx sqrlx.Transaction, baton CalendarPSMFullBaton, state *CalendarState, event *CalendarEvent) error {
+			asType, ok := any(event.UnwrapPSMEvent()).(SE)
+			if !ok {
+				name := event.ProtoReflect().Descriptor().FullName()
+				return fmt.Errorf("unexpected event type in transition: %s [IE] does not match [SE] (%T)", name, new(SE))
+			}
+			return cb(ctx, baton, state, asType)
+		},
+		EventType:   eventType,
+		RunOnFollow: false,
+	}
+}
+
+// CalendarPSMDataHook runs after the mutations, and can be used to update data in tables which are not controlled as the state machine, e.g. for pre-calculating fields for performance reasons. Use of this hook prevents (future) transaction optimizations, as the transaction state when the function is called must needs to match the processing state, but only for this single transition, unlike the GeneralEventDataHook.
+func CalendarPSMDataHook[
+	SE CalendarPSMEvent,
+](
+	cb func(
+		context.Context,
+		sqrlx.Transaction,
+		*CalendarState,
+		SE,
+	) error) psm.TransitionHook[
+	*CalendarKeys,    // implements psm.IKeyset
+	*CalendarState,   // implements psm.IState
+	CalendarStatus,   // implements psm.IStatusEnum
+	*CalendarData,    // implements psm.IStateData
+	*CalendarEvent,   // implements psm.IEvent
+	CalendarPSMEvent, // implements psm.IInnerEvent
+] {
+	eventType := (*new(SE)).PSMEventKey()
+	return psm.TransitionHook[
+		*CalendarKeys,    // implements psm.IKeyset
+		*CalendarState,   // implements psm.IState
+		CalendarStatus,   // implements psm.IStatusEnum
+		*CalendarData,    // implements psm.IStateData
+		*CalendarEvent,   // implements psm.IEvent
+		CalendarPSMEvent, // implements psm.IInnerEvent
+	]{
+		Callback: func(ctx context.Context, tx sqrlx.Transaction, baton CalendarPSMFullBaton, state *CalendarState, event *CalendarEvent) error {
+			asType, ok := any(event.UnwrapPSMEvent()).(SE)
+			if !ok {
+				name := event.ProtoReflect().Descriptor().FullName()
+				return fmt.Errorf("unexpected event type in transition: %s [IE] does not match [SE] (%T)", name, new(SE))
+			}
+			return cb(ctx, tx, state, asType)
+		},
+		EventType:   eventType,
+		RunOnFollow: true,
+	}
+}
+
+// CalendarPSMLinkHook runs after the mutation and logic hook, and can be used to link the state machine to other state machines in the same database transaction
+func CalendarPSMLinkHook[
+	SE CalendarPSMEvent,
+	DK psm.IKeyset,
+	DIE psm.IInnerEvent,
+](
+	linkDestination psm.LinkDestination[DK, DIE],
+	cb func(
+		context.Context,
+		*CalendarState,
+		SE,
+		func(DK, DIE),
+	) error) psm.TransitionHook[
+	*CalendarKeys,    // implements psm.IKeyset
+	*CalendarState,   // implements psm.IState
+	CalendarStatus,   // implements psm.IStatusEnum
+	*CalendarData,    // implements psm.IStateData
+	*CalendarEvent,   // implements psm.IEvent
+	CalendarPSMEvent, // implements psm.IInnerEvent
+] {
+	eventType := (*new(SE)).PSMEventKey()
+	wrapped := func(ctx context.Context, tx sqrlx.Transaction, state *CalendarState, event SE, add func(DK, DIE)) error {
+		return cb(ctx, state, event, add)
+	}
+	return psm.TransitionHook[
+		*CalendarKeys,    // implements psm.IKeyset
+		*CalendarState,   // implements psm.IState
+		CalendarStatus,   // implements psm.IStatusEnum
+		*CalendarData,    // implements psm.IStateData
+		*CalendarEvent,   // implements psm.IEvent
+		CalendarPSMEvent, // implements psm.IInnerEvent
+	]{
+		Callback: func(ctx context.Context, tx sqrlx.Transaction, baton CalendarPSMFullBaton, state *CalendarState, event *CalendarEvent) error {
+			return psm.RunLinkHook(ctx, linkDestination, wrapped, tx, state, event)
+		},
+		EventType:   eventType,
+		RunOnFollow: false,
+	}
+}
+
+// CalendarPSMLinkDBHook like LinkHook, but has access to the current transaction for reads only (not enforced), use in place of controller logic to look up existing state.
+func CalendarPSMLinkDBHook[
+	SE CalendarPSMEvent,
+	DK psm.IKeyset,
+	DIE psm.IInnerEvent,
+](
+	linkDestination psm.LinkDestination[DK, DIE],
+	cb func(
+		context.Context,
+		sqrlx.Transaction,
+		*CalendarState,
+		SE,
+		func(DK, DIE),
+	) error) psm.TransitionHook[
+	*CalendarKeys,    // implements psm.IKeyset
+	*CalendarState,   // implements psm.IState
+	CalendarStatus,   // implements psm.IStatusEnum
+	*CalendarData,    // implements psm.IStateData
+	*CalendarEvent,   // implements psm.IEvent
+	CalendarPSMEvent, // implements psm.IInnerEvent
+] {
+	eventType := (*new(SE)).PSMEventKey()
+	return psm.TransitionHook[
+		*CalendarKeys,    // implements psm.IKeyset
+		*CalendarState,   // implements psm.IState
+		CalendarStatus,   // implements psm.IStatusEnum
+		*CalendarData,    // implements psm.IStateData
+		*CalendarEvent,   // implements psm.IEvent
+		CalendarPSMEvent, // implements psm.IInnerEvent
+	]{
+		Callback: func(ctx context.Context, tx sqrlx.Transaction, baton CalendarPSMFullBaton, state *CalendarState, event *CalendarEvent) error {
+			return psm.RunLinkHook(ctx, linkDestination, cb, tx, state, event)
+		},
+		EventType:   eventType,
+		RunOnFollow: false,
+	}
+}
+
+// CalendarPSMGeneralLogicHook runs once per transition at the state-machine level regardless of which transition / event is being processed. It runs exactly once per transition, with the state object in the final state after the transition but prior to processing any further events. Chained events are added to the *end* of the event queue for the transaction, and side effects are published (as always) when the transaction is committed. The function MUST be pure, i.e. It MUST NOT produce any side-effects outside of the HookBaton, and MUST NOT modify the state.
+func CalendarPSMGeneralLogicHook(
+	cb func(
+		context.Context,
+		CalendarPSMHookBaton,
+		*CalendarState,
+		*CalendarEvent,
+	) error) psm.GeneralEventHook[
+	*CalendarKeys,    // implements psm.IKeyset
+	*CalendarState,   // implements psm.IState
+	CalendarStatus,   // implements psm.IStatusEnum
+	*CalendarData,    // implements psm.IStateData
+	*CalendarEvent,   // implements psm.IEvent
+	CalendarPSMEvent, // implements psm.IInnerEvent
+] {
+	return psm.GeneralEventHook[
+		*CalendarKeys,    // implements psm.IKeyset
+		*CalendarState,   // implements psm.IState
+		CalendarStatus,   // implements psm.IStatusEnum
+		*CalendarData,    // implements psm.IStateData
+		*CalendarEvent,   // implements psm.IEvent
+		CalendarPSMEvent, // implements psm.IInnerEvent
+	]{
+		Callback: func(
+			ctx context.Context,
+			tx sqrlx.Transaction,
+			baton CalendarPSMFullBaton,
+			state *CalendarState,
+			event *CalendarEvent,
+		) error {
+			return cb(ctx, baton, state, event)
+		},
+		RunOnFollow: false,
+	}
+}
+
+// CalendarPSMGeneralStateDataHook runs at the state-machine level regardless of which transition / event is being processed. It runs at-least once before committing a database transaction after multiple transitions are complete. This hook has access only to the final state after the transitions and is used to update other tables based on the resulting state. It MUST be idempotent, it may be called after injecting externally-held state data.
+func CalendarPSMGeneralStateDataHook(
+	cb func(
+		context.Context,
+		sqrlx.Transaction,
+		*CalendarState,
+	) error) psm.GeneralStateHook[
+	*CalendarKeys,    // implements psm.IKeyset
+	*CalendarState,   // implements psm.IState
+	CalendarStatus,   // implements psm.IStatusEnum
+	*CalendarData,    // implements psm.IStateData
+	*CalendarEvent,   // implements psm.IEvent
+	CalendarPSMEvent, // implements psm.IInnerEvent
+] {
+	return psm.GeneralStateHook[
+		*CalendarKeys,    // implements psm.IKeyset
+		*CalendarState,   // implements psm.IState
+		CalendarStatus,   // implements psm.IStatusEnum
+		*CalendarData,    // implements psm.IStateData
+		*CalendarEvent,   // implements psm.IEvent
+		CalendarPSMEvent, // implements psm.IInnerEvent
+	]{
+		Callback: func(
+			ctx context.Context,
+			tx sqrlx.Transaction,
+			baton CalendarPSMFullBaton,
+			state *CalendarState,
+		) error {
+			return cb(ctx, tx, state)
+		},
+		RunOnFollow: true,
+	}
+}
+
+// CalendarPSMGeneralEventDataHook runs after each transition at the state-machine level regardless of which transition / event is being processed. It runs exactly once per transition, before any other events are processed. The presence of this hook type prevents (future) transaction optimizations, so should be used sparingly.
+func CalendarPSMGeneralEventDataHook(
+	cb func(
+		context.Context,
+		sqrlx.Transaction,
+		*CalendarState,
+		*CalendarEvent,
+	) error) psm.GeneralEventHook[
+	*CalendarKeys,    // implements psm.IKeyset
+	*CalendarState,   // implements psm.IState
+	CalendarStatus,   // implements psm.IStatusEnum
+	*CalendarData,    // implements psm.IStateData
+	*CalendarEvent,   // implements psm.IEvent
+	CalendarPSMEvent, // implements psm.IInnerEvent
+] {
+	return psm.GeneralEventHook[
+		*CalendarKeys,    // implements psm.IKeyset
+		*CalendarState,   // implements psm.IState
+		CalendarStatus,   // implements psm.IStatusEnum
+		*CalendarData,    // implements psm.IStateData
+		*CalendarEvent,   // implements psm.IEvent
+		CalendarPSMEvent, // implements psm.IInnerEvent
+	]{
+		Callback: func(
+			ctx context.Context,
+			tx sqrlx.Transaction,
+			baton CalendarPSMFullBaton,
+			state *CalendarState,
+			event *CalendarEvent,
+		) error {
+			return cb(ctx, tx, state, event)
+		},
+		RunOnFollow: true,
+	}
+}
+
+// CalendarPSMEventPublishHook  EventPublishHook runs for each transition, at least once before committing a database transaction after multiple transitions are complete. It should publish a derived version of the event using the publisher.
+func CalendarPSMEventPublishHook(
+	cb func(
+		context.Context,
+		psm.Publisher,
+		*CalendarState,
+		*CalendarEvent,
+	) error) psm.GeneralEventHook[
+	*CalendarKeys,    // implements psm.IKeyset
+	*CalendarState,   // implements psm.IState
+	CalendarStatus,   // implements psm.IStatusEnum
+	*CalendarData,    // implements psm.IStateData
+	*CalendarEvent,   // implements psm.IEvent
+	CalendarPSMEvent, // implements psm.IInnerEvent
+] {
+	return psm.GeneralEventHook[
+		*CalendarKeys,    // implements psm.IKeyset
+		*CalendarState,   // implements psm.IState
+		CalendarStatus,   // implements psm.IStatusEnum
+		*CalendarData,    // implements psm.IStateData
+		*CalendarEvent,   // implements psm.IEvent
+		CalendarPSMEvent, // implements psm.IInnerEvent
+	]{
+		Callback: func(
+			ctx context.Context,
+			tx sqrlx.Transaction,
+			baton CalendarPSMFullBaton,
+			state *CalendarState,
+			event *CalendarEvent,
+		) error {
+			return cb(ctx, baton, state, event)
+		},
+		RunOnFollow: false,
+	}
+}
+
+// CalendarPSMUpsertPublishHook runs for each transition, at least once before committing a database transaction after multiple transitions are complete. It should publish a derived version of the event using the publisher.
+func CalendarPSMUpsertPublishHook(
+	cb func(
+		context.Context,
+		psm.Publisher,
+		*CalendarState,
+	) error) psm.GeneralStateHook[
+	*CalendarKeys,    // implements psm.IKeyset
+	*CalendarState,   // implements psm.IState
+	CalendarStatus,   // implements psm.IStatusEnum
+	*CalendarData,    // implements psm.IStateData
+	*CalendarEvent,   // implements psm.IEvent
+	CalendarPSMEvent, // implements psm.IInnerEvent
+] {
+	return psm.GeneralStateHook[
+		*CalendarKeys,    // implements psm.IKeyset
+		*CalendarState,   // implements psm.IState
+		CalendarStatus,   // implements psm.IStatusEnum
+		*CalendarData,    // implements psm.IStateData
+		*CalendarEvent,   // implements psm.IEvent
+		CalendarPSMEvent, // implements psm.IInnerEvent
+	]{
+		Callback: func(
+			ctx context.Context,
+			tx sqrlx.Transaction,
+			baton CalendarPSMFullBaton,
+			state *CalendarState,
+		) error {
+			return cb(ctx, baton, state)
+		},
+		RunOnFollow: false,
+	}
+}
+
+func (event *CalendarEvent) EventPublishMetadata() *psm_j5pb.EventPublishMetadata {
+	tenantKeys := make([]*psm_j5pb.EventTenant, 0)
+	return &psm_j5pb.EventPublishMetadata{
+		EventId:   event.Metadata.EventId,
+		Sequence:  event.Metadata.Sequence,
+		Timestamp: event.Metadata.Timestamp,
+		Cause:     event.Metadata.Cause,
+		Auth: &psm_j5pb.PublishAuth{
+			TenantKeys: tenantKeys,
+		},
+	}
+}
@@ -186,6 +186,9 @@ const (
 	TriggerCommandService_PauseTrigger_FullMethodName    = "/o5.trigger.v1.service.TriggerCommandService/PauseTrigger"
 	TriggerCommandService_ResumeTrigger_FullMethodName   = "/o5.trigger.v1.service.TriggerCommandService/ResumeTrigger"
 	TriggerCommandService_ManuallyTrigger_FullMethodName = "/o5.trigger.v1.service.TriggerCommandService/ManuallyTrigger"
+	TriggerCommandService_CreateTrigger_FullMethodName   = "/o5.trigger.v1.service.TriggerCommandService/CreateTrigger"
+	TriggerCommandService_UpdateTrigger_FullMethodName   = "/o5.trigger.v1.service.TriggerCommandService/UpdateTrigger"
+	TriggerCommandService_RestoreTrigger_FullMethodName  = "/o5.trigger.v1.service.TriggerCommandService/RestoreTrigger"
 )
 
 // TriggerCommandServiceClient is the client API for TriggerCommandService service.
@@ -195,6 +198,9 @@ type TriggerCommandServiceClient interface {
 	PauseTrigger(ctx context.Context, in *PauseTriggerRequest, opts ...grpc.CallOption) (*PauseTriggerResponse, error)
 	ResumeTrigger(ctx context.Context, in *ResumeTriggerRequest, opts ...grpc.CallOption) (*ResumeTriggerResponse, error)
 	ManuallyTrigger(ctx context.Context, in *ManuallyTriggerRequest, opts ...grpc.CallOption) (*ManuallyTriggerResponse, error)
+	CreateTrigger(ctx context.Context, in *CreateTriggerRequest, opts ...grpc.CallOption) (*CreateTriggerResponse, error)
+	UpdateTrigger(ctx context.Context, in *UpdateTriggerRequest, opts ...grpc.CallOption) (*UpdateTriggerResponse, error)
+	RestoreTrigger(ctx context.Context, in *RestoreTriggerRequest, opts ...grpc.CallOption) (*RestoreTriggerResponse, error)
 }
 
 type triggerCommandServiceClient struct {
@@ -232,6 +238,33 @@ func (c *triggerCommandServiceClient) ManuallyTrigger(ctx context.Context, in *M
 	return out, nil
 }
 
+func (c *triggerCommandServiceClient) CreateTrigger(ctx context.Context, in *CreateTriggerRequest, opts ...grpc.CallOption) (*CreateTriggerResponse, error) {
+	out := new(CreateTriggerResponse)
+	err := c.cc.Invoke(ctx, TriggerCommandService_CreateTrigger_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *triggerCommandServiceClient) UpdateTrigger(ctx context.Context, in *UpdateTriggerRequest, opts ...grpc.CallOption) (*UpdateTriggerResponse, error) {
+	out := new(UpdateTriggerResponse)
+	err := c.cc.Invoke(ctx, TriggerCommandService_UpdateTrigger_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *triggerCommandServiceClient) RestoreTrigger(ctx context.Context, in *RestoreTriggerRequest, opts ...grpc.CallOption) (*RestoreTriggerResponse, error) {
+	out := new(RestoreTriggerResponse)
+	err := c.cc.Invoke(ctx, TriggerCommandService_RestoreTrigger_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // TriggerCommandServiceServer is the server API for TriggerCommandService service.
 // All implementations must embed UnimplementedTriggerCommandServiceServer
 // for forward compatibility
@@ -239,6 +272,9 @@ type TriggerCommandServiceServer interface {
 	PauseTrigger(context.Context, *PauseTriggerRequest) (*PauseTriggerResponse, error)
 	ResumeTrigger(context.Context, *ResumeTriggerRequest) (*ResumeTriggerResponse, error)
 	ManuallyTrigger(context.Context, *ManuallyTriggerRequest) (*ManuallyTriggerResponse, error)
+	CreateTrigger(context.Context, *CreateTriggerRequest) (*CreateTriggerResponse, error)
+	UpdateTrigger(context.Context, *UpdateTriggerRequest) (*UpdateTriggerResponse, error)
+	RestoreTrigger(context.Context, *RestoreTriggerRequest) (*RestoreTriggerResponse, error)
 	mustEmbedUnimplementedTriggerCommandServiceServer()
 }
 
@@ -255,6 +291,15 @@ func (UnimplementedTriggerCommandServiceServer) ResumeTrigger(context.Context, *
 func (UnimplementedTriggerCommandServiceServer) ManuallyTrigger(context.Context, *ManuallyTriggerRequest) (*ManuallyTriggerResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ManuallyTrigger not implemented")
 }
+func (UnimplementedTriggerCommandServiceServer) CreateTrigger(context.Context, *CreateTriggerRequest) (*CreateTriggerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateTrigger not implemented")
+}
+func (UnimplementedTriggerCommandServiceServer) UpdateTrigger(context.Context, *UpdateTriggerRequest) (*UpdateTriggerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateTrigger not implemented")
+}
+func (UnimplementedTriggerCommandServiceServer) RestoreTrigger(context.Context, *RestoreTriggerRequest) (*RestoreTriggerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreTrigger not implemented")
+}
 func (UnimplementedTriggerCommandServiceServer) mustEmbedUnimplementedTriggerCommandServiceServer() {}
 
 // UnsafeTriggerCommandServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -322,6 +367,60 @@ func _TriggerCommandService_ManuallyTrigger_Handler(srv interface{}, ctx context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _TriggerCommandService_CreateTrigger_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTriggerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TriggerCommandServiceServer).CreateTrigger(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TriggerCommandService_CreateTrigger_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TriggerCommandServiceServer).CreateTrigger(ctx, req.(*CreateTriggerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TriggerCommandService_UpdateTrigger_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateTriggerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TriggerCommandServiceServer).UpdateTrigger(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TriggerCommandService_UpdateTrigger_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TriggerCommandServiceServer).UpdateTrigger(ctx, req.(*UpdateTriggerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TriggerCommandService_RestoreTrigger_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreTriggerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TriggerCommandServiceServer).RestoreTrigger(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TriggerCommandService_RestoreTrigger_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TriggerCommandServiceServer).RestoreTrigger(ctx, req.(*RestoreTriggerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // TriggerCommandService_ServiceDesc is the grpc.ServiceDesc for TriggerCommandService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -341,6 +440,527 @@ var TriggerCommandService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ManuallyTrigger",
 			Handler:    _TriggerCommandService_ManuallyTrigger_Handler,
 		},
+		{
+			MethodName: "CreateTrigger",
+			Handler:    _TriggerCommandService_CreateTrigger_Handler,
+		},
+		{
+			MethodName: "UpdateTrigger",
+			Handler:    _TriggerCommandService_UpdateTrigger_Handler,
+		},
+		{
+			MethodName: "RestoreTrigger",
+			Handler:    _TriggerCommandService_RestoreTrigger_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "o5/trigger/v1/service/trigger.p.j5s.proto",
+}
+
+const (
+	CalendarQueryService_CalendarGet_FullMethodName    = "/o5.trigger.v1.service.CalendarQueryService/CalendarGet"
+	CalendarQueryService_CalendarList_FullMethodName   = "/o5.trigger.v1.service.CalendarQueryService/CalendarList"
+	CalendarQueryService_CalendarEvents_FullMethodName = "/o5.trigger.v1.service.CalendarQueryService/CalendarEvents"
+)
+
+// CalendarQueryServiceClient is the client API for CalendarQueryService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type CalendarQueryServiceClient interface {
+	CalendarGet(ctx context.Context, in *CalendarGetRequest, opts ...grpc.CallOption) (*CalendarGetResponse, error)
+	CalendarList(ctx context.Context, in *CalendarListRequest, opts ...grpc.CallOption) (*CalendarListResponse, error)
+	CalendarEvents(ctx context.Context, in *CalendarEventsRequest, opts ...grpc.CallOption) (*CalendarEventsResponse, error)
+}
+
+type calendarQueryServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewCalendarQueryServiceClient(cc grpc.ClientConnInterface) CalendarQueryServiceClient {
+	return &calendarQueryServiceClient{cc}
+}
+
+func (c *calendarQueryServiceClient) CalendarGet(ctx context.Context, in *CalendarGetRequest, opts ...grpc.CallOption) (*CalendarGetResponse, error) {
+	out := new(CalendarGetResponse)
+	err := c.cc.Invoke(ctx, CalendarQueryService_CalendarGet_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *calendarQueryServiceClient) CalendarList(ctx context.Context, in *CalendarListRequest, opts ...grpc.CallOption) (*CalendarListResponse, error) {
+	out := new(CalendarListResponse)
+	err := c.cc.Invoke(ctx, CalendarQueryService_CalendarList_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *calendarQueryServiceClient) CalendarEvents(ctx context.Context, in *CalendarEventsRequest, opts ...grpc.CallOption) (*CalendarEventsResponse, error) {
+	out := new(CalendarEventsResponse)
+	err := c.cc.Invoke(ctx, CalendarQueryService_CalendarEvents_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// CalendarQueryServiceServer is the server API for CalendarQueryService service.
+// All implementations must embed UnimplementedCalendarQueryServiceServer
+// for forward compatibility
+type CalendarQueryServiceServer interface {
+	CalendarGet(context.Context, *CalendarGetRequest) (*CalendarGetResponse, error)
+	CalendarList(context.Context, *CalendarListRequest) (*CalendarListResponse, error)
+	CalendarEvents(context.Context, *CalendarEventsRequest) (*CalendarEventsResponse, error)
+	mustEmbedUnimplementedCalendarQueryServiceServer()
+}
+
+// UnimplementedCalendarQueryServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedCalendarQueryServiceServer struct {
+}
+
+func (UnimplementedCalendarQueryServiceServer) CalendarGet(context.Context, *CalendarGetRequest) (*CalendarGetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CalendarGet not implemented")
+}
+func (UnimplementedCalendarQueryServiceServer) CalendarList(context.Context, *CalendarListRequest) (*CalendarListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CalendarList not implemented")
+}
+func (UnimplementedCalendarQueryServiceServer) CalendarEvents(context.Context, *CalendarEventsRequest) (*CalendarEventsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CalendarEvents not implemented")
+}
+func (UnimplementedCalendarQueryServiceServer) mustEmbedUnimplementedCalendarQueryServiceServer() {}
+
+// UnsafeCalendarQueryServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to CalendarQueryServiceServer will
+// result in compilation errors.
+type UnsafeCalendarQueryServiceServer interface {
+	mustEmbedUnimplementedCalendarQueryServiceServer()
+}
+
+func RegisterCalendarQueryServiceServer(s grpc.ServiceRegistrar, srv CalendarQueryServiceServer) {
+	s.RegisterService(&CalendarQueryService_ServiceDesc, srv)
+}
+
+func _CalendarQueryService_CalendarGet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CalendarGetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CalendarQueryServiceServer).CalendarGet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CalendarQueryService_CalendarGet_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CalendarQueryServiceServer).CalendarGet(ctx, req.(*CalendarGetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CalendarQueryService_CalendarList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CalendarListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CalendarQueryServiceServer).CalendarList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CalendarQueryService_CalendarList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CalendarQueryServiceServer).CalendarList(ctx, req.(*CalendarListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CalendarQueryService_CalendarEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CalendarEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CalendarQueryServiceServer).CalendarEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CalendarQueryService_CalendarEvents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CalendarQueryServiceServer).CalendarEvents(ctx, req.(*CalendarEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// CalendarQueryService_ServiceDesc is the grpc.ServiceDesc for CalendarQueryService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var CalendarQueryService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "o5.trigger.v1.service.CalendarQueryService",
+	HandlerType: (*CalendarQueryServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CalendarGet",
+			Handler:    _CalendarQueryService_CalendarGet_Handler,
+		},
+		{
+			MethodName: "CalendarList",
+			Handler:    _CalendarQueryService_CalendarList_Handler,
+		},
+		{
+			MethodName: "CalendarEvents",
+			Handler:    _CalendarQueryService_CalendarEvents_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "o5/trigger/v1/service/trigger.p.j5s.proto",
+}
+
+const (
+	CalendarCommandService_CreateCalendar_FullMethodName  = "/o5.trigger.v1.service.CalendarCommandService/CreateCalendar"
+	CalendarCommandService_UpdateCalendar_FullMethodName  = "/o5.trigger.v1.service.CalendarCommandService/UpdateCalendar"
+	CalendarCommandService_ArchiveCalendar_FullMethodName = "/o5.trigger.v1.service.CalendarCommandService/ArchiveCalendar"
+)
+
+// CalendarCommandServiceClient is the client API for CalendarCommandService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type CalendarCommandServiceClient interface {
+	CreateCalendar(ctx context.Context, in *CreateCalendarRequest, opts ...grpc.CallOption) (*CreateCalendarResponse, error)
+	UpdateCalendar(ctx context.Context, in *UpdateCalendarRequest, opts ...grpc.CallOption) (*UpdateCalendarResponse, error)
+	ArchiveCalendar(ctx context.Context, in *ArchiveCalendarRequest, opts ...grpc.CallOption) (*ArchiveCalendarResponse, error)
+}
+
+type calendarCommandServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewCalendarCommandServiceClient(cc grpc.ClientConnInterface) CalendarCommandServiceClient {
+	return &calendarCommandServiceClient{cc}
+}
+
+func (c *calendarCommandServiceClient) CreateCalendar(ctx context.Context, in *CreateCalendarRequest, opts ...grpc.CallOption) (*CreateCalendarResponse, error) {
+	out := new(CreateCalendarResponse)
+	err := c.cc.Invoke(ctx, CalendarCommandService_CreateCalendar_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *calendarCommandServiceClient) UpdateCalendar(ctx context.Context, in *UpdateCalendarRequest, opts ...grpc.CallOption) (*UpdateCalendarResponse, error) {
+	out := new(UpdateCalendarResponse)
+	err := c.cc.Invoke(ctx, CalendarCommandService_UpdateCalendar_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *calendarCommandServiceClient) ArchiveCalendar(ctx context.Context, in *ArchiveCalendarRequest, opts ...grpc.CallOption) (*ArchiveCalendarResponse, error) {
+	out := new(ArchiveCalendarResponse)
+	err := c.cc.Invoke(ctx, CalendarCommandService_ArchiveCalendar_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// CalendarCommandServiceServer is the server API for CalendarCommandService service.
+// All implementations must embed UnimplementedCalendarCommandServiceServer
+// for forward compatibility
+type CalendarCommandServiceServer interface {
+	CreateCalendar(context.Context, *CreateCalendarRequest) (*CreateCalendarResponse, error)
+	UpdateCalendar(context.Context, *UpdateCalendarRequest) (*UpdateCalendarResponse, error)
+	ArchiveCalendar(context.Context, *ArchiveCalendarRequest) (*ArchiveCalendarResponse, error)
+	mustEmbedUnimplementedCalendarCommandServiceServer()
+}
+
+// UnimplementedCalendarCommandServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedCalendarCommandServiceServer struct {
+}
+
+func (UnimplementedCalendarCommandServiceServer) CreateCalendar(context.Context, *CreateCalendarRequest) (*CreateCalendarResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateCalendar not implemented")
+}
+func (UnimplementedCalendarCommandServiceServer) UpdateCalendar(context.Context, *UpdateCalendarRequest) (*UpdateCalendarResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateCalendar not implemented")
+}
+func (UnimplementedCalendarCommandServiceServer) ArchiveCalendar(context.Context, *ArchiveCalendarRequest) (*ArchiveCalendarResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ArchiveCalendar not implemented")
+}
+func (UnimplementedCalendarCommandServiceServer) mustEmbedUnimplementedCalendarCommandServiceServer() {
+}
+
+// UnsafeCalendarCommandServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to CalendarCommandServiceServer will
+// result in compilation errors.
+type UnsafeCalendarCommandServiceServer interface {
+	mustEmbedUnimplementedCalendarCommandServiceServer()
+}
+
+func RegisterCalendarCommandServiceServer(s grpc.ServiceRegistrar, srv CalendarCommandServiceServer) {
+	s.RegisterService(&CalendarCommandService_ServiceDesc, srv)
+}
+
+func _CalendarCommandService_CreateCalendar_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCalendarRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CalendarCommandServiceServer).CreateCalendar(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CalendarCommandService_CreateCalendar_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CalendarCommandServiceServer).CreateCalendar(ctx, req.(*CreateCalendarRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CalendarCommandService_UpdateCalendar_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateCalendarRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CalendarCommandServiceServer).UpdateCalendar(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CalendarCommandService_UpdateCalendar_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CalendarCommandServiceServer).UpdateCalendar(ctx, req.(*UpdateCalendarRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CalendarCommandService_ArchiveCalendar_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ArchiveCalendarRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CalendarCommandServiceServer).ArchiveCalendar(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CalendarCommandService_ArchiveCalendar_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CalendarCommandServiceServer).ArchiveCalendar(ctx, req.(*ArchiveCalendarRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// CalendarCommandService_ServiceDesc is the grpc.ServiceDesc for CalendarCommandService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var CalendarCommandService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "o5.trigger.v1.service.CalendarCommandService",
+	HandlerType: (*CalendarCommandServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateCalendar",
+			Handler:    _CalendarCommandService_CreateCalendar_Handler,
+		},
+		{
+			MethodName: "UpdateCalendar",
+			Handler:    _CalendarCommandService_UpdateCalendar_Handler,
+		},
+		{
+			MethodName: "ArchiveCalendar",
+			Handler:    _CalendarCommandService_ArchiveCalendar_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "o5/trigger/v1/service/trigger.p.j5s.proto",
+}
+
+const (
+	TriggerPreviewService_PreviewTrigger_FullMethodName = "/o5.trigger.v1.service.TriggerPreviewService/PreviewTrigger"
+)
+
+// TriggerPreviewServiceClient is the client API for TriggerPreviewService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type TriggerPreviewServiceClient interface {
+	PreviewTrigger(ctx context.Context, in *PreviewTriggerRequest, opts ...grpc.CallOption) (*PreviewTriggerResponse, error)
+}
+
+type triggerPreviewServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewTriggerPreviewServiceClient(cc grpc.ClientConnInterface) TriggerPreviewServiceClient {
+	return &triggerPreviewServiceClient{cc}
+}
+
+func (c *triggerPreviewServiceClient) PreviewTrigger(ctx context.Context, in *PreviewTriggerRequest, opts ...grpc.CallOption) (*PreviewTriggerResponse, error) {
+	out := new(PreviewTriggerResponse)
+	err := c.cc.Invoke(ctx, TriggerPreviewService_PreviewTrigger_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// TriggerPreviewServiceServer is the server API for TriggerPreviewService service.
+// All implementations must embed UnimplementedTriggerPreviewServiceServer
+// for forward compatibility
+type TriggerPreviewServiceServer interface {
+	PreviewTrigger(context.Context, *PreviewTriggerRequest) (*PreviewTriggerResponse, error)
+	mustEmbedUnimplementedTriggerPreviewServiceServer()
+}
+
+// UnimplementedTriggerPreviewServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedTriggerPreviewServiceServer struct {
+}
+
+func (UnimplementedTriggerPreviewServiceServer) PreviewTrigger(context.Context, *PreviewTriggerRequest) (*PreviewTriggerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PreviewTrigger not implemented")
+}
+func (UnimplementedTriggerPreviewServiceServer) mustEmbedUnimplementedTriggerPreviewServiceServer() {}
+
+// UnsafeTriggerPreviewServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to TriggerPreviewServiceServer will
+// result in compilation errors.
+type UnsafeTriggerPreviewServiceServer interface {
+	mustEmbedUnimplementedTriggerPreviewServiceServer()
+}
+
+func RegisterTriggerPreviewServiceServer(s grpc.ServiceRegistrar, srv TriggerPreviewServiceServer) {
+	s.RegisterService(&TriggerPreviewService_ServiceDesc, srv)
+}
+
+func _TriggerPreviewService_PreviewTrigger_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PreviewTriggerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TriggerPreviewServiceServer).PreviewTrigger(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TriggerPreviewService_PreviewTrigger_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TriggerPreviewServiceServer).PreviewTrigger(ctx, req.(*PreviewTriggerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// TriggerPreviewService_ServiceDesc is the grpc.ServiceDesc for TriggerPreviewService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var TriggerPreviewService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "o5.trigger.v1.service.TriggerPreviewService",
+	HandlerType: (*TriggerPreviewServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "PreviewTrigger",
+			Handler:    _TriggerPreviewService_PreviewTrigger_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "o5/trigger/v1/service/trigger.p.j5s.proto",
+}
+
+const (
+	TriggerTickService_TickStatus_FullMethodName = "/o5.trigger.v1.service.TriggerTickService/TickStatus"
+)
+
+// TriggerTickServiceClient is the client API for TriggerTickService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type TriggerTickServiceClient interface {
+	TickStatus(ctx context.Context, in *TickStatusRequest, opts ...grpc.CallOption) (*TickStatusResponse, error)
+}
+
+type triggerTickServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewTriggerTickServiceClient(cc grpc.ClientConnInterface) TriggerTickServiceClient {
+	return &triggerTickServiceClient{cc}
+}
+
+func (c *triggerTickServiceClient) TickStatus(ctx context.Context, in *TickStatusRequest, opts ...grpc.CallOption) (*TickStatusResponse, error) {
+	out := new(TickStatusResponse)
+	err := c.cc.Invoke(ctx, TriggerTickService_TickStatus_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// TriggerTickServiceServer is the server API for TriggerTickService service.
+// All implementations must embed UnimplementedTriggerTickServiceServer
+// for forward compatibility
+type TriggerTickServiceServer interface {
+	TickStatus(context.Context, *TickStatusRequest) (*TickStatusResponse, error)
+	mustEmbedUnimplementedTriggerTickServiceServer()
+}
+
+// UnimplementedTriggerTickServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedTriggerTickServiceServer struct {
+}
+
+func (UnimplementedTriggerTickServiceServer) TickStatus(context.Context, *TickStatusRequest) (*TickStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TickStatus not implemented")
+}
+func (UnimplementedTriggerTickServiceServer) mustEmbedUnimplementedTriggerTickServiceServer() {}
+
+// UnsafeTriggerTickServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to TriggerTickServiceServer will
+// result in compilation errors.
+type UnsafeTriggerTickServiceServer interface {
+	mustEmbedUnimplementedTriggerTickServiceServer()
+}
+
+func RegisterTriggerTickServiceServer(s grpc.ServiceRegistrar, srv TriggerTickServiceServer) {
+	s.RegisterService(&TriggerTickService_ServiceDesc, srv)
+}
+
+func _TriggerTickService_TickStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TickStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TriggerTickServiceServer).TickStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TriggerTickService_TickStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TriggerTickServiceServer).TickStatus(ctx, req.(*TickStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// TriggerTickService_ServiceDesc is the grpc.ServiceDesc for TriggerTickService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var TriggerTickService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "o5.trigger.v1.service.TriggerTickService",
+	HandlerType: (*TriggerTickServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "TickStatus",
+			Handler:    _TriggerTickService_TickStatus_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "o5/trigger/v1/service/trigger.p.j5s.proto",
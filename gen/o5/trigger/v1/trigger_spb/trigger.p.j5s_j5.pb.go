@@ -138,3 +138,245 @@ func (msg *ManuallyTriggerResponse) J5Reflect() j5reflect.Root {
 func (msg *ManuallyTriggerResponse) J5Object() j5reflect.Object {
 	return j5reflect.MustReflect(msg.ProtoReflect()).(j5reflect.Object)
 }
+
+func (msg *CreateTriggerRequest) Clone() any {
+	return proto.Clone(msg).(*CreateTriggerRequest)
+}
+func (msg *CreateTriggerRequest) J5Reflect() j5reflect.Root {
+	return j5reflect.MustReflect(msg.ProtoReflect())
+}
+
+func (msg *CreateTriggerRequest) J5Object() j5reflect.Object {
+	return j5reflect.MustReflect(msg.ProtoReflect()).(j5reflect.Object)
+}
+
+func (msg *CreateTriggerResponse) Clone() any {
+	return proto.Clone(msg).(*CreateTriggerResponse)
+}
+func (msg *CreateTriggerResponse) J5Reflect() j5reflect.Root {
+	return j5reflect.MustReflect(msg.ProtoReflect())
+}
+
+func (msg *CreateTriggerResponse) J5Object() j5reflect.Object {
+	return j5reflect.MustReflect(msg.ProtoReflect()).(j5reflect.Object)
+}
+
+func (msg *UpdateTriggerRequest) Clone() any {
+	return proto.Clone(msg).(*UpdateTriggerRequest)
+}
+func (msg *UpdateTriggerRequest) J5Reflect() j5reflect.Root {
+	return j5reflect.MustReflect(msg.ProtoReflect())
+}
+
+func (msg *UpdateTriggerRequest) J5Object() j5reflect.Object {
+	return j5reflect.MustReflect(msg.ProtoReflect()).(j5reflect.Object)
+}
+
+func (msg *UpdateTriggerResponse) Clone() any {
+	return proto.Clone(msg).(*UpdateTriggerResponse)
+}
+func (msg *UpdateTriggerResponse) J5Reflect() j5reflect.Root {
+	return j5reflect.MustReflect(msg.ProtoReflect())
+}
+
+func (msg *UpdateTriggerResponse) J5Object() j5reflect.Object {
+	return j5reflect.MustReflect(msg.ProtoReflect()).(j5reflect.Object)
+}
+
+func (msg *RestoreTriggerRequest) Clone() any {
+	return proto.Clone(msg).(*RestoreTriggerRequest)
+}
+func (msg *RestoreTriggerRequest) J5Reflect() j5reflect.Root {
+	return j5reflect.MustReflect(msg.ProtoReflect())
+}
+
+func (msg *RestoreTriggerRequest) J5Object() j5reflect.Object {
+	return j5reflect.MustReflect(msg.ProtoReflect()).(j5reflect.Object)
+}
+
+func (msg *RestoreTriggerResponse) Clone() any {
+	return proto.Clone(msg).(*RestoreTriggerResponse)
+}
+func (msg *RestoreTriggerResponse) J5Reflect() j5reflect.Root {
+	return j5reflect.MustReflect(msg.ProtoReflect())
+}
+
+func (msg *RestoreTriggerResponse) J5Object() j5reflect.Object {
+	return j5reflect.MustReflect(msg.ProtoReflect()).(j5reflect.Object)
+}
+
+func (msg *CalendarGetRequest) Clone() any {
+	return proto.Clone(msg).(*CalendarGetRequest)
+}
+func (msg *CalendarGetRequest) J5Reflect() j5reflect.Root {
+	return j5reflect.MustReflect(msg.ProtoReflect())
+}
+
+func (msg *CalendarGetRequest) J5Object() j5reflect.Object {
+	return j5reflect.MustReflect(msg.ProtoReflect()).(j5reflect.Object)
+}
+
+func (msg *CalendarGetResponse) Clone() any {
+	return proto.Clone(msg).(*CalendarGetResponse)
+}
+func (msg *CalendarGetResponse) J5Reflect() j5reflect.Root {
+	return j5reflect.MustReflect(msg.ProtoReflect())
+}
+
+func (msg *CalendarGetResponse) J5Object() j5reflect.Object {
+	return j5reflect.MustReflect(msg.ProtoReflect()).(j5reflect.Object)
+}
+
+func (msg *CalendarListRequest) Clone() any {
+	return proto.Clone(msg).(*CalendarListRequest)
+}
+func (msg *CalendarListRequest) J5Reflect() j5reflect.Root {
+	return j5reflect.MustReflect(msg.ProtoReflect())
+}
+
+func (msg *CalendarListRequest) J5Object() j5reflect.Object {
+	return j5reflect.MustReflect(msg.ProtoReflect()).(j5reflect.Object)
+}
+
+func (msg *CalendarListResponse) Clone() any {
+	return proto.Clone(msg).(*CalendarListResponse)
+}
+func (msg *CalendarListResponse) J5Reflect() j5reflect.Root {
+	return j5reflect.MustReflect(msg.ProtoReflect())
+}
+
+func (msg *CalendarListResponse) J5Object() j5reflect.Object {
+	return j5reflect.MustReflect(msg.ProtoReflect()).(j5reflect.Object)
+}
+
+func (msg *CalendarEventsRequest) Clone() any {
+	return proto.Clone(msg).(*CalendarEventsRequest)
+}
+func (msg *CalendarEventsRequest) J5Reflect() j5reflect.Root {
+	return j5reflect.MustReflect(msg.ProtoReflect())
+}
+
+func (msg *CalendarEventsRequest) J5Object() j5reflect.Object {
+	return j5reflect.MustReflect(msg.ProtoReflect()).(j5reflect.Object)
+}
+
+func (msg *CalendarEventsResponse) Clone() any {
+	return proto.Clone(msg).(*CalendarEventsResponse)
+}
+func (msg *CalendarEventsResponse) J5Reflect() j5reflect.Root {
+	return j5reflect.MustReflect(msg.ProtoReflect())
+}
+
+func (msg *CalendarEventsResponse) J5Object() j5reflect.Object {
+	return j5reflect.MustReflect(msg.ProtoReflect()).(j5reflect.Object)
+}
+
+func (msg *CreateCalendarRequest) Clone() any {
+	return proto.Clone(msg).(*CreateCalendarRequest)
+}
+func (msg *CreateCalendarRequest) J5Reflect() j5reflect.Root {
+	return j5reflect.MustReflect(msg.ProtoReflect())
+}
+
+func (msg *CreateCalendarRequest) J5Object() j5reflect.Object {
+	return j5reflect.MustReflect(msg.ProtoReflect()).(j5reflect.Object)
+}
+
+func (msg *CreateCalendarResponse) Clone() any {
+	return proto.Clone(msg).(*CreateCalendarResponse)
+}
+func (msg *CreateCalendarResponse) J5Reflect() j5reflect.Root {
+	return j5reflect.MustReflect(msg.ProtoReflect())
+}
+
+func (msg *CreateCalendarResponse) J5Object() j5reflect.Object {
+	return j5reflect.MustReflect(msg.ProtoReflect()).(j5reflect.Object)
+}
+
+func (msg *UpdateCalendarRequest) Clone() any {
+	return proto.Clone(msg).(*UpdateCalendarRequest)
+}
+func (msg *UpdateCalendarRequest) J5Reflect() j5reflect.Root {
+	return j5reflect.MustReflect(msg.ProtoReflect())
+}
+
+func (msg *UpdateCalendarRequest) J5Object() j5reflect.Object {
+	return j5reflect.MustReflect(msg.ProtoReflect()).(j5reflect.Object)
+}
+
+func (msg *UpdateCalendarResponse) Clone() any {
+	return proto.Clone(msg).(*UpdateCalendarResponse)
+}
+func (msg *UpdateCalendarResponse) J5Reflect() j5reflect.Root {
+	return j5reflect.MustReflect(msg.ProtoReflect())
+}
+
+func (msg *UpdateCalendarResponse) J5Object() j5reflect.Object {
+	return j5reflect.MustReflect(msg.ProtoReflect()).(j5reflect.Object)
+}
+
+func (msg *ArchiveCalendarRequest) Clone() any {
+	return proto.Clone(msg).(*ArchiveCalendarRequest)
+}
+func (msg *ArchiveCalendarRequest) J5Reflect() j5reflect.Root {
+	return j5reflect.MustReflect(msg.ProtoReflect())
+}
+
+func (msg *ArchiveCalendarRequest) J5Object() j5reflect.Object {
+	return j5reflect.MustReflect(msg.ProtoReflect()).(j5reflect.Object)
+}
+
+func (msg *ArchiveCalendarResponse) Clone() any {
+	return proto.Clone(msg).(*ArchiveCalendarResponse)
+}
+func (msg *ArchiveCalendarResponse) J5Reflect() j5reflect.Root {
+	return j5reflect.MustReflect(msg.ProtoReflect())
+}
+
+func (msg *ArchiveCalendarResponse) J5Object() j5reflect.Object {
+	return j5reflect.MustReflect(msg.ProtoReflect()).(j5reflect.Object)
+}
+
+func (msg *PreviewTriggerRequest) Clone() any {
+	return proto.Clone(msg).(*PreviewTriggerRequest)
+}
+func (msg *PreviewTriggerRequest) J5Reflect() j5reflect.Root {
+	return j5reflect.MustReflect(msg.ProtoReflect())
+}
+
+func (msg *PreviewTriggerRequest) J5Object() j5reflect.Object {
+	return j5reflect.MustReflect(msg.ProtoReflect()).(j5reflect.Object)
+}
+
+func (msg *PreviewTriggerResponse) Clone() any {
+	return proto.Clone(msg).(*PreviewTriggerResponse)
+}
+func (msg *PreviewTriggerResponse) J5Reflect() j5reflect.Root {
+	return j5reflect.MustReflect(msg.ProtoReflect())
+}
+
+func (msg *PreviewTriggerResponse) J5Object() j5reflect.Object {
+	return j5reflect.MustReflect(msg.ProtoReflect()).(j5reflect.Object)
+}
+
+func (msg *TickStatusRequest) Clone() any {
+	return proto.Clone(msg).(*TickStatusRequest)
+}
+func (msg *TickStatusRequest) J5Reflect() j5reflect.Root {
+	return j5reflect.MustReflect(msg.ProtoReflect())
+}
+
+func (msg *TickStatusRequest) J5Object() j5reflect.Object {
+	return j5reflect.MustReflect(msg.ProtoReflect()).(j5reflect.Object)
+}
+
+func (msg *TickStatusResponse) Clone() any {
+	return proto.Clone(msg).(*TickStatusResponse)
+}
+func (msg *TickStatusResponse) J5Reflect() j5reflect.Root {
+	return j5reflect.MustReflect(msg.ProtoReflect())
+}
+
+func (msg *TickStatusResponse) J5Object() j5reflect.Object {
+	return j5reflect.MustReflect(msg.ProtoReflect()).(j5reflect.Object)
+}
@@ -101,3 +101,94 @@ func (s *TriggerQueryServiceImpl) TriggerEvents(ctx context.Context, req *Trigge
 	}
 	return resObject, nil
 }
+
+// State Query Service for %sCalendar
+// QuerySet is the query set for the Calendar service.
+
+type CalendarPSMQuerySet = psm.StateQuerySet
+
+func NewCalendarPSMQuerySet(
+	smSpec psm.QuerySpec,
+	options psm.StateQueryOptions,
+) (*CalendarPSMQuerySet, error) {
+	return psm.BuildStateQuerySet(smSpec, options)
+}
+
+type CalendarPSMQuerySpec = psm.QuerySpec
+
+func DefaultCalendarPSMQuerySpec(tableSpec psm.QueryTableSpec) CalendarPSMQuerySpec {
+	return psm.QuerySpec{
+		GetMethod: &j5schema.MethodSchema{
+			Request:  j5schema.MustObjectSchema((&CalendarGetRequest{}).ProtoReflect().Descriptor()),
+			Response: j5schema.MustObjectSchema((&CalendarGetResponse{}).ProtoReflect().Descriptor()),
+		},
+		ListMethod: &j5schema.MethodSchema{
+			Request:  j5schema.MustObjectSchema((&CalendarListRequest{}).ProtoReflect().Descriptor()),
+			Response: j5schema.MustObjectSchema((&CalendarListResponse{}).ProtoReflect().Descriptor()),
+		},
+		ListEventsMethod: &j5schema.MethodSchema{
+			Request:  j5schema.MustObjectSchema((&CalendarEventsRequest{}).ProtoReflect().Descriptor()),
+			Response: j5schema.MustObjectSchema((&CalendarEventsResponse{}).ProtoReflect().Descriptor()),
+		},
+		QueryTableSpec: tableSpec,
+		ListRequestFilter: func(reqReflect j5reflect.Object) (map[string]interface{}, error) {
+			req, ok := reqReflect.Interface().(*CalendarListRequest)
+			if !ok {
+				return nil, fmt.Errorf("expected *CalendarListRequest but got %T", req)
+			}
+			filter := map[string]interface{}{}
+			return filter, nil
+		},
+		ListEventsRequestFilter: func(reqReflect j5reflect.Object) (map[string]interface{}, error) {
+			req, ok := reqReflect.Interface().(*CalendarEventsRequest)
+			if !ok {
+				return nil, fmt.Errorf("expected *CalendarEventsRequest but got %T", req)
+			}
+			filter := map[string]interface{}{}
+			filter["calendar_id"] = req.CalendarId
+			return filter, nil
+		},
+	}
+}
+
+type CalendarQueryServiceImpl struct {
+	db       sqrlx.Transactor
+	querySet *CalendarPSMQuerySet
+	UnsafeCalendarQueryServiceServer
+}
+
+var _ CalendarQueryServiceServer = &CalendarQueryServiceImpl{}
+
+func NewCalendarQueryServiceImpl(db sqrlx.Transactor, querySet *CalendarPSMQuerySet) *CalendarQueryServiceImpl {
+	return &CalendarQueryServiceImpl{
+		db:       db,
+		querySet: querySet,
+	}
+}
+
+func (s *CalendarQueryServiceImpl) CalendarGet(ctx context.Context, req *CalendarGetRequest) (*CalendarGetResponse, error) {
+	resObject := &CalendarGetResponse{}
+	err := s.querySet.Get(ctx, s.db, req.J5Object(), resObject.J5Object())
+	if err != nil {
+		return nil, err
+	}
+	return resObject, nil
+}
+
+func (s *CalendarQueryServiceImpl) CalendarList(ctx context.Context, req *CalendarListRequest) (*CalendarListResponse, error) {
+	resObject := &CalendarListResponse{}
+	err := s.querySet.List(ctx, s.db, req.J5Object(), resObject.J5Object())
+	if err != nil {
+		return nil, err
+	}
+	return resObject, nil
+}
+
+func (s *CalendarQueryServiceImpl) CalendarEvents(ctx context.Context, req *CalendarEventsRequest) (*CalendarEventsResponse, error) {
+	resObject := &CalendarEventsResponse{}
+	err := s.querySet.ListEvents(ctx, s.db, req.J5Object(), resObject.J5Object())
+	if err != nil {
+		return nil, err
+	}
+	return resObject, nil
+}
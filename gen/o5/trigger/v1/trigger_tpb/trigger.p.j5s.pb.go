@@ -11,6 +11,7 @@ import (
 	_ "github.com/pentops/j5/gen/j5/ext/v1/ext_j5pb"
 	messaging_j5pb "github.com/pentops/j5/gen/j5/messaging/v1/messaging_j5pb"
 	psm_j5pb "github.com/pentops/j5/gen/j5/state/v1/psm_j5pb"
+	any_j5t "github.com/pentops/j5/j5types/any_j5t"
 	trigger_pb "github.com/pentops/trigger/gen/o5/trigger/v1/trigger_pb"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
@@ -106,6 +107,85 @@ func (x *TriggerEventMessage) GetStatus() trigger_pb.TriggerStatus {
 	return trigger_pb.TriggerStatus(0)
 }
 
+type CalendarEventMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Metadata *psm_j5pb.EventPublishMetadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	Keys     *trigger_pb.CalendarKeys       `protobuf:"bytes,2,opt,name=keys,proto3" json:"keys,omitempty"`
+	Event    *trigger_pb.CalendarEventType  `protobuf:"bytes,3,opt,name=event,proto3" json:"event,omitempty"`
+	Data     *trigger_pb.CalendarData       `protobuf:"bytes,4,opt,name=data,proto3" json:"data,omitempty"`
+	Status   trigger_pb.CalendarStatus      `protobuf:"varint,5,opt,name=status,proto3,enum=o5.trigger.v1.CalendarStatus" json:"status,omitempty"`
+}
+
+func (x *CalendarEventMessage) Reset() {
+	*x = CalendarEventMessage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_o5_trigger_v1_topic_trigger_p_j5s_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CalendarEventMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CalendarEventMessage) ProtoMessage() {}
+
+func (x *CalendarEventMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_o5_trigger_v1_topic_trigger_p_j5s_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CalendarEventMessage.ProtoReflect.Descriptor instead.
+func (*CalendarEventMessage) Descriptor() ([]byte, []int) {
+	return file_o5_trigger_v1_topic_trigger_p_j5s_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CalendarEventMessage) GetMetadata() *psm_j5pb.EventPublishMetadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *CalendarEventMessage) GetKeys() *trigger_pb.CalendarKeys {
+	if x != nil {
+		return x.Keys
+	}
+	return nil
+}
+
+func (x *CalendarEventMessage) GetEvent() *trigger_pb.CalendarEventType {
+	if x != nil {
+		return x.Event
+	}
+	return nil
+}
+
+func (x *CalendarEventMessage) GetData() *trigger_pb.CalendarData {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *CalendarEventMessage) GetStatus() trigger_pb.CalendarStatus {
+	if x != nil {
+		return x.Status
+	}
+	return trigger_pb.CalendarStatus(0)
+}
+
 type TriggerManageRequestMessage struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -118,7 +198,7 @@ type TriggerManageRequestMessage struct {
 func (x *TriggerManageRequestMessage) Reset() {
 	*x = TriggerManageRequestMessage{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_o5_trigger_v1_topic_trigger_p_j5s_proto_msgTypes[1]
+		mi := &file_o5_trigger_v1_topic_trigger_p_j5s_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -131,7 +211,7 @@ func (x *TriggerManageRequestMessage) String() string {
 func (*TriggerManageRequestMessage) ProtoMessage() {}
 
 func (x *TriggerManageRequestMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_o5_trigger_v1_topic_trigger_p_j5s_proto_msgTypes[1]
+	mi := &file_o5_trigger_v1_topic_trigger_p_j5s_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -144,7 +224,7 @@ func (x *TriggerManageRequestMessage) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TriggerManageRequestMessage.ProtoReflect.Descriptor instead.
 func (*TriggerManageRequestMessage) Descriptor() ([]byte, []int) {
-	return file_o5_trigger_v1_topic_trigger_p_j5s_proto_rawDescGZIP(), []int{1}
+	return file_o5_trigger_v1_topic_trigger_p_j5s_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *TriggerManageRequestMessage) GetRequest() *messaging_j5pb.RequestMetadata {
@@ -167,14 +247,22 @@ type TriggerManageReplyMessage struct {
 	unknownFields protoimpl.UnknownFields
 
 	Request *messaging_j5pb.RequestMetadata `protobuf:"bytes,1,opt,name=request,proto3" json:"request,omitempty"`
-	// The time the trigger is for
-	TickTime *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=tick_time,json=tickTime,proto3" json:"tick_time,omitempty"`
+	// The trigger the action was for, including the ID assigned on create
+	TriggerId *string `protobuf:"bytes,2,opt,name=trigger_id,json=triggerId,proto3,oneof" json:"trigger_id,omitempty"`
+	// The calendar the action was for
+	CalendarId *string `protobuf:"bytes,3,opt,name=calendar_id,json=calendarId,proto3,oneof" json:"calendar_id,omitempty"`
+	// The action was applied
+	Success bool `protobuf:"varint,4,opt,name=success,proto3" json:"success,omitempty"`
+	// Why the action was rejected
+	Error *string `protobuf:"bytes,5,opt,name=error,proto3,oneof" json:"error,omitempty"`
+	// When the trigger is next scheduled to fire after the action, unset when it will not fire again
+	NextFireAt *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=next_fire_at,json=nextFireAt,proto3,oneof" json:"next_fire_at,omitempty"`
 }
 
 func (x *TriggerManageReplyMessage) Reset() {
 	*x = TriggerManageReplyMessage{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_o5_trigger_v1_topic_trigger_p_j5s_proto_msgTypes[2]
+		mi := &file_o5_trigger_v1_topic_trigger_p_j5s_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -187,7 +275,7 @@ func (x *TriggerManageReplyMessage) String() string {
 func (*TriggerManageReplyMessage) ProtoMessage() {}
 
 func (x *TriggerManageReplyMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_o5_trigger_v1_topic_trigger_p_j5s_proto_msgTypes[2]
+	mi := &file_o5_trigger_v1_topic_trigger_p_j5s_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -200,7 +288,7 @@ func (x *TriggerManageReplyMessage) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TriggerManageReplyMessage.ProtoReflect.Descriptor instead.
 func (*TriggerManageReplyMessage) Descriptor() ([]byte, []int) {
-	return file_o5_trigger_v1_topic_trigger_p_j5s_proto_rawDescGZIP(), []int{2}
+	return file_o5_trigger_v1_topic_trigger_p_j5s_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *TriggerManageReplyMessage) GetRequest() *messaging_j5pb.RequestMetadata {
@@ -210,9 +298,37 @@ func (x *TriggerManageReplyMessage) GetRequest() *messaging_j5pb.RequestMetadata
 	return nil
 }
 
-func (x *TriggerManageReplyMessage) GetTickTime() *timestamppb.Timestamp {
+func (x *TriggerManageReplyMessage) GetTriggerId() string {
+	if x != nil && x.TriggerId != nil {
+		return *x.TriggerId
+	}
+	return ""
+}
+
+func (x *TriggerManageReplyMessage) GetCalendarId() string {
+	if x != nil && x.CalendarId != nil {
+		return *x.CalendarId
+	}
+	return ""
+}
+
+func (x *TriggerManageReplyMessage) GetSuccess() bool {
 	if x != nil {
-		return x.TickTime
+		return x.Success
+	}
+	return false
+}
+
+func (x *TriggerManageReplyMessage) GetError() string {
+	if x != nil && x.Error != nil {
+		return *x.Error
+	}
+	return ""
+}
+
+func (x *TriggerManageReplyMessage) GetNextFireAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.NextFireAt
 	}
 	return nil
 }
@@ -228,7 +344,7 @@ type TriggerRequestMessage struct {
 func (x *TriggerRequestMessage) Reset() {
 	*x = TriggerRequestMessage{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_o5_trigger_v1_topic_trigger_p_j5s_proto_msgTypes[3]
+		mi := &file_o5_trigger_v1_topic_trigger_p_j5s_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -241,7 +357,7 @@ func (x *TriggerRequestMessage) String() string {
 func (*TriggerRequestMessage) ProtoMessage() {}
 
 func (x *TriggerRequestMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_o5_trigger_v1_topic_trigger_p_j5s_proto_msgTypes[3]
+	mi := &file_o5_trigger_v1_topic_trigger_p_j5s_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -254,7 +370,7 @@ func (x *TriggerRequestMessage) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TriggerRequestMessage.ProtoReflect.Descriptor instead.
 func (*TriggerRequestMessage) Descriptor() ([]byte, []int) {
-	return file_o5_trigger_v1_topic_trigger_p_j5s_proto_rawDescGZIP(), []int{3}
+	return file_o5_trigger_v1_topic_trigger_p_j5s_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *TriggerRequestMessage) GetRequest() *messaging_j5pb.RequestMetadata {
@@ -272,12 +388,17 @@ type TriggerReplyMessage struct {
 	Request *messaging_j5pb.RequestMetadata `protobuf:"bytes,1,opt,name=request,proto3" json:"request,omitempty"`
 	// The time the trigger is for
 	TickTime *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=tick_time,json=tickTime,proto3" json:"tick_time,omitempty"`
+	// The tick time was missed and is being delivered during catch-up, after
+	// the scheduled time.
+	Late bool `protobuf:"varint,3,opt,name=late,proto3" json:"late,omitempty"`
+	// The payload set on the trigger
+	Payload *any_j5t.Any `protobuf:"bytes,4,opt,name=payload,proto3,oneof" json:"payload,omitempty"`
 }
 
 func (x *TriggerReplyMessage) Reset() {
 	*x = TriggerReplyMessage{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_o5_trigger_v1_topic_trigger_p_j5s_proto_msgTypes[4]
+		mi := &file_o5_trigger_v1_topic_trigger_p_j5s_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -290,7 +411,7 @@ func (x *TriggerReplyMessage) String() string {
 func (*TriggerReplyMessage) ProtoMessage() {}
 
 func (x *TriggerReplyMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_o5_trigger_v1_topic_trigger_p_j5s_proto_msgTypes[4]
+	mi := &file_o5_trigger_v1_topic_trigger_p_j5s_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -303,7 +424,7 @@ func (x *TriggerReplyMessage) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TriggerReplyMessage.ProtoReflect.Descriptor instead.
 func (*TriggerReplyMessage) Descriptor() ([]byte, []int) {
-	return file_o5_trigger_v1_topic_trigger_p_j5s_proto_rawDescGZIP(), []int{4}
+	return file_o5_trigger_v1_topic_trigger_p_j5s_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *TriggerReplyMessage) GetRequest() *messaging_j5pb.RequestMetadata {
@@ -320,6 +441,20 @@ func (x *TriggerReplyMessage) GetTickTime() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *TriggerReplyMessage) GetLate() bool {
+	if x != nil {
+		return x.Late
+	}
+	return false
+}
+
+func (x *TriggerReplyMessage) GetPayload() *any_j5t.Any {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
 var File_o5_trigger_v1_topic_trigger_p_j5s_proto protoreflect.FileDescriptor
 
 var file_o5_trigger_v1_topic_trigger_p_j5s_proto_rawDesc = []byte{
@@ -340,122 +475,182 @@ var file_o5_trigger_v1_topic_trigger_p_j5s_proto_rawDesc = []byte{
 	0x73, 0x73, 0x61, 0x67, 0x69, 0x6e, 0x67, 0x2f, 0x76, 0x31, 0x2f, 0x72, 0x65, 0x71, 0x72, 0x65,
 	0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1a, 0x6a, 0x35, 0x2f, 0x73, 0x74, 0x61, 0x74,
 	0x65, 0x2f, 0x76, 0x31, 0x2f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x6f, 0x35, 0x2f, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x2f,
-	0x76, 0x31, 0x2f, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x2e, 0x6a, 0x35, 0x73, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x22, 0xfa, 0x02, 0x0a, 0x13, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72,
-	0x45, 0x76, 0x65, 0x6e, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x4c, 0x0a, 0x08,
-	0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21,
-	0x2e, 0x6a, 0x35, 0x2e, 0x73, 0x74, 0x61, 0x74, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x76, 0x65,
-	0x6e, 0x74, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
-	0x61, 0x42, 0x0d, 0xba, 0x48, 0x03, 0xc8, 0x01, 0x01, 0xc2, 0xff, 0x8e, 0x02, 0x02, 0x52, 0x00,
-	0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x3d, 0x0a, 0x04, 0x6b, 0x65,
-	0x79, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x6f, 0x35, 0x2e, 0x74, 0x72,
-	0x69, 0x67, 0x67, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72,
-	0x4b, 0x65, 0x79, 0x73, 0x42, 0x0d, 0xba, 0x48, 0x03, 0xc8, 0x01, 0x01, 0xc2, 0xff, 0x8e, 0x02,
-	0x02, 0x52, 0x00, 0x52, 0x04, 0x6b, 0x65, 0x79, 0x73, 0x12, 0x44, 0x0a, 0x05, 0x65, 0x76, 0x65,
-	0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6f, 0x35, 0x2e, 0x74, 0x72,
+	0x6f, 0x74, 0x6f, 0x1a, 0x19, 0x6a, 0x35, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2f, 0x61, 0x6e,
+	0x79, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x6e, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f,
+	0x6f, 0x35, 0x2f, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x2f, 0x76, 0x31, 0x2f, 0x74, 0x72,
+	0x69, 0x67, 0x67, 0x65, 0x72, 0x2e, 0x6a, 0x35, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22,
+	0xfa, 0x02, 0x0a, 0x13, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x4c, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64,
+	0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x6a, 0x35, 0x2e, 0x73,
+	0x74, 0x61, 0x74, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x50, 0x75, 0x62,
+	0x6c, 0x69, 0x73, 0x68, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x42, 0x0d, 0xba, 0x48,
+	0x03, 0xc8, 0x01, 0x01, 0xc2, 0xff, 0x8e, 0x02, 0x02, 0x52, 0x00, 0x52, 0x08, 0x6d, 0x65, 0x74,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x3d, 0x0a, 0x04, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x6f, 0x35, 0x2e, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72,
+	0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x4b, 0x65, 0x79, 0x73, 0x42,
+	0x0d, 0xba, 0x48, 0x03, 0xc8, 0x01, 0x01, 0xc2, 0xff, 0x8e, 0x02, 0x02, 0x52, 0x00, 0x52, 0x04,
+	0x6b, 0x65, 0x79, 0x73, 0x12, 0x44, 0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6f, 0x35, 0x2e, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72,
+	0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x54, 0x79, 0x70, 0x65, 0x42, 0x0d, 0xba, 0x48, 0x03, 0xc8, 0x01, 0x01, 0xc2, 0xff, 0x8e, 0x02,
+	0x02, 0x62, 0x00, 0x52, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x3d, 0x0a, 0x04, 0x64, 0x61,
+	0x74, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x6f, 0x35, 0x2e, 0x74, 0x72,
 	0x69, 0x67, 0x67, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72,
-	0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x42, 0x0d, 0xba, 0x48, 0x03, 0xc8, 0x01,
-	0x01, 0xc2, 0xff, 0x8e, 0x02, 0x02, 0x62, 0x00, 0x52, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x12,
-	0x3d, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
-	0x6f, 0x35, 0x2e, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72,
-	0x69, 0x67, 0x67, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x42, 0x0d, 0xba, 0x48, 0x03, 0xc8, 0x01,
-	0x01, 0xc2, 0xff, 0x8e, 0x02, 0x02, 0x52, 0x00, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x48,
-	0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1c,
-	0x2e, 0x6f, 0x35, 0x2e, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x54,
-	0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x42, 0x12, 0xba, 0x48,
-	0x08, 0xc8, 0x01, 0x01, 0x82, 0x01, 0x02, 0x10, 0x01, 0xc2, 0xff, 0x8e, 0x02, 0x02, 0x5a, 0x00,
-	0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x3a, 0x07, 0xc2, 0xff, 0x8e, 0x02, 0x02, 0x52,
-	0x00, 0x22, 0xb3, 0x01, 0x0a, 0x1b, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x4d, 0x61, 0x6e,
-	0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x44, 0x61, 0x74, 0x61, 0x42, 0x0d, 0xba, 0x48, 0x03, 0xc8, 0x01, 0x01, 0xc2, 0xff, 0x8e, 0x02,
+	0x02, 0x52, 0x00, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x48, 0x0a, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1c, 0x2e, 0x6f, 0x35, 0x2e, 0x74,
+	0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65,
+	0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x42, 0x12, 0xba, 0x48, 0x08, 0xc8, 0x01, 0x01, 0x82,
+	0x01, 0x02, 0x10, 0x01, 0xc2, 0xff, 0x8e, 0x02, 0x02, 0x5a, 0x00, 0x52, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x3a, 0x07, 0xc2, 0xff, 0x8e, 0x02, 0x02, 0x52, 0x00, 0x22, 0xff, 0x02, 0x0a,
+	0x14, 0x43, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x4d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x4c, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
+	0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x6a, 0x35, 0x2e, 0x73, 0x74, 0x61,
+	0x74, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x50, 0x75, 0x62, 0x6c, 0x69,
+	0x73, 0x68, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x42, 0x0d, 0xba, 0x48, 0x03, 0xc8,
+	0x01, 0x01, 0xc2, 0xff, 0x8e, 0x02, 0x02, 0x52, 0x00, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64,
+	0x61, 0x74, 0x61, 0x12, 0x3e, 0x0a, 0x04, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1b, 0x2e, 0x6f, 0x35, 0x2e, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x2e, 0x76,
+	0x31, 0x2e, 0x43, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x4b, 0x65, 0x79, 0x73, 0x42, 0x0d,
+	0xba, 0x48, 0x03, 0xc8, 0x01, 0x01, 0xc2, 0xff, 0x8e, 0x02, 0x02, 0x52, 0x00, 0x52, 0x04, 0x6b,
+	0x65, 0x79, 0x73, 0x12, 0x45, 0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6f, 0x35, 0x2e, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x2e,
+	0x76, 0x31, 0x2e, 0x43, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x54, 0x79, 0x70, 0x65, 0x42, 0x0d, 0xba, 0x48, 0x03, 0xc8, 0x01, 0x01, 0xc2, 0xff, 0x8e, 0x02,
+	0x02, 0x62, 0x00, 0x52, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x3e, 0x0a, 0x04, 0x64, 0x61,
+	0x74, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6f, 0x35, 0x2e, 0x74, 0x72,
+	0x69, 0x67, 0x67, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61,
+	0x72, 0x44, 0x61, 0x74, 0x61, 0x42, 0x0d, 0xba, 0x48, 0x03, 0xc8, 0x01, 0x01, 0xc2, 0xff, 0x8e,
+	0x02, 0x02, 0x52, 0x00, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x49, 0x0a, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x6f, 0x35, 0x2e,
+	0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x6c, 0x65, 0x6e,
+	0x64, 0x61, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x42, 0x12, 0xba, 0x48, 0x08, 0xc8, 0x01,
+	0x01, 0x82, 0x01, 0x02, 0x10, 0x01, 0xc2, 0xff, 0x8e, 0x02, 0x02, 0x5a, 0x00, 0x52, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x3a, 0x07, 0xc2, 0xff, 0x8e, 0x02, 0x02, 0x52, 0x00, 0x22, 0xb3,
+	0x01, 0x0a, 0x1b, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x49,
+	0x0a, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x20, 0x2e, 0x6a, 0x35, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x69, 0x6e, 0x67, 0x2e, 0x76,
+	0x31, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
+	0x61, 0x42, 0x0d, 0xba, 0x48, 0x03, 0xc8, 0x01, 0x01, 0xc2, 0xff, 0x8e, 0x02, 0x02, 0x52, 0x00,
+	0x52, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x40, 0x0a, 0x06, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6f, 0x35, 0x2e, 0x74,
+	0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x54, 0x79, 0x70, 0x65, 0x42, 0x0d, 0xba, 0x48, 0x03, 0xc8, 0x01, 0x01, 0xc2, 0xff, 0x8e, 0x02,
+	0x02, 0x62, 0x00, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x3a, 0x07, 0xc2, 0xff, 0x8e,
+	0x02, 0x02, 0x52, 0x00, 0x22, 0xd1, 0x03, 0x0a, 0x19, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72,
+	0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x12, 0x49, 0x0a, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6a, 0x35, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x69,
+	0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4d, 0x65, 0x74,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0x42, 0x0d, 0xba, 0x48, 0x03, 0xc8, 0x01, 0x01, 0xc2, 0xff, 0x8e,
+	0x02, 0x02, 0x52, 0x00, 0x52, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x46, 0x0a,
+	0x0a, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x22, 0xba, 0x48, 0x15, 0x72, 0x13, 0x32, 0x11, 0x5e, 0x5b, 0x30, 0x2d, 0x39, 0x41,
+	0x2d, 0x5a, 0x61, 0x2d, 0x7a, 0x5d, 0x7b, 0x32, 0x32, 0x7d, 0x24, 0xc2, 0xff, 0x8e, 0x02, 0x05,
+	0xb2, 0x02, 0x02, 0x08, 0x03, 0x48, 0x00, 0x52, 0x09, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72,
+	0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x48, 0x0a, 0x0b, 0x63, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61,
+	0x72, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x22, 0xba, 0x48, 0x15, 0x72,
+	0x13, 0x32, 0x11, 0x5e, 0x5b, 0x30, 0x2d, 0x39, 0x41, 0x2d, 0x5a, 0x61, 0x2d, 0x7a, 0x5d, 0x7b,
+	0x32, 0x32, 0x7d, 0x24, 0xc2, 0xff, 0x8e, 0x02, 0x05, 0xb2, 0x02, 0x02, 0x08, 0x03, 0x48, 0x01,
+	0x52, 0x0a, 0x63, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12,
+	0x22, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08,
+	0x42, 0x08, 0xc2, 0xff, 0x8e, 0x02, 0x03, 0x8a, 0x02, 0x00, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x12, 0x23, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x08, 0xc2, 0xff, 0x8e, 0x02, 0x03, 0xf2, 0x01, 0x00, 0x48, 0x02, 0x52, 0x05,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x88, 0x01, 0x01, 0x12, 0x4b, 0x0a, 0x0c, 0x6e, 0x65, 0x78, 0x74,
+	0x5f, 0x66, 0x69, 0x72, 0x65, 0x5f, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x08, 0xc2, 0xff, 0x8e, 0x02,
+	0x03, 0xaa, 0x02, 0x00, 0x48, 0x03, 0x52, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x46, 0x69, 0x72, 0x65,
+	0x41, 0x74, 0x88, 0x01, 0x01, 0x3a, 0x07, 0xc2, 0xff, 0x8e, 0x02, 0x02, 0x52, 0x00, 0x42, 0x0d,
+	0x0a, 0x0b, 0x5f, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x42, 0x0e, 0x0a,
+	0x0c, 0x5f, 0x63, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x5f, 0x69, 0x64, 0x42, 0x08, 0x0a,
+	0x06, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x6e, 0x65, 0x78, 0x74,
+	0x5f, 0x66, 0x69, 0x72, 0x65, 0x5f, 0x61, 0x74, 0x22, 0x6b, 0x0a, 0x15, 0x54, 0x72, 0x69, 0x67,
+	0x67, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
 	0x65, 0x12, 0x49, 0x0a, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01,
 	0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6a, 0x35, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x69, 0x6e,
 	0x67, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4d, 0x65, 0x74, 0x61,
 	0x64, 0x61, 0x74, 0x61, 0x42, 0x0d, 0xba, 0x48, 0x03, 0xc8, 0x01, 0x01, 0xc2, 0xff, 0x8e, 0x02,
-	0x02, 0x52, 0x00, 0x52, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x40, 0x0a, 0x06,
-	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6f,
-	0x35, 0x2e, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x42, 0x0d, 0xba, 0x48, 0x03, 0xc8, 0x01, 0x01, 0xc2,
-	0xff, 0x8e, 0x02, 0x02, 0x62, 0x00, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x3a, 0x07,
-	0xc2, 0xff, 0x8e, 0x02, 0x02, 0x52, 0x00, 0x22, 0xb8, 0x01, 0x0a, 0x19, 0x54, 0x72, 0x69, 0x67,
-	0x67, 0x65, 0x72, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x4d, 0x65,
-	0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x49, 0x0a, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6a, 0x35, 0x2e, 0x6d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x42, 0x0d, 0xba, 0x48, 0x03, 0xc8, 0x01, 0x01,
-	0xc2, 0xff, 0x8e, 0x02, 0x02, 0x52, 0x00, 0x52, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x47, 0x0a, 0x09, 0x74, 0x69, 0x63, 0x6b, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42,
-	0x0e, 0xba, 0x48, 0x03, 0xc8, 0x01, 0x01, 0xc2, 0xff, 0x8e, 0x02, 0x03, 0xaa, 0x02, 0x00, 0x52,
-	0x08, 0x74, 0x69, 0x63, 0x6b, 0x54, 0x69, 0x6d, 0x65, 0x3a, 0x07, 0xc2, 0xff, 0x8e, 0x02, 0x02,
-	0x52, 0x00, 0x22, 0x6b, 0x0a, 0x15, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x49, 0x0a, 0x07, 0x72,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6a,
-	0x35, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x42, 0x0d,
-	0xba, 0x48, 0x03, 0xc8, 0x01, 0x01, 0xc2, 0xff, 0x8e, 0x02, 0x02, 0x52, 0x00, 0x52, 0x07, 0x72,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x3a, 0x07, 0xc2, 0xff, 0x8e, 0x02, 0x02, 0x52, 0x00, 0x22,
-	0xb2, 0x01, 0x0a, 0x13, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x52, 0x65, 0x70, 0x6c, 0x79,
-	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x49, 0x0a, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6a, 0x35, 0x2e, 0x6d, 0x65,
-	0x73, 0x73, 0x61, 0x67, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x42, 0x0d, 0xba, 0x48, 0x03, 0xc8,
-	0x01, 0x01, 0xc2, 0xff, 0x8e, 0x02, 0x02, 0x52, 0x00, 0x52, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x47, 0x0a, 0x09, 0x74, 0x69, 0x63, 0x6b, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
-	0x70, 0x42, 0x0e, 0xba, 0x48, 0x03, 0xc8, 0x01, 0x01, 0xc2, 0xff, 0x8e, 0x02, 0x03, 0xaa, 0x02,
-	0x00, 0x52, 0x08, 0x74, 0x69, 0x63, 0x6b, 0x54, 0x69, 0x6d, 0x65, 0x3a, 0x07, 0xc2, 0xff, 0x8e,
-	0x02, 0x02, 0x52, 0x00, 0x32, 0x99, 0x01, 0x0a, 0x13, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72,
-	0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x12, 0x50, 0x0a, 0x0c,
-	0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x28, 0x2e, 0x6f,
-	0x35, 0x2e, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x74, 0x6f, 0x70,
-	0x69, 0x63, 0x2e, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x4d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x30,
-	0xda, 0xa2, 0xf5, 0xe4, 0x02, 0x2a, 0x0a, 0x0f, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x5f,
-	0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x6a, 0x17, 0x0a, 0x15, 0x6f, 0x35, 0x2e, 0x74, 0x72,
-	0x69, 0x67, 0x67, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72,
-	0x32, 0x97, 0x01, 0x0a, 0x19, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x4d, 0x61, 0x6e, 0x61,
-	0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x12, 0x60,
-	0x0a, 0x14, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x30, 0x2e, 0x6f, 0x35, 0x2e, 0x74, 0x72, 0x69, 0x67,
+	0x02, 0x52, 0x00, 0x52, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x3a, 0x07, 0xc2, 0xff,
+	0x8e, 0x02, 0x02, 0x52, 0x00, 0x22, 0x9a, 0x02, 0x0a, 0x13, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65,
+	0x72, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x49, 0x0a,
+	0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20,
+	0x2e, 0x6a, 0x35, 0x2e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31,
+	0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0x42, 0x0d, 0xba, 0x48, 0x03, 0xc8, 0x01, 0x01, 0xc2, 0xff, 0x8e, 0x02, 0x02, 0x52, 0x00, 0x52,
+	0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x47, 0x0a, 0x09, 0x74, 0x69, 0x63, 0x6b,
+	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x0e, 0xba, 0x48, 0x03, 0xc8, 0x01, 0x01, 0xc2,
+	0xff, 0x8e, 0x02, 0x03, 0xaa, 0x02, 0x00, 0x52, 0x08, 0x74, 0x69, 0x63, 0x6b, 0x54, 0x69, 0x6d,
+	0x65, 0x12, 0x1c, 0x0a, 0x04, 0x6c, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x42,
+	0x08, 0xc2, 0xff, 0x8e, 0x02, 0x03, 0x8a, 0x02, 0x00, 0x52, 0x04, 0x6c, 0x61, 0x74, 0x65, 0x12,
+	0x3c, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x14, 0x2e, 0x6a, 0x35, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x61, 0x6e, 0x79, 0x2e,
+	0x76, 0x31, 0x2e, 0x41, 0x6e, 0x79, 0x42, 0x07, 0xc2, 0xff, 0x8e, 0x02, 0x02, 0x4a, 0x00, 0x48,
+	0x00, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x88, 0x01, 0x01, 0x3a, 0x07, 0xc2,
+	0xff, 0x8e, 0x02, 0x02, 0x52, 0x00, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x70, 0x61, 0x79, 0x6c, 0x6f,
+	0x61, 0x64, 0x32, 0x99, 0x01, 0x0a, 0x13, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x50, 0x75,
+	0x62, 0x6c, 0x69, 0x73, 0x68, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x12, 0x50, 0x0a, 0x0c, 0x54, 0x72,
+	0x69, 0x67, 0x67, 0x65, 0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x28, 0x2e, 0x6f, 0x35, 0x2e,
+	0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x74, 0x6f, 0x70, 0x69, 0x63,
+	0x2e, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x30, 0xda, 0xa2,
+	0xf5, 0xe4, 0x02, 0x2a, 0x0a, 0x0f, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x5f, 0x70, 0x75,
+	0x62, 0x6c, 0x69, 0x73, 0x68, 0x6a, 0x17, 0x0a, 0x15, 0x6f, 0x35, 0x2e, 0x74, 0x72, 0x69, 0x67,
+	0x67, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x32, 0x9e,
+	0x01, 0x0a, 0x14, 0x43, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x50, 0x75, 0x62, 0x6c, 0x69,
+	0x73, 0x68, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x12, 0x52, 0x0a, 0x0d, 0x43, 0x61, 0x6c, 0x65, 0x6e,
+	0x64, 0x61, 0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x29, 0x2e, 0x6f, 0x35, 0x2e, 0x74, 0x72,
+	0x69, 0x67, 0x67, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x2e, 0x43,
+	0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x32, 0xda, 0xa2, 0xf5,
+	0xe4, 0x02, 0x2c, 0x0a, 0x10, 0x63, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x5f, 0x70, 0x75,
+	0x62, 0x6c, 0x69, 0x73, 0x68, 0x6a, 0x18, 0x0a, 0x16, 0x6f, 0x35, 0x2e, 0x74, 0x72, 0x69, 0x67,
+	0x67, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x32,
+	0x97, 0x01, 0x0a, 0x19, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x4d, 0x61, 0x6e, 0x61, 0x67,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x12, 0x60, 0x0a,
+	0x14, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x30, 0x2e, 0x6f, 0x35, 0x2e, 0x74, 0x72, 0x69, 0x67, 0x67,
+	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x2e, 0x54, 0x72, 0x69, 0x67,
+	0x67, 0x65, 0x72, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a,
+	0x18, 0xda, 0xa2, 0xf5, 0xe4, 0x02, 0x12, 0x0a, 0x0e, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72,
+	0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x5a, 0x00, 0x32, 0x91, 0x01, 0x0a, 0x17, 0x54, 0x72,
+	0x69, 0x67, 0x67, 0x65, 0x72, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79,
+	0x54, 0x6f, 0x70, 0x69, 0x63, 0x12, 0x5c, 0x0a, 0x12, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72,
+	0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x2e, 0x2e, 0x6f, 0x35,
+	0x2e, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x74, 0x6f, 0x70, 0x69,
+	0x63, 0x2e, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x52,
+	0x65, 0x70, 0x6c, 0x79, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x1a, 0x16, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d,
+	0x70, 0x74, 0x79, 0x1a, 0x18, 0xda, 0xa2, 0xf5, 0xe4, 0x02, 0x12, 0x0a, 0x0e, 0x74, 0x72, 0x69,
+	0x67, 0x67, 0x65, 0x72, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x62, 0x00, 0x32, 0x7e, 0x0a,
+	0x13, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x54,
+	0x6f, 0x70, 0x69, 0x63, 0x12, 0x54, 0x0a, 0x0e, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2a, 0x2e, 0x6f, 0x35, 0x2e, 0x74, 0x72, 0x69, 0x67,
 	0x67, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x2e, 0x54, 0x72, 0x69,
-	0x67, 0x67, 0x65, 0x72, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
-	0x1a, 0x18, 0xda, 0xa2, 0xf5, 0xe4, 0x02, 0x12, 0x0a, 0x0e, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65,
-	0x72, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x5a, 0x00, 0x32, 0x91, 0x01, 0x0a, 0x17, 0x54,
-	0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x52, 0x65, 0x70, 0x6c,
-	0x79, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x12, 0x5c, 0x0a, 0x12, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65,
-	0x72, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x2e, 0x2e, 0x6f,
-	0x35, 0x2e, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x74, 0x6f, 0x70,
-	0x69, 0x63, 0x2e, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65,
+	0x67, 0x67, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x11, 0xda, 0xa2, 0xf5, 0xe4,
+	0x02, 0x0b, 0x0a, 0x07, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x5a, 0x00, 0x32, 0x78, 0x0a,
+	0x11, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x54, 0x6f, 0x70,
+	0x69, 0x63, 0x12, 0x50, 0x0a, 0x0c, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x52, 0x65, 0x70,
+	0x6c, 0x79, 0x12, 0x28, 0x2e, 0x6f, 0x35, 0x2e, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x2e,
+	0x76, 0x31, 0x2e, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x2e, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72,
 	0x52, 0x65, 0x70, 0x6c, 0x79, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x1a, 0x16, 0x2e, 0x67,
 	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45,
-	0x6d, 0x70, 0x74, 0x79, 0x1a, 0x18, 0xda, 0xa2, 0xf5, 0xe4, 0x02, 0x12, 0x0a, 0x0e, 0x74, 0x72,
-	0x69, 0x67, 0x67, 0x65, 0x72, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x62, 0x00, 0x32, 0x7e,
-	0x0a, 0x13, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x54, 0x6f, 0x70, 0x69, 0x63, 0x12, 0x54, 0x0a, 0x0e, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2a, 0x2e, 0x6f, 0x35, 0x2e, 0x74, 0x72, 0x69,
-	0x67, 0x67, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x2e, 0x54, 0x72,
-	0x69, 0x67, 0x67, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x11, 0xda, 0xa2, 0xf5,
-	0xe4, 0x02, 0x0b, 0x0a, 0x07, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x5a, 0x00, 0x32, 0x78,
-	0x0a, 0x11, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x54, 0x6f,
-	0x70, 0x69, 0x63, 0x12, 0x50, 0x0a, 0x0c, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x52, 0x65,
-	0x70, 0x6c, 0x79, 0x12, 0x28, 0x2e, 0x6f, 0x35, 0x2e, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72,
-	0x2e, 0x76, 0x31, 0x2e, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x2e, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65,
-	0x72, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x1a, 0x16, 0x2e,
-	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
-	0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x11, 0xda, 0xa2, 0xf5, 0xe4, 0x02, 0x0b, 0x0a, 0x07, 0x74,
-	0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x62, 0x00, 0x42, 0x3a, 0x5a, 0x38, 0x67, 0x69, 0x74, 0x68,
-	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x70, 0x65, 0x6e, 0x74, 0x6f, 0x70, 0x73, 0x2f, 0x74,
-	0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x6f, 0x35, 0x2f, 0x74, 0x72,
-	0x69, 0x67, 0x67, 0x65, 0x72, 0x2f, 0x76, 0x31, 0x2f, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72,
-	0x5f, 0x74, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x6d, 0x70, 0x74, 0x79, 0x1a, 0x11, 0xda, 0xa2, 0xf5, 0xe4, 0x02, 0x0b, 0x0a, 0x07, 0x74, 0x72,
+	0x69, 0x67, 0x67, 0x65, 0x72, 0x62, 0x00, 0x42, 0x3a, 0x5a, 0x38, 0x67, 0x69, 0x74, 0x68, 0x75,
+	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x70, 0x65, 0x6e, 0x74, 0x6f, 0x70, 0x73, 0x2f, 0x74, 0x72,
+	0x69, 0x67, 0x67, 0x65, 0x72, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x6f, 0x35, 0x2f, 0x74, 0x72, 0x69,
+	0x67, 0x67, 0x65, 0x72, 0x2f, 0x76, 0x31, 0x2f, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x5f,
+	0x74, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -470,51 +665,65 @@ func file_o5_trigger_v1_topic_trigger_p_j5s_proto_rawDescGZIP() []byte {
 	return file_o5_trigger_v1_topic_trigger_p_j5s_proto_rawDescData
 }
 
-var file_o5_trigger_v1_topic_trigger_p_j5s_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_o5_trigger_v1_topic_trigger_p_j5s_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
 var file_o5_trigger_v1_topic_trigger_p_j5s_proto_goTypes = []interface{}{
 	(*TriggerEventMessage)(nil),            // 0: o5.trigger.v1.topic.TriggerEventMessage
-	(*TriggerManageRequestMessage)(nil),    // 1: o5.trigger.v1.topic.TriggerManageRequestMessage
-	(*TriggerManageReplyMessage)(nil),      // 2: o5.trigger.v1.topic.TriggerManageReplyMessage
-	(*TriggerRequestMessage)(nil),          // 3: o5.trigger.v1.topic.TriggerRequestMessage
-	(*TriggerReplyMessage)(nil),            // 4: o5.trigger.v1.topic.TriggerReplyMessage
-	(*psm_j5pb.EventPublishMetadata)(nil),  // 5: j5.state.v1.EventPublishMetadata
-	(*trigger_pb.TriggerKeys)(nil),         // 6: o5.trigger.v1.TriggerKeys
-	(*trigger_pb.TriggerEventType)(nil),    // 7: o5.trigger.v1.TriggerEventType
-	(*trigger_pb.TriggerData)(nil),         // 8: o5.trigger.v1.TriggerData
-	(trigger_pb.TriggerStatus)(0),          // 9: o5.trigger.v1.TriggerStatus
-	(*messaging_j5pb.RequestMetadata)(nil), // 10: j5.messaging.v1.RequestMetadata
-	(*trigger_pb.ActionType)(nil),          // 11: o5.trigger.v1.ActionType
-	(*timestamppb.Timestamp)(nil),          // 12: google.protobuf.Timestamp
-	(*emptypb.Empty)(nil),                  // 13: google.protobuf.Empty
+	(*CalendarEventMessage)(nil),           // 1: o5.trigger.v1.topic.CalendarEventMessage
+	(*TriggerManageRequestMessage)(nil),    // 2: o5.trigger.v1.topic.TriggerManageRequestMessage
+	(*TriggerManageReplyMessage)(nil),      // 3: o5.trigger.v1.topic.TriggerManageReplyMessage
+	(*TriggerRequestMessage)(nil),          // 4: o5.trigger.v1.topic.TriggerRequestMessage
+	(*TriggerReplyMessage)(nil),            // 5: o5.trigger.v1.topic.TriggerReplyMessage
+	(*psm_j5pb.EventPublishMetadata)(nil),  // 6: j5.state.v1.EventPublishMetadata
+	(*trigger_pb.TriggerKeys)(nil),         // 7: o5.trigger.v1.TriggerKeys
+	(*trigger_pb.TriggerEventType)(nil),    // 8: o5.trigger.v1.TriggerEventType
+	(*trigger_pb.TriggerData)(nil),         // 9: o5.trigger.v1.TriggerData
+	(trigger_pb.TriggerStatus)(0),          // 10: o5.trigger.v1.TriggerStatus
+	(*trigger_pb.CalendarKeys)(nil),        // 11: o5.trigger.v1.CalendarKeys
+	(*trigger_pb.CalendarEventType)(nil),   // 12: o5.trigger.v1.CalendarEventType
+	(*trigger_pb.CalendarData)(nil),        // 13: o5.trigger.v1.CalendarData
+	(trigger_pb.CalendarStatus)(0),         // 14: o5.trigger.v1.CalendarStatus
+	(*messaging_j5pb.RequestMetadata)(nil), // 15: j5.messaging.v1.RequestMetadata
+	(*trigger_pb.ActionType)(nil),          // 16: o5.trigger.v1.ActionType
+	(*timestamppb.Timestamp)(nil),          // 17: google.protobuf.Timestamp
+	(*any_j5t.Any)(nil),                    // 18: j5.types.any.v1.Any
+	(*emptypb.Empty)(nil),                  // 19: google.protobuf.Empty
 }
 var file_o5_trigger_v1_topic_trigger_p_j5s_proto_depIdxs = []int32{
-	5,  // 0: o5.trigger.v1.topic.TriggerEventMessage.metadata:type_name -> j5.state.v1.EventPublishMetadata
-	6,  // 1: o5.trigger.v1.topic.TriggerEventMessage.keys:type_name -> o5.trigger.v1.TriggerKeys
-	7,  // 2: o5.trigger.v1.topic.TriggerEventMessage.event:type_name -> o5.trigger.v1.TriggerEventType
-	8,  // 3: o5.trigger.v1.topic.TriggerEventMessage.data:type_name -> o5.trigger.v1.TriggerData
-	9,  // 4: o5.trigger.v1.topic.TriggerEventMessage.status:type_name -> o5.trigger.v1.TriggerStatus
-	10, // 5: o5.trigger.v1.topic.TriggerManageRequestMessage.request:type_name -> j5.messaging.v1.RequestMetadata
-	11, // 6: o5.trigger.v1.topic.TriggerManageRequestMessage.action:type_name -> o5.trigger.v1.ActionType
-	10, // 7: o5.trigger.v1.topic.TriggerManageReplyMessage.request:type_name -> j5.messaging.v1.RequestMetadata
-	12, // 8: o5.trigger.v1.topic.TriggerManageReplyMessage.tick_time:type_name -> google.protobuf.Timestamp
-	10, // 9: o5.trigger.v1.topic.TriggerRequestMessage.request:type_name -> j5.messaging.v1.RequestMetadata
-	10, // 10: o5.trigger.v1.topic.TriggerReplyMessage.request:type_name -> j5.messaging.v1.RequestMetadata
-	12, // 11: o5.trigger.v1.topic.TriggerReplyMessage.tick_time:type_name -> google.protobuf.Timestamp
-	0,  // 12: o5.trigger.v1.topic.TriggerPublishTopic.TriggerEvent:input_type -> o5.trigger.v1.topic.TriggerEventMessage
-	1,  // 13: o5.trigger.v1.topic.TriggerManageRequestTopic.TriggerManageRequest:input_type -> o5.trigger.v1.topic.TriggerManageRequestMessage
-	2,  // 14: o5.trigger.v1.topic.TriggerManageReplyTopic.TriggerManageReply:input_type -> o5.trigger.v1.topic.TriggerManageReplyMessage
-	3,  // 15: o5.trigger.v1.topic.TriggerRequestTopic.TriggerRequest:input_type -> o5.trigger.v1.topic.TriggerRequestMessage
-	4,  // 16: o5.trigger.v1.topic.TriggerReplyTopic.TriggerReply:input_type -> o5.trigger.v1.topic.TriggerReplyMessage
-	13, // 17: o5.trigger.v1.topic.TriggerPublishTopic.TriggerEvent:output_type -> google.protobuf.Empty
-	13, // 18: o5.trigger.v1.topic.TriggerManageRequestTopic.TriggerManageRequest:output_type -> google.protobuf.Empty
-	13, // 19: o5.trigger.v1.topic.TriggerManageReplyTopic.TriggerManageReply:output_type -> google.protobuf.Empty
-	13, // 20: o5.trigger.v1.topic.TriggerRequestTopic.TriggerRequest:output_type -> google.protobuf.Empty
-	13, // 21: o5.trigger.v1.topic.TriggerReplyTopic.TriggerReply:output_type -> google.protobuf.Empty
-	17, // [17:22] is the sub-list for method output_type
-	12, // [12:17] is the sub-list for method input_type
-	12, // [12:12] is the sub-list for extension type_name
-	12, // [12:12] is the sub-list for extension extendee
-	0,  // [0:12] is the sub-list for field type_name
+	6,  // 0: o5.trigger.v1.topic.TriggerEventMessage.metadata:type_name -> j5.state.v1.EventPublishMetadata
+	7,  // 1: o5.trigger.v1.topic.TriggerEventMessage.keys:type_name -> o5.trigger.v1.TriggerKeys
+	8,  // 2: o5.trigger.v1.topic.TriggerEventMessage.event:type_name -> o5.trigger.v1.TriggerEventType
+	9,  // 3: o5.trigger.v1.topic.TriggerEventMessage.data:type_name -> o5.trigger.v1.TriggerData
+	10, // 4: o5.trigger.v1.topic.TriggerEventMessage.status:type_name -> o5.trigger.v1.TriggerStatus
+	6,  // 5: o5.trigger.v1.topic.CalendarEventMessage.metadata:type_name -> j5.state.v1.EventPublishMetadata
+	11, // 6: o5.trigger.v1.topic.CalendarEventMessage.keys:type_name -> o5.trigger.v1.CalendarKeys
+	12, // 7: o5.trigger.v1.topic.CalendarEventMessage.event:type_name -> o5.trigger.v1.CalendarEventType
+	13, // 8: o5.trigger.v1.topic.CalendarEventMessage.data:type_name -> o5.trigger.v1.CalendarData
+	14, // 9: o5.trigger.v1.topic.CalendarEventMessage.status:type_name -> o5.trigger.v1.CalendarStatus
+	15, // 10: o5.trigger.v1.topic.TriggerManageRequestMessage.request:type_name -> j5.messaging.v1.RequestMetadata
+	16, // 11: o5.trigger.v1.topic.TriggerManageRequestMessage.action:type_name -> o5.trigger.v1.ActionType
+	15, // 12: o5.trigger.v1.topic.TriggerManageReplyMessage.request:type_name -> j5.messaging.v1.RequestMetadata
+	17, // 13: o5.trigger.v1.topic.TriggerManageReplyMessage.next_fire_at:type_name -> google.protobuf.Timestamp
+	15, // 14: o5.trigger.v1.topic.TriggerRequestMessage.request:type_name -> j5.messaging.v1.RequestMetadata
+	15, // 15: o5.trigger.v1.topic.TriggerReplyMessage.request:type_name -> j5.messaging.v1.RequestMetadata
+	17, // 16: o5.trigger.v1.topic.TriggerReplyMessage.tick_time:type_name -> google.protobuf.Timestamp
+	18, // 17: o5.trigger.v1.topic.TriggerReplyMessage.payload:type_name -> j5.types.any.v1.Any
+	0,  // 18: o5.trigger.v1.topic.TriggerPublishTopic.TriggerEvent:input_type -> o5.trigger.v1.topic.TriggerEventMessage
+	1,  // 19: o5.trigger.v1.topic.CalendarPublishTopic.CalendarEvent:input_type -> o5.trigger.v1.topic.CalendarEventMessage
+	2,  // 20: o5.trigger.v1.topic.TriggerManageRequestTopic.TriggerManageRequest:input_type -> o5.trigger.v1.topic.TriggerManageRequestMessage
+	3,  // 21: o5.trigger.v1.topic.TriggerManageReplyTopic.TriggerManageReply:input_type -> o5.trigger.v1.topic.TriggerManageReplyMessage
+	4,  // 22: o5.trigger.v1.topic.TriggerRequestTopic.TriggerRequest:input_type -> o5.trigger.v1.topic.TriggerRequestMessage
+	5,  // 23: o5.trigger.v1.topic.TriggerReplyTopic.TriggerReply:input_type -> o5.trigger.v1.topic.TriggerReplyMessage
+	19, // 24: o5.trigger.v1.topic.TriggerPublishTopic.TriggerEvent:output_type -> google.protobuf.Empty
+	19, // 25: o5.trigger.v1.topic.CalendarPublishTopic.CalendarEvent:output_type -> google.protobuf.Empty
+	19, // 26: o5.trigger.v1.topic.TriggerManageRequestTopic.TriggerManageRequest:output_type -> google.protobuf.Empty
+	19, // 27: o5.trigger.v1.topic.TriggerManageReplyTopic.TriggerManageReply:output_type -> google.protobuf.Empty
+	19, // 28: o5.trigger.v1.topic.TriggerRequestTopic.TriggerRequest:output_type -> google.protobuf.Empty
+	19, // 29: o5.trigger.v1.topic.TriggerReplyTopic.TriggerReply:output_type -> google.protobuf.Empty
+	24, // [24:30] is the sub-list for method output_type
+	18, // [18:24] is the sub-list for method input_type
+	18, // [18:18] is the sub-list for extension type_name
+	18, // [18:18] is the sub-list for extension extendee
+	0,  // [0:18] is the sub-list for field type_name
 }
 
 func init() { file_o5_trigger_v1_topic_trigger_p_j5s_proto_init() }
@@ -536,7 +745,7 @@ func file_o5_trigger_v1_topic_trigger_p_j5s_proto_init() {
 			}
 		}
 		file_o5_trigger_v1_topic_trigger_p_j5s_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TriggerManageRequestMessage); i {
+			switch v := v.(*CalendarEventMessage); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -548,7 +757,7 @@ func file_o5_trigger_v1_topic_trigger_p_j5s_proto_init() {
 			}
 		}
 		file_o5_trigger_v1_topic_trigger_p_j5s_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TriggerManageReplyMessage); i {
+			switch v := v.(*TriggerManageRequestMessage); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -560,7 +769,7 @@ func file_o5_trigger_v1_topic_trigger_p_j5s_proto_init() {
 			}
 		}
 		file_o5_trigger_v1_topic_trigger_p_j5s_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TriggerRequestMessage); i {
+			switch v := v.(*TriggerManageReplyMessage); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -572,6 +781,18 @@ func file_o5_trigger_v1_topic_trigger_p_j5s_proto_init() {
 			}
 		}
 		file_o5_trigger_v1_topic_trigger_p_j5s_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TriggerRequestMessage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_o5_trigger_v1_topic_trigger_p_j5s_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*TriggerReplyMessage); i {
 			case 0:
 				return &v.state
@@ -584,15 +805,17 @@ func file_o5_trigger_v1_topic_trigger_p_j5s_proto_init() {
 			}
 		}
 	}
+	file_o5_trigger_v1_topic_trigger_p_j5s_proto_msgTypes[3].OneofWrappers = []interface{}{}
+	file_o5_trigger_v1_topic_trigger_p_j5s_proto_msgTypes[5].OneofWrappers = []interface{}{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_o5_trigger_v1_topic_trigger_p_j5s_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   5,
+			NumMessages:   6,
 			NumExtensions: 0,
-			NumServices:   5,
+			NumServices:   6,
 		},
 		GoTypes:           file_o5_trigger_v1_topic_trigger_p_j5s_proto_goTypes,
 		DependencyIndexes: file_o5_trigger_v1_topic_trigger_p_j5s_proto_depIdxs,
@@ -109,6 +109,96 @@ var TriggerPublishTopic_ServiceDesc = grpc.ServiceDesc{
 	Metadata: "o5/trigger/v1/topic/trigger.p.j5s.proto",
 }
 
+const (
+	CalendarPublishTopic_CalendarEvent_FullMethodName = "/o5.trigger.v1.topic.CalendarPublishTopic/CalendarEvent"
+)
+
+// CalendarPublishTopicClient is the client API for CalendarPublishTopic service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type CalendarPublishTopicClient interface {
+	CalendarEvent(ctx context.Context, in *CalendarEventMessage, opts ...grpc.CallOption) (*emptypb.Empty, error)
+}
+
+type calendarPublishTopicClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewCalendarPublishTopicClient(cc grpc.ClientConnInterface) CalendarPublishTopicClient {
+	return &calendarPublishTopicClient{cc}
+}
+
+func (c *calendarPublishTopicClient) CalendarEvent(ctx context.Context, in *CalendarEventMessage, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, CalendarPublishTopic_CalendarEvent_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// CalendarPublishTopicServer is the server API for CalendarPublishTopic service.
+// All implementations must embed UnimplementedCalendarPublishTopicServer
+// for forward compatibility
+type CalendarPublishTopicServer interface {
+	CalendarEvent(context.Context, *CalendarEventMessage) (*emptypb.Empty, error)
+	mustEmbedUnimplementedCalendarPublishTopicServer()
+}
+
+// UnimplementedCalendarPublishTopicServer must be embedded to have forward compatible implementations.
+type UnimplementedCalendarPublishTopicServer struct {
+}
+
+func (UnimplementedCalendarPublishTopicServer) CalendarEvent(context.Context, *CalendarEventMessage) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CalendarEvent not implemented")
+}
+func (UnimplementedCalendarPublishTopicServer) mustEmbedUnimplementedCalendarPublishTopicServer() {}
+
+// UnsafeCalendarPublishTopicServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to CalendarPublishTopicServer will
+// result in compilation errors.
+type UnsafeCalendarPublishTopicServer interface {
+	mustEmbedUnimplementedCalendarPublishTopicServer()
+}
+
+func RegisterCalendarPublishTopicServer(s grpc.ServiceRegistrar, srv CalendarPublishTopicServer) {
+	s.RegisterService(&CalendarPublishTopic_ServiceDesc, srv)
+}
+
+func _CalendarPublishTopic_CalendarEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CalendarEventMessage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CalendarPublishTopicServer).CalendarEvent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CalendarPublishTopic_CalendarEvent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CalendarPublishTopicServer).CalendarEvent(ctx, req.(*CalendarEventMessage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// CalendarPublishTopic_ServiceDesc is the grpc.ServiceDesc for CalendarPublishTopic service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var CalendarPublishTopic_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "o5.trigger.v1.topic.CalendarPublishTopic",
+	HandlerType: (*CalendarPublishTopicServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CalendarEvent",
+			Handler:    _CalendarPublishTopic_CalendarEvent_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "o5/trigger/v1/topic/trigger.p.j5s.proto",
+}
+
 const (
 	TriggerManageRequestTopic_TriggerManageRequest_FullMethodName = "/o5.trigger.v1.topic.TriggerManageRequestTopic/TriggerManageRequest"
 )
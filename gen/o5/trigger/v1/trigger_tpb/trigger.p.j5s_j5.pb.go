@@ -18,6 +18,17 @@ func (msg *TriggerEventMessage) J5Object() j5reflect.Object {
 	return j5reflect.MustReflect(msg.ProtoReflect()).(j5reflect.Object)
 }
 
+func (msg *CalendarEventMessage) Clone() any {
+	return proto.Clone(msg).(*CalendarEventMessage)
+}
+func (msg *CalendarEventMessage) J5Reflect() j5reflect.Root {
+	return j5reflect.MustReflect(msg.ProtoReflect())
+}
+
+func (msg *CalendarEventMessage) J5Object() j5reflect.Object {
+	return j5reflect.MustReflect(msg.ProtoReflect()).(j5reflect.Object)
+}
+
 func (msg *TriggerManageRequestMessage) Clone() any {
 	return proto.Clone(msg).(*TriggerManageRequestMessage)
 }
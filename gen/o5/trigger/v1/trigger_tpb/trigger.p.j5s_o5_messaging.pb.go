@@ -95,6 +95,89 @@ func (publish TriggerPublishTopicPublisher) TriggerEvent(ctx context.Context, ms
 	return publish.publisher.Publish(ctx, msg)
 }
 
+// Service: CalendarPublishTopic
+// Method: CalendarEvent
+
+func (msg *CalendarEventMessage) O5MessageHeader() o5msg.Header {
+	header := o5msg.Header{
+		GrpcService:      "o5.trigger.v1.topic.CalendarPublishTopic",
+		GrpcMethod:       "CalendarEvent",
+		Headers:          map[string]string{},
+		DestinationTopic: "calendar_publish",
+	}
+	header.Extension = &messaging_pb.Message_Event_{
+		Event: &messaging_pb.Message_Event{
+			EntityName: "o5.trigger.v1.Calendar",
+		},
+	}
+	return header
+}
+
+type CalendarPublishTopicTxSender[C any] struct {
+	sender o5msg.TxSender[C]
+}
+
+func NewCalendarPublishTopicTxSender[C any](sender o5msg.TxSender[C]) *CalendarPublishTopicTxSender[C] {
+	sender.Register(o5msg.TopicDescriptor{
+		Service: "o5.trigger.v1.topic.CalendarPublishTopic",
+		Methods: []o5msg.MethodDescriptor{
+			{
+				Name:    "CalendarEvent",
+				Message: (*CalendarEventMessage).ProtoReflect(nil).Descriptor(),
+			},
+		},
+	})
+	return &CalendarPublishTopicTxSender[C]{sender: sender}
+}
+
+type CalendarPublishTopicCollector[C any] struct {
+	collector o5msg.Collector[C]
+}
+
+func NewCalendarPublishTopicCollector[C any](collector o5msg.Collector[C]) *CalendarPublishTopicCollector[C] {
+	collector.Register(o5msg.TopicDescriptor{
+		Service: "o5.trigger.v1.topic.CalendarPublishTopic",
+		Methods: []o5msg.MethodDescriptor{
+			{
+				Name:    "CalendarEvent",
+				Message: (*CalendarEventMessage).ProtoReflect(nil).Descriptor(),
+			},
+		},
+	})
+	return &CalendarPublishTopicCollector[C]{collector: collector}
+}
+
+type CalendarPublishTopicPublisher struct {
+	publisher o5msg.Publisher
+}
+
+func NewCalendarPublishTopicPublisher(publisher o5msg.Publisher) *CalendarPublishTopicPublisher {
+	publisher.Register(o5msg.TopicDescriptor{
+		Service: "o5.trigger.v1.topic.CalendarPublishTopic",
+		Methods: []o5msg.MethodDescriptor{
+			{
+				Name:    "CalendarEvent",
+				Message: (*CalendarEventMessage).ProtoReflect(nil).Descriptor(),
+			},
+		},
+	})
+	return &CalendarPublishTopicPublisher{publisher: publisher}
+}
+
+// Method: CalendarEvent
+
+func (send CalendarPublishTopicTxSender[C]) CalendarEvent(ctx context.Context, sendContext C, msg *CalendarEventMessage) error {
+	return send.sender.Send(ctx, sendContext, msg)
+}
+
+func (collect CalendarPublishTopicCollector[C]) CalendarEvent(sendContext C, msg *CalendarEventMessage) {
+	collect.collector.Collect(sendContext, msg)
+}
+
+func (publish CalendarPublishTopicPublisher) CalendarEvent(ctx context.Context, msg *CalendarEventMessage) error {
+	return publish.publisher.Publish(ctx, msg)
+}
+
 // Service: TriggerManageRequestTopic
 // Expose Request Metadata
 func (msg *TriggerManageRequestMessage) SetJ5RequestMetadata(md *messaging_j5pb.RequestMetadata) {
@@ -33,3 +33,29 @@ func PublishTrigger() psm.GeneralEventHook[
 		return nil
 	})
 }
+
+// Publish Toipc for o5.trigger.v1.Calendar
+func PublishCalendar() psm.GeneralEventHook[
+	*trigger_pb.CalendarKeys,    // implements psm.IKeyset
+	*trigger_pb.CalendarState,   // implements psm.IState
+	trigger_pb.CalendarStatus,   // implements psm.IStatusEnum
+	*trigger_pb.CalendarData,    // implements psm.IStateData
+	*trigger_pb.CalendarEvent,   // implements psm.IEvent
+	trigger_pb.CalendarPSMEvent, // implements psm.IInnerEvent
+] {
+	return trigger_pb.CalendarPSMEventPublishHook(func(
+		ctx context.Context,
+		publisher psm.Publisher,
+		state *trigger_pb.CalendarState,
+		event *trigger_pb.CalendarEvent,
+	) error {
+		publisher.Publish(&CalendarEventMessage{
+			Metadata: event.EventPublishMetadata(),
+			Keys:     event.Keys,
+			Event:    event.Event,
+			Data:     state.Data,
+			Status:   state.Status,
+		})
+		return nil
+	})
+}
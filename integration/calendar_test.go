@@ -0,0 +1,158 @@
+package integration
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/pentops/flowtest"
+	"github.com/pentops/j5/j5types/date_j5t"
+	"github.com/pentops/j5/lib/id62"
+	"github.com/pentops/o5-auth/authtest"
+	"github.com/pentops/trigger/gen/o5/trigger/v1/trigger_pb"
+	"github.com/pentops/trigger/gen/o5/trigger/v1/trigger_spb"
+	"github.com/pentops/trigger/gen/o5/trigger/v1/trigger_tpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestCalendar(tt *testing.T) {
+	flow, uu := NewUniverse(tt)
+	defer flow.RunSteps(tt)
+
+	calendarID := id62.NewString()
+	skipID := id62.NewString()
+	rollID := id62.NewString()
+
+	// Monday 17th is a holiday
+	holiday := time.Date(2025, 2, 17, 18, 30, 0, 0, time.UTC)
+	nextDay := time.Date(2025, 2, 18, 18, 30, 0, 0, time.UTC)
+
+	flow.Step("create calendar", func(ctx context.Context, t flowtest.Asserter) {
+		ctx = authtest.JWTContext(ctx)
+
+		resp, err := uu.CalendarCommand.CreateCalendar(ctx, &trigger_spb.CreateCalendarRequest{
+			CalendarId:      &calendarID,
+			Name:            "US",
+			ExcludedDates:   []*date_j5t.Date{date_j5t.NewDate(2025, 2, 17)},
+			ExcludeWeekends: true,
+		})
+		t.NoError(err)
+		t.Equal("ACTIVE", resp.Calendar.Status.ShortString())
+	})
+
+	flow.Step("update calendar through the manage topic", func(ctx context.Context, t flowtest.Asserter) {
+		ctx = authtest.JWTContext(ctx)
+
+		_, err := uu.TriggerWorker.TriggerManageRequest(ctx, &trigger_tpb.TriggerManageRequestMessage{
+			Action: &trigger_pb.ActionType{
+				Type: &trigger_pb.ActionType_UpdateCalendar_{
+					UpdateCalendar: &trigger_pb.ActionType_UpdateCalendar{
+						CalendarId:      calendarID,
+						Name:            "US Federal",
+						ExcludedDates:   []*date_j5t.Date{date_j5t.NewDate(2025, 2, 17)},
+						ExcludeWeekends: true,
+					},
+				},
+			},
+		})
+		t.NoError(err)
+
+		resp, err := uu.CalendarQuery.CalendarGet(ctx, &trigger_spb.CalendarGetRequest{
+			CalendarId: calendarID,
+		})
+		t.NoError(err)
+		t.Equal("US Federal", resp.Calendar.Data.Name)
+	})
+
+	flow.Step("unknown calendar is rejected", func(ctx context.Context, t flowtest.Asserter) {
+		ctx = authtest.JWTContext(ctx)
+
+		unknownID := id62.NewString()
+		err := uu.CreateTrigger(ctx, t, triggerConfig{
+			TriggerName: "unknownCalendar",
+			Cron:        "30 18 17 * *",
+			CalendarID:  &unknownID,
+		})
+		t.NotNil(err)
+	})
+
+	flow.Step("create triggers", func(ctx context.Context, t flowtest.Asserter) {
+		ctx = authtest.JWTContext(ctx)
+
+		err := uu.CreateTrigger(ctx, t, triggerConfig{
+			TriggerID:    skipID,
+			TriggerName:  "skip",
+			Cron:         "30 18 17 * *",
+			CalendarID:   &calendarID,
+			CalendarRoll: trigger_pb.CalendarRoll_SKIP,
+		})
+		t.NoError(err)
+
+		err = uu.CreateTrigger(ctx, t, triggerConfig{
+			TriggerID:    rollID,
+			TriggerName:  "roll",
+			Cron:         "30 18 17 * *",
+			CalendarID:   &calendarID,
+			CalendarRoll: trigger_pb.CalendarRoll_NEXT_BUSINESS_DAY,
+		})
+		t.NoError(err)
+	})
+
+	flow.Step("nothing fires on the holiday", func(ctx context.Context, t flowtest.Asserter) {
+		ctx = authtest.JWTContext(ctx)
+
+		_, err := uu.TickTopic.SelfTick(ctx, &trigger_tpb.SelfTickMessage{
+			LastTick: timestamppb.New(holiday.Add(-5 * time.Second)),
+		})
+		t.NoError(err)
+
+		stmsg := &trigger_tpb.SelfTickMessage{}
+		uu.Outbox.PopMessage(t, stmsg)
+	})
+
+	flow.Step("rolled trigger fires the next business day", func(ctx context.Context, t flowtest.Asserter) {
+		ctx = authtest.JWTContext(ctx)
+
+		_, err := uu.TickTopic.SelfTick(ctx, &trigger_tpb.SelfTickMessage{
+			LastTick: timestamppb.New(nextDay.Add(-5 * time.Second)),
+		})
+		t.NoError(err)
+
+		stmsg := &trigger_tpb.SelfTickMessage{}
+		uu.Outbox.PopMessage(t, stmsg)
+
+		// the tick time is still the holiday slot
+		trmsg := &trigger_tpb.TriggerReplyMessage{}
+		uu.Outbox.PopMessage(t, trmsg)
+		t.Equal(holiday, trmsg.TickTime.AsTime())
+
+		resp, err := uu.Query.TriggerEvents(ctx, &trigger_spb.TriggerEventsRequest{
+			TriggerId: rollID,
+		})
+		t.NoError(err)
+		t.NotNil(resp.Events[0].Event.GetTriggered())
+	})
+
+	flow.Step("archived calendar excludes nothing", func(ctx context.Context, t flowtest.Asserter) {
+		ctx = authtest.JWTContext(ctx)
+
+		_, err := uu.CalendarCommand.ArchiveCalendar(ctx, &trigger_spb.ArchiveCalendarRequest{
+			CalendarId: calendarID,
+		})
+		t.NoError(err)
+
+		_, err = uu.TickTopic.SelfTick(ctx, &trigger_tpb.SelfTickMessage{
+			LastTick: timestamppb.New(holiday.AddDate(0, 1, 0).Add(-5 * time.Second)),
+		})
+		t.NoError(err)
+
+		stmsg := &trigger_tpb.SelfTickMessage{}
+		uu.Outbox.PopMessage(t, stmsg)
+
+		// March 17th is a Monday, both fire
+		for range 2 {
+			trmsg := &trigger_tpb.TriggerReplyMessage{}
+			uu.Outbox.PopMessage(t, trmsg)
+		}
+	})
+}
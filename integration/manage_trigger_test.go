@@ -4,6 +4,7 @@ import (
 	"context"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/pentops/flowtest"
 	"github.com/pentops/golib/gl"
@@ -13,6 +14,8 @@ import (
 	"github.com/pentops/trigger/gen/o5/trigger/v1/trigger_pb"
 	"github.com/pentops/trigger/gen/o5/trigger/v1/trigger_spb"
 	"github.com/pentops/trigger/gen/o5/trigger/v1/trigger_tpb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 func TestTriggerManageRequest(tt *testing.T) {
@@ -43,6 +46,12 @@ func TestTriggerManageRequest(tt *testing.T) {
 		_, err := uu.TriggerWorker.TriggerManageRequest(ctx, req)
 		t.NoError(err)
 
+		reply := &trigger_tpb.TriggerManageReplyMessage{}
+		uu.Outbox.PopMessage(t, reply)
+		t.Equal(true, reply.Success)
+		t.Equal(triggerID, reply.GetTriggerId())
+		t.NotNil(reply.NextFireAt)
+
 		resp, err := uu.Query.TriggerGet(ctx, &trigger_spb.TriggerGetRequest{TriggerId: triggerID})
 		t.NoError(err)
 		t.NotNil(resp)
@@ -72,6 +81,12 @@ func TestTriggerManageRequest(tt *testing.T) {
 		_, err := uu.TriggerWorker.TriggerManageRequest(ctx, req)
 		t.NoError(err)
 
+		reply := &trigger_tpb.TriggerManageReplyMessage{}
+		uu.Outbox.PopMessage(t, reply)
+		t.Equal(true, reply.Success)
+		t.Equal(triggerID, reply.GetTriggerId())
+		t.NotNil(reply.NextFireAt)
+
 		resp, err := uu.Query.TriggerGet(ctx, &trigger_spb.TriggerGetRequest{TriggerId: triggerID})
 		t.NoError(err)
 		t.NotNil(resp)
@@ -98,6 +113,12 @@ func TestTriggerManageRequest(tt *testing.T) {
 		_, err := uu.TriggerWorker.TriggerManageRequest(ctx, req)
 		t.NoError(err)
 
+		reply := &trigger_tpb.TriggerManageReplyMessage{}
+		uu.Outbox.PopMessage(t, reply)
+		t.Equal(true, reply.Success)
+		t.Equal(triggerID, reply.GetTriggerId())
+		t.Nil(reply.NextFireAt)
+
 		resp, err := uu.Query.TriggerGet(ctx, &trigger_spb.TriggerGetRequest{TriggerId: triggerID})
 		t.NoError(err)
 		t.NotNil(resp)
@@ -105,6 +126,252 @@ func TestTriggerManageRequest(tt *testing.T) {
 	})
 }
 
+func TestIdempotentCreate(tt *testing.T) {
+	flow, uu := NewUniverse(tt)
+	defer flow.RunSteps(tt)
+
+	var triggerID string
+
+	createTrigger := func(ctx context.Context, t flowtest.Asserter, cron string) *trigger_tpb.TriggerManageReplyMessage {
+		_, err := uu.TriggerWorker.TriggerManageRequest(ctx, &trigger_tpb.TriggerManageRequestMessage{
+			Request: &messaging_j5pb.RequestMetadata{
+				Context: []byte(""),
+			},
+			Action: &trigger_pb.ActionType{
+				Type: &trigger_pb.ActionType_Create_{
+					Create: &trigger_pb.ActionType_Create{
+						AppName:     "test",
+						TriggerName: "TestIdempotent",
+						Cron:        cron,
+					},
+				},
+			},
+		})
+		t.NoError(err)
+
+		reply := &trigger_tpb.TriggerManageReplyMessage{}
+		uu.Outbox.PopMessage(t, reply)
+		t.Equal(true, reply.Success)
+		return reply
+	}
+
+	assertEvents := func(ctx context.Context, t flowtest.Asserter, count int) {
+		resp, err := uu.Query.TriggerEvents(ctx, &trigger_spb.TriggerEventsRequest{TriggerId: triggerID})
+		t.NoError(err)
+		t.Equal(count, len(resp.Events))
+	}
+
+	flow.Step("create trigger without an ID", func(ctx context.Context, t flowtest.Asserter) {
+		ctx = authtest.JWTContext(ctx)
+
+		reply := createTrigger(ctx, t, "0 7 * * *")
+		t.NotNil(reply.TriggerId)
+		triggerID = reply.GetTriggerId()
+
+		resp, err := uu.Query.TriggerGet(ctx, &trigger_spb.TriggerGetRequest{TriggerId: triggerID})
+		t.NoError(err)
+		t.Equal("ACTIVE", resp.Trigger.Status.ShortString())
+		t.Equal("0 7 * * *", resp.Trigger.Data.Cron)
+		assertEvents(ctx, t, 1)
+	})
+
+	flow.Step("repeated create is a no-op", func(ctx context.Context, t flowtest.Asserter) {
+		ctx = authtest.JWTContext(ctx)
+
+		reply := createTrigger(ctx, t, "0 7 * * *")
+		t.Equal(triggerID, reply.GetTriggerId())
+		assertEvents(ctx, t, 1)
+	})
+
+	flow.Step("create with a different config updates", func(ctx context.Context, t flowtest.Asserter) {
+		ctx = authtest.JWTContext(ctx)
+
+		reply := createTrigger(ctx, t, "0 8 * * *")
+		t.Equal(triggerID, reply.GetTriggerId())
+
+		resp, err := uu.Query.TriggerGet(ctx, &trigger_spb.TriggerGetRequest{TriggerId: triggerID})
+		t.NoError(err)
+		t.Equal("ACTIVE", resp.Trigger.Status.ShortString())
+		t.Equal("0 8 * * *", resp.Trigger.Data.Cron)
+		assertEvents(ctx, t, 2)
+	})
+}
+
+func TestCreateArchivedTrigger(tt *testing.T) {
+	flow, uu := NewUniverse(tt)
+	defer flow.RunSteps(tt)
+
+	var triggerID string
+
+	createTrigger := func(ctx context.Context, cron string) (*trigger_spb.CreateTriggerResponse, error) {
+		return uu.TriggerCommand.CreateTrigger(ctx, &trigger_spb.CreateTriggerRequest{
+			AppName: "test",
+			Trigger: &trigger_pb.TriggerDefinition{
+				TriggerName: "TestCreateArchived",
+				Cron:        cron,
+			},
+			ReplyTo: "test",
+		})
+	}
+
+	flow.Step("create and archive trigger", func(ctx context.Context, t flowtest.Asserter) {
+		ctx = authtest.JWTContext(ctx)
+
+		resp, err := createTrigger(ctx, "0 7 * * *")
+		t.NoError(err)
+		triggerID = resp.Trigger.Keys.TriggerId
+
+		err = uu.ArchiveTrigger(ctx, triggerID)
+		t.NoError(err)
+	})
+
+	flow.Step("create with the same config is rejected", func(ctx context.Context, t flowtest.Asserter) {
+		ctx = authtest.JWTContext(ctx)
+
+		_, err := createTrigger(ctx, "0 7 * * *")
+		t.CodeError(err, codes.FailedPrecondition)
+	})
+
+	flow.Step("create with a different config is rejected", func(ctx context.Context, t flowtest.Asserter) {
+		ctx = authtest.JWTContext(ctx)
+
+		_, err := createTrigger(ctx, "0 8 * * *")
+		t.CodeError(err, codes.FailedPrecondition)
+
+		resp, err := uu.Query.TriggerGet(ctx, &trigger_spb.TriggerGetRequest{TriggerId: triggerID})
+		t.NoError(err)
+		t.Equal("ARCHIVED", resp.Trigger.Status.ShortString())
+		t.Equal("0 7 * * *", resp.Trigger.Data.Cron)
+	})
+}
+
+func TestCreateCompletedTrigger(tt *testing.T) {
+	flow, uu := NewUniverse(tt)
+	defer flow.RunSteps(tt)
+
+	var triggerID string
+	runAt := time.Date(2025, 2, 17, 18, 30, 0, 0, time.UTC)
+
+	createTrigger := func(ctx context.Context, t flowtest.Asserter, runAt time.Time) *trigger_tpb.TriggerManageReplyMessage {
+		_, err := uu.TriggerWorker.TriggerManageRequest(ctx, &trigger_tpb.TriggerManageRequestMessage{
+			Request: &messaging_j5pb.RequestMetadata{
+				ReplyTo: "test",
+				Context: []byte(""),
+			},
+			Action: &trigger_pb.ActionType{
+				Type: &trigger_pb.ActionType_Create_{
+					Create: &trigger_pb.ActionType_Create{
+						AppName:     "test",
+						TriggerName: "TestCreateCompleted",
+						RunAt:       timestamppb.New(runAt),
+					},
+				},
+			},
+		})
+		t.NoError(err)
+
+		reply := &trigger_tpb.TriggerManageReplyMessage{}
+		uu.Outbox.PopMessage(t, reply)
+		return reply
+	}
+
+	flow.Step("create trigger and fire it", func(ctx context.Context, t flowtest.Asserter) {
+		ctx = authtest.JWTContext(ctx)
+
+		reply := createTrigger(ctx, t, runAt)
+		t.Equal(true, reply.Success)
+		triggerID = reply.GetTriggerId()
+
+		_, err := uu.TickTopic.SelfTick(ctx, &trigger_tpb.SelfTickMessage{
+			LastTick: timestamppb.New(runAt.Add(-5 * time.Second)),
+		})
+		t.NoError(err)
+
+		stmsg := &trigger_tpb.SelfTickMessage{}
+		uu.Outbox.PopMessage(t, stmsg)
+
+		trmsg := &trigger_tpb.TriggerReplyMessage{}
+		uu.Outbox.PopMessage(t, trmsg)
+
+		resp, err := uu.Query.TriggerGet(ctx, &trigger_spb.TriggerGetRequest{TriggerId: triggerID})
+		t.NoError(err)
+		t.Equal("COMPLETED", resp.Trigger.Status.ShortString())
+	})
+
+	flow.Step("create with the same config is rejected", func(ctx context.Context, t flowtest.Asserter) {
+		ctx = authtest.JWTContext(ctx)
+
+		reply := createTrigger(ctx, t, runAt)
+		t.Equal(false, reply.Success)
+		t.Equal(triggerID, reply.GetTriggerId())
+	})
+
+	flow.Step("create with a different config is rejected", func(ctx context.Context, t flowtest.Asserter) {
+		ctx = authtest.JWTContext(ctx)
+
+		reply := createTrigger(ctx, t, runAt.Add(time.Hour))
+		t.Equal(false, reply.Success)
+
+		resp, err := uu.Query.TriggerGet(ctx, &trigger_spb.TriggerGetRequest{TriggerId: triggerID})
+		t.NoError(err)
+		t.Equal("COMPLETED", resp.Trigger.Status.ShortString())
+		t.Equal(runAt, resp.Trigger.Data.RunAt.AsTime())
+		uu.Outbox.AssertEmpty(t)
+	})
+}
+
+func TestSyncTriggers(tt *testing.T) {
+	flow, uu := NewUniverse(tt)
+	defer flow.RunSteps(tt)
+
+	flow.Step("sync creates declared triggers", func(ctx context.Context, t flowtest.Asserter) {
+		ctx = authtest.JWTContext(ctx)
+
+		err := uu.SyncTriggers(ctx, t, "syncApp",
+			&trigger_pb.TriggerDefinition{TriggerName: "daily", Cron: "0 7 * * *"},
+			&trigger_pb.TriggerDefinition{TriggerName: "hourly", Cron: "0 * * * *"},
+		)
+		t.NoError(err)
+
+		t.Equal(map[string][]string{
+			"daily":  {"ACTIVE"},
+			"hourly": {"ACTIVE"},
+		}, uu.AppTriggers(ctx, t, "syncApp"))
+	})
+
+	flow.Step("sync updates, creates and archives", func(ctx context.Context, t flowtest.Asserter) {
+		ctx = authtest.JWTContext(ctx)
+
+		err := uu.SyncTriggers(ctx, t, "syncApp",
+			&trigger_pb.TriggerDefinition{TriggerName: "daily", Cron: "0 8 * * *"},
+			&trigger_pb.TriggerDefinition{TriggerName: "weekly", Cron: "0 7 * * 1"},
+		)
+		t.NoError(err)
+
+		t.Equal(map[string][]string{
+			"daily":  {"ACTIVE"},
+			"hourly": {"ARCHIVED"},
+			"weekly": {"ACTIVE"},
+		}, uu.AppTriggers(ctx, t, "syncApp"))
+	})
+
+	flow.Step("sync rejects invalid definitions", func(ctx context.Context, t flowtest.Asserter) {
+		ctx = authtest.JWTContext(ctx)
+
+		err := uu.SyncTriggers(ctx, t, "syncApp",
+			&trigger_pb.TriggerDefinition{TriggerName: "daily", Cron: "0 8 * * *"},
+			&trigger_pb.TriggerDefinition{TriggerName: "broken", Cron: "0 7 * *"},
+		)
+		t.NotNil(err)
+
+		t.Equal(map[string][]string{
+			"daily":  {"ACTIVE"},
+			"hourly": {"ARCHIVED"},
+			"weekly": {"ACTIVE"},
+		}, uu.AppTriggers(ctx, t, "syncApp"))
+	})
+}
+
 func TestActive(tt *testing.T) {
 	flow, uu := NewUniverse(tt)
 	defer flow.RunSteps(tt)
@@ -114,7 +381,7 @@ func TestActive(tt *testing.T) {
 	flow.Step("create trigger", func(ctx context.Context, t flowtest.Asserter) {
 		ctx = authtest.JWTContext(ctx)
 
-		err := uu.CreateTrigger(ctx, triggerConfig{
+		err := uu.CreateTrigger(ctx, t, triggerConfig{
 			TriggerID:   TriggerID,
 			AppName:     "test",
 			TriggerName: "TestMonthlyCron",
@@ -135,7 +402,7 @@ func TestActive(tt *testing.T) {
 	flow.Step("update trigger", func(ctx context.Context, t flowtest.Asserter) {
 		ctx = authtest.JWTContext(ctx)
 
-		err := uu.UpdateTrigger(ctx, triggerConfig{
+		err := uu.UpdateTrigger(ctx, t, triggerConfig{
 			TriggerID:   TriggerID,
 			AppName:     "test",
 			TriggerName: "TestMonthlyCron",
@@ -175,7 +442,7 @@ func TestPause(tt *testing.T) {
 	TriggerID := id62.NewString()
 
 	flow.Step("create trigger", func(ctx context.Context, t flowtest.Asserter) {
-		err := uu.CreateTrigger(ctx, triggerConfig{
+		err := uu.CreateTrigger(ctx, t, triggerConfig{
 			TriggerID:   TriggerID,
 			AppName:     "test",
 			TriggerName: "TestMonthlyCron",
@@ -196,7 +463,7 @@ func TestPause(tt *testing.T) {
 	flow.Step("update trigger while active", func(ctx context.Context, t flowtest.Asserter) {
 		ctx = authtest.JWTContext(ctx)
 
-		err := uu.UpdateTrigger(ctx, triggerConfig{
+		err := uu.UpdateTrigger(ctx, t, triggerConfig{
 			TriggerID:   TriggerID,
 			AppName:     "testUpdated",
 			TriggerName: "TestMonthlyCronUpdated",
@@ -229,7 +496,7 @@ func TestPause(tt *testing.T) {
 	flow.Step("update trigger while paused", func(ctx context.Context, t flowtest.Asserter) {
 		ctx = authtest.JWTContext(ctx)
 
-		err := uu.UpdateTrigger(ctx, triggerConfig{
+		err := uu.UpdateTrigger(ctx, t, triggerConfig{
 			TriggerID:   TriggerID,
 			AppName:     "testUpdated",
 			TriggerName: "TestMonthlyCronPaused",
@@ -272,6 +539,283 @@ func TestPause(tt *testing.T) {
 	})
 }
 
+func TestManageTopicPause(tt *testing.T) {
+	flow, uu := NewUniverse(tt)
+	defer flow.RunSteps(tt)
+
+	triggerID := id62.NewString()
+
+	flow.Step("create trigger", func(ctx context.Context, t flowtest.Asserter) {
+		err := uu.CreateTrigger(ctx, t, triggerConfig{
+			TriggerID: triggerID,
+			Cron:      "0 18 1 * *",
+		})
+		t.NoError(err)
+	})
+
+	flow.Step("pause trigger", func(ctx context.Context, t flowtest.Asserter) {
+		ctx = authtest.JWTContext(ctx)
+
+		reply := uu.ManageTrigger(ctx, t, &trigger_pb.ActionType{
+			Type: &trigger_pb.ActionType_Pause_{
+				Pause: &trigger_pb.ActionType_Pause{
+					TriggerId: triggerID,
+				},
+			},
+		})
+		t.Equal(true, reply.Success)
+		t.Nil(reply.NextFireAt)
+
+		resp, err := uu.Query.TriggerGet(ctx, &trigger_spb.TriggerGetRequest{TriggerId: triggerID})
+		t.NoError(err)
+		t.Equal("PAUSED", resp.Trigger.Status.ShortString())
+	})
+
+	flow.Step("manual trigger is rejected while paused", func(ctx context.Context, t flowtest.Asserter) {
+		ctx = authtest.JWTContext(ctx)
+
+		reply := uu.ManageTrigger(ctx, t, &trigger_pb.ActionType{
+			Type: &trigger_pb.ActionType_ManuallyTrigger_{
+				ManuallyTrigger: &trigger_pb.ActionType_ManuallyTrigger{
+					TriggerId: triggerID,
+				},
+			},
+		})
+		t.Equal(false, reply.Success)
+		t.NotNil(reply.Error)
+	})
+
+	flow.Step("resume trigger", func(ctx context.Context, t flowtest.Asserter) {
+		ctx = authtest.JWTContext(ctx)
+
+		reply := uu.ManageTrigger(ctx, t, &trigger_pb.ActionType{
+			Type: &trigger_pb.ActionType_Resume_{
+				Resume: &trigger_pb.ActionType_Resume{
+					TriggerId: triggerID,
+				},
+			},
+		})
+		t.Equal(true, reply.Success)
+		t.NotNil(reply.NextFireAt)
+
+		resp, err := uu.Query.TriggerGet(ctx, &trigger_spb.TriggerGetRequest{TriggerId: triggerID})
+		t.NoError(err)
+		t.Equal("ACTIVE", resp.Trigger.Status.ShortString())
+	})
+
+	flow.Step("manual trigger", func(ctx context.Context, t flowtest.Asserter) {
+		ctx = authtest.JWTContext(ctx)
+
+		triggerTime := timestamppb.New(time.Date(2025, 2, 1, 18, 0, 0, 0, time.UTC))
+		reply := uu.ManageTrigger(ctx, t, &trigger_pb.ActionType{
+			Type: &trigger_pb.ActionType_ManuallyTrigger_{
+				ManuallyTrigger: &trigger_pb.ActionType_ManuallyTrigger{
+					TriggerId:   triggerID,
+					TriggerTime: triggerTime,
+				},
+			},
+		})
+		t.Equal(true, reply.Success)
+
+		trmsg := &trigger_tpb.TriggerReplyMessage{}
+		uu.Outbox.PopMessage(t, trmsg)
+		t.Equal(triggerTime.AsTime(), trmsg.TickTime.AsTime())
+	})
+}
+
+func TestRestore(tt *testing.T) {
+	flow, uu := NewUniverse(tt)
+	defer flow.RunSteps(tt)
+
+	triggerID := id62.NewString()
+
+	archive := func(ctx context.Context, t flowtest.Asserter) {
+		reply := uu.ManageTrigger(ctx, t, &trigger_pb.ActionType{
+			Type: &trigger_pb.ActionType_Archive_{
+				Archive: &trigger_pb.ActionType_Archive{
+					TriggerId: triggerID,
+				},
+			},
+		})
+		t.Equal(true, reply.Success)
+	}
+
+	flow.Step("create and archive trigger", func(ctx context.Context, t flowtest.Asserter) {
+		ctx = authtest.JWTContext(ctx)
+
+		err := uu.CreateTrigger(ctx, t, triggerConfig{
+			TriggerID: triggerID,
+			Cron:      "0 18 1 * *",
+		})
+		t.NoError(err)
+
+		archive(ctx, t)
+	})
+
+	flow.Step("restore requires a reason", func(ctx context.Context, t flowtest.Asserter) {
+		ctx = authtest.JWTContext(ctx)
+
+		_, err := uu.TriggerCommand.RestoreTrigger(ctx, &trigger_spb.RestoreTriggerRequest{
+			TriggerId: triggerID,
+		})
+		t.CodeError(err, codes.InvalidArgument)
+	})
+
+	flow.Step("restore to paused", func(ctx context.Context, t flowtest.Asserter) {
+		ctx = authtest.JWTContext(ctx)
+
+		resp, err := uu.TriggerCommand.RestoreTrigger(ctx, &trigger_spb.RestoreTriggerRequest{
+			TriggerId: triggerID,
+			Reason:    "archived by mistake",
+		})
+		t.NoError(err)
+		t.Equal("PAUSED", resp.Trigger.Status.ShortString())
+		t.Equal("0 18 1 * *", resp.Trigger.Data.Cron)
+
+		events, err := uu.Query.TriggerEvents(ctx, &trigger_spb.TriggerEventsRequest{TriggerId: triggerID})
+		t.NoError(err)
+		t.Equal(3, len(events.Events))
+	})
+
+	flow.Step("restore to active through the manage topic", func(ctx context.Context, t flowtest.Asserter) {
+		ctx = authtest.JWTContext(ctx)
+
+		archive(ctx, t)
+
+		reply := uu.ManageTrigger(ctx, t, &trigger_pb.ActionType{
+			Type: &trigger_pb.ActionType_Restore_{
+				Restore: &trigger_pb.ActionType_Restore{
+					TriggerId: triggerID,
+					Reason:    "archived by mistake again",
+					Activate:  true,
+				},
+			},
+		})
+		t.Equal(true, reply.Success)
+		t.NotNil(reply.NextFireAt)
+
+		resp, err := uu.Query.TriggerGet(ctx, &trigger_spb.TriggerGetRequest{TriggerId: triggerID})
+		t.NoError(err)
+		t.Equal("ACTIVE", resp.Trigger.Status.ShortString())
+	})
+}
+
+func TestTriggerCommandCreateUpdate(tt *testing.T) {
+	flow, uu := NewUniverse(tt)
+	defer flow.RunSteps(tt)
+
+	var triggerID string
+
+	flow.Step("create rejects an invalid cron", func(ctx context.Context, t flowtest.Asserter) {
+		ctx = authtest.JWTContext(ctx)
+
+		_, err := uu.TriggerCommand.CreateTrigger(ctx, &trigger_spb.CreateTriggerRequest{
+			AppName: "test",
+			Trigger: &trigger_pb.TriggerDefinition{
+				TriggerName: "TestCommand",
+				Cron:        "0 7 * *",
+			},
+		})
+		t.CodeError(err, codes.InvalidArgument)
+	})
+
+	flow.Step("create trigger", func(ctx context.Context, t flowtest.Asserter) {
+		ctx = authtest.JWTContext(ctx)
+
+		resp, err := uu.TriggerCommand.CreateTrigger(ctx, &trigger_spb.CreateTriggerRequest{
+			AppName: "test",
+			Trigger: &trigger_pb.TriggerDefinition{
+				TriggerName: "TestCommand",
+				Cron:        "0 7 * * *",
+			},
+			ReplyTo: "test",
+		})
+		t.NoError(err)
+		t.Equal("ACTIVE", resp.Trigger.Status.ShortString())
+		t.Equal("0 7 * * *", resp.Trigger.Data.Cron)
+		t.Equal("test", resp.Trigger.Data.RequestMetadata.ReplyTo)
+		triggerID = resp.Trigger.Keys.TriggerId
+
+		again, err := uu.TriggerCommand.CreateTrigger(ctx, &trigger_spb.CreateTriggerRequest{
+			AppName: "test",
+			Trigger: &trigger_pb.TriggerDefinition{
+				TriggerName: "TestCommand",
+				Cron:        "0 7 * * *",
+			},
+			ReplyTo: "test",
+		})
+		t.NoError(err)
+		t.Equal(triggerID, again.Trigger.Keys.TriggerId)
+	})
+
+	flow.Step("update trigger", func(ctx context.Context, t flowtest.Asserter) {
+		ctx = authtest.JWTContext(ctx)
+
+		resp, err := uu.TriggerCommand.UpdateTrigger(ctx, &trigger_spb.UpdateTriggerRequest{
+			TriggerId: triggerID,
+			AppName:   "test",
+			Trigger: &trigger_pb.TriggerDefinition{
+				TriggerName: "TestCommand",
+				Cron:        "0 8 * * *",
+			},
+		})
+		t.NoError(err)
+		t.Equal("ACTIVE", resp.Trigger.Status.ShortString())
+		t.Equal("0 8 * * *", resp.Trigger.Data.Cron)
+		t.Equal("test", resp.Trigger.Data.RequestMetadata.ReplyTo)
+	})
+
+	flow.Step("archived trigger cannot be updated or resumed", func(ctx context.Context, t flowtest.Asserter) {
+		ctx = authtest.JWTContext(ctx)
+
+		err := uu.ArchiveTrigger(ctx, triggerID)
+		t.NoError(err)
+
+		_, err = uu.TriggerCommand.UpdateTrigger(ctx, &trigger_spb.UpdateTriggerRequest{
+			TriggerId: triggerID,
+			AppName:   "test",
+			Trigger: &trigger_pb.TriggerDefinition{
+				TriggerName: "TestCommand",
+				Cron:        "0 9 * * *",
+			},
+		})
+		t.CodeError(err, codes.FailedPrecondition)
+
+		_, err = uu.TriggerCommand.ResumeTrigger(ctx, &trigger_spb.ResumeTriggerRequest{
+			TriggerId: triggerID,
+		})
+		t.CodeError(err, codes.FailedPrecondition)
+	})
+
+	flow.Step("create without a reply to has no request metadata", func(ctx context.Context, t flowtest.Asserter) {
+		ctx = authtest.JWTContext(ctx)
+
+		resp, err := uu.TriggerCommand.CreateTrigger(ctx, &trigger_spb.CreateTriggerRequest{
+			AppName: "test",
+			Trigger: &trigger_pb.TriggerDefinition{
+				TriggerName: "TestCommandNoReply",
+				Cron:        "0 7 * * *",
+			},
+		})
+		t.NoError(err)
+		t.Nil(resp.Trigger.Data.RequestMetadata)
+	})
+
+	flow.Step("update unknown trigger", func(ctx context.Context, t flowtest.Asserter) {
+		ctx = authtest.JWTContext(ctx)
+
+		_, err := uu.TriggerCommand.UpdateTrigger(ctx, &trigger_spb.UpdateTriggerRequest{
+			TriggerId: id62.NewString(),
+			AppName:   "test",
+			Trigger: &trigger_pb.TriggerDefinition{
+				TriggerName: "TestCommand",
+				Cron:        "0 8 * * *",
+			},
+		})
+		t.CodeError(err, codes.NotFound)
+	})
+}
+
 func TestGrumpyTrigger(tt *testing.T) {
 	flow, uu := NewUniverse(tt)
 	defer flow.RunSteps(tt)
@@ -281,16 +825,16 @@ func TestGrumpyTrigger(tt *testing.T) {
 	flow.Step("create trigger", func(ctx context.Context, t flowtest.Asserter) {
 		ctx = authtest.JWTContext(ctx)
 
-		err := uu.CreateTrigger(ctx, triggerConfig{
+		err := uu.CreateTrigger(ctx, t, triggerConfig{
 			TriggerID:   TriggerID,
 			AppName:     "test",
 			TriggerName: "TestCron",
 			Cron:        "fail",
 		})
 		t.NotNil(err)
-		t.Equal(true, strings.Contains(err.Error(), "invalid cron string: expected exactly 5 fields"))
+		t.Equal(true, strings.Contains(err.Error(), "invalid cron string: expected 5 to 6 fields"))
 
-		err = uu.CreateTrigger(ctx, triggerConfig{
+		err = uu.CreateTrigger(ctx, t, triggerConfig{
 			TriggerID:   TriggerID,
 			AppName:     "test",
 			TriggerName: "TestCron",
@@ -298,7 +842,7 @@ func TestGrumpyTrigger(tt *testing.T) {
 		})
 		t.NoError(err)
 
-		err = uu.UpdateTrigger(ctx, triggerConfig{
+		err = uu.UpdateTrigger(ctx, t, triggerConfig{
 			TriggerID:   TriggerID,
 			AppName:     "test",
 			TriggerName: "TestCron",
@@ -307,7 +851,7 @@ func TestGrumpyTrigger(tt *testing.T) {
 
 		t.Equal(true, strings.Contains(err.Error(), "invalid cron string: end of range"))
 
-		err = uu.UpdateTrigger(ctx, triggerConfig{
+		err = uu.UpdateTrigger(ctx, t, triggerConfig{
 			TriggerID:   TriggerID,
 			AppName:     "test",
 			TriggerName: "TestCron",
@@ -316,3 +860,41 @@ func TestGrumpyTrigger(tt *testing.T) {
 		t.NoError(err)
 	})
 }
+
+func TestPreviewTrigger(tt *testing.T) {
+	flow, uu := NewUniverse(tt)
+	defer flow.RunSteps(tt)
+
+	after := time.Date(2025, 3, 7, 12, 0, 0, 0, time.UTC)
+
+	flow.Step("preview a cron", func(ctx context.Context, t flowtest.Asserter) {
+		resp, err := uu.Preview.PreviewTrigger(ctx, &trigger_spb.PreviewTriggerRequest{
+			Cron:     "30 2 * * *",
+			Timezone: "America/New_York",
+			Count:    3,
+			After:    timestamppb.New(after),
+		})
+		t.NoError(err)
+		t.Equal(3, len(resp.FireTimes))
+
+		// 02:30 does not happen on 2025-03-09, the default policy shifts it an
+		// hour later
+		t.Equal(time.Date(2025, 3, 8, 7, 30, 0, 0, time.UTC), resp.FireTimes[0].AsTime())
+		t.Equal(time.Date(2025, 3, 9, 7, 30, 0, 0, time.UTC), resp.FireTimes[1].AsTime())
+		t.Equal(time.Date(2025, 3, 10, 6, 30, 0, 0, time.UTC), resp.FireTimes[2].AsTime())
+	})
+
+	flow.Step("preview rejects an invalid cron", func(ctx context.Context, t flowtest.Asserter) {
+		_, err := uu.Preview.PreviewTrigger(ctx, &trigger_spb.PreviewTriggerRequest{
+			Cron: "30 2 * *",
+		})
+		t.CodeError(err, codes.InvalidArgument)
+	})
+
+	flow.Step("preview a missing trigger", func(ctx context.Context, t flowtest.Asserter) {
+		_, err := uu.Preview.PreviewTrigger(ctx, &trigger_spb.PreviewTriggerRequest{
+			TriggerId: gl.Ptr(id62.NewString()),
+		})
+		t.CodeError(err, codes.NotFound)
+	})
+}
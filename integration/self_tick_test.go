@@ -2,14 +2,21 @@ package integration
 
 import (
 	"context"
+	"errors"
+	"slices"
 	"testing"
 	"time"
 
 	"github.com/pentops/flowtest"
+	"github.com/pentops/j5/gen/j5/state/v1/psm_j5pb"
 	"github.com/pentops/j5/lib/id62"
 	"github.com/pentops/o5-auth/authtest"
+	"github.com/pentops/trigger/gen/o5/trigger/v1/trigger_pb"
 	"github.com/pentops/trigger/gen/o5/trigger/v1/trigger_spb"
 	"github.com/pentops/trigger/gen/o5/trigger/v1/trigger_tpb"
+	"github.com/pentops/trigger/service"
+	"github.com/pentops/trigger/states"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
@@ -22,7 +29,7 @@ func TestSelfTick(tt *testing.T) {
 	TriggerID3 := id62.NewString()
 	TriggerID4 := id62.NewString()
 
-	lastTickString := "2025-02-17 18:29:00Z"
+	lastTickString := "2025-02-17 18:29:55Z"
 	layout := "2006-01-02 15:04:05Z" // Format string for parsing
 
 	lastTick, err := time.Parse(layout, lastTickString)
@@ -33,7 +40,7 @@ func TestSelfTick(tt *testing.T) {
 	flow.Step("create triggers", func(ctx context.Context, t flowtest.Asserter) {
 		ctx = authtest.JWTContext(ctx)
 
-		err := uu.CreateTrigger(ctx, triggerConfig{
+		err := uu.CreateTrigger(ctx, t, triggerConfig{
 			TriggerID:   TriggerID1,
 			AppName:     "test",
 			TriggerName: "testCron1",
@@ -41,7 +48,7 @@ func TestSelfTick(tt *testing.T) {
 		})
 		t.NoError(err)
 
-		err = uu.CreateTrigger(ctx, triggerConfig{
+		err = uu.CreateTrigger(ctx, t, triggerConfig{
 			TriggerID:   TriggerID2,
 			AppName:     "test",
 			TriggerName: "testCron2",
@@ -49,7 +56,7 @@ func TestSelfTick(tt *testing.T) {
 		})
 		t.NoError(err)
 
-		err = uu.CreateTrigger(ctx, triggerConfig{
+		err = uu.CreateTrigger(ctx, t, triggerConfig{
 			TriggerID:   TriggerID3,
 			AppName:     "test",
 			TriggerName: "testCron3",
@@ -57,7 +64,7 @@ func TestSelfTick(tt *testing.T) {
 		})
 		t.NoError(err)
 
-		err = uu.CreateTrigger(ctx, triggerConfig{
+		err = uu.CreateTrigger(ctx, t, triggerConfig{
 			TriggerID:   TriggerID4,
 			AppName:     "test",
 			TriggerName: "testCron4",
@@ -80,12 +87,12 @@ func TestSelfTick(tt *testing.T) {
 
 		stmsg := &trigger_tpb.SelfTickMessage{}
 		uu.Outbox.PopMessage(t, stmsg)
-		t.Equal(true, stmsg.LastTick.AsTime().Equal(lastTick.Add(1*time.Minute)))
+		t.Equal(true, stmsg.LastTick.AsTime().Equal(lastTick.Add(5*time.Second)))
 
 		trmsg := &trigger_tpb.TriggerReplyMessage{}
 
-		// last tick was sent, so need to add 1 minute to make sure the tick happened at the correct time.
-		thisTick := lastTick.Add(1 * time.Minute)
+		// last tick was sent, so need to add one cadence to make sure the tick happened at the correct time.
+		thisTick := lastTick.Add(5 * time.Second)
 		uu.Outbox.PopMessage(t, trmsg)
 		t.Equal(thisTick, trmsg.TickTime.AsTime())
 	})
@@ -126,6 +133,671 @@ func TestSelfTick(tt *testing.T) {
 	})
 }
 
+func TestSelfTickCatchUp(tt *testing.T) {
+	flow, uu := NewUniverse(tt)
+	defer flow.RunSteps(tt)
+
+	fireAllID := id62.NewString()
+	fireLatestID := id62.NewString()
+	skipID := id62.NewString()
+
+	// well in the past, so the tick is late and catch-up policies apply
+	lastTick := time.Date(2025, 2, 17, 18, 29, 55, 0, time.UTC)
+
+	flow.Step("create triggers", func(ctx context.Context, t flowtest.Asserter) {
+		ctx = authtest.JWTContext(ctx)
+
+		err := uu.CreateTrigger(ctx, t, triggerConfig{
+			TriggerID:   fireAllID,
+			TriggerName: "fireAll",
+			Cron:        "30 18 * * *",
+			CatchUp:     trigger_pb.CatchUpPolicy_FIRE_ALL,
+		})
+		t.NoError(err)
+
+		// the same time tomorrow has also been missed, so this is not the latest
+		err = uu.CreateTrigger(ctx, t, triggerConfig{
+			TriggerID:   fireLatestID,
+			TriggerName: "fireLatest",
+			Cron:        "30 18 * * *",
+			CatchUp:     trigger_pb.CatchUpPolicy_FIRE_LATEST,
+		})
+		t.NoError(err)
+
+		err = uu.CreateTrigger(ctx, t, triggerConfig{
+			TriggerID:   skipID,
+			TriggerName: "skip",
+			Cron:        "30 18 * * *",
+			CatchUp:     trigger_pb.CatchUpPolicy_SKIP,
+		})
+		t.NoError(err)
+	})
+
+	flow.Step("send late self tick", func(ctx context.Context, t flowtest.Asserter) {
+		ctx = authtest.JWTContext(ctx)
+
+		_, err := uu.TickTopic.SelfTick(ctx, &trigger_tpb.SelfTickMessage{
+			LastTick: timestamppb.New(lastTick),
+		})
+		t.NoError(err)
+
+		stmsg := &trigger_tpb.SelfTickMessage{}
+		uu.Outbox.PopMessage(t, stmsg)
+
+		// only the FIRE_ALL trigger replies, marked as late
+		trmsg := &trigger_tpb.TriggerReplyMessage{}
+		uu.Outbox.PopMessage(t, trmsg)
+		t.Equal(lastTick.Add(5*time.Second), trmsg.TickTime.AsTime())
+		t.Equal(true, trmsg.Late)
+	})
+
+	flow.Step("only the fire all trigger should have triggered", func(ctx context.Context, t flowtest.Asserter) {
+		ctx = authtest.JWTContext(ctx)
+
+		resp, err := uu.Query.TriggerEvents(ctx, &trigger_spb.TriggerEventsRequest{
+			TriggerId: fireAllID,
+		})
+		t.NoError(err)
+		t.Equal(true, resp.Events[0].Event.GetTriggered().Late)
+
+		resp, err = uu.Query.TriggerEvents(ctx, &trigger_spb.TriggerEventsRequest{
+			TriggerId: fireLatestID,
+		})
+		t.NoError(err)
+		t.Nil(resp.Events[0].Event.GetTriggered())
+
+		resp, err = uu.Query.TriggerEvents(ctx, &trigger_spb.TriggerEventsRequest{
+			TriggerId: skipID,
+		})
+		t.NoError(err)
+		t.Nil(resp.Events[0].Event.GetTriggered())
+	})
+}
+
+func TestSelfTickInterval(tt *testing.T) {
+	flow, uu := NewUniverse(tt)
+	defer flow.RunSteps(tt)
+
+	TriggerID := id62.NewString()
+
+	// every 90 minutes starting at 08:15, 18:45 is the 7th interval
+	anchor := time.Date(2025, 2, 17, 8, 15, 0, 0, time.UTC)
+	fireTime := time.Date(2025, 2, 17, 18, 45, 0, 0, time.UTC)
+
+	flow.Step("create trigger", func(ctx context.Context, t flowtest.Asserter) {
+		ctx = authtest.JWTContext(ctx)
+
+		err := uu.CreateTrigger(ctx, t, triggerConfig{
+			TriggerID:   TriggerID,
+			TriggerName: "testInterval",
+			Interval: &trigger_pb.Interval{
+				EverySeconds: 90 * 60,
+				Anchor:       timestamppb.New(anchor),
+			},
+		})
+		t.NoError(err)
+	})
+
+	flow.Step("tick between intervals", func(ctx context.Context, t flowtest.Asserter) {
+		ctx = authtest.JWTContext(ctx)
+
+		_, err := uu.TickTopic.SelfTick(ctx, &trigger_tpb.SelfTickMessage{
+			LastTick: timestamppb.New(fireTime.Add(-10 * time.Second)),
+		})
+		t.NoError(err)
+
+		stmsg := &trigger_tpb.SelfTickMessage{}
+		uu.Outbox.PopMessage(t, stmsg)
+	})
+
+	flow.Step("tick on interval", func(ctx context.Context, t flowtest.Asserter) {
+		ctx = authtest.JWTContext(ctx)
+
+		_, err := uu.TickTopic.SelfTick(ctx, &trigger_tpb.SelfTickMessage{
+			LastTick: timestamppb.New(fireTime.Add(-5 * time.Second)),
+		})
+		t.NoError(err)
+
+		stmsg := &trigger_tpb.SelfTickMessage{}
+		uu.Outbox.PopMessage(t, stmsg)
+
+		trmsg := &trigger_tpb.TriggerReplyMessage{}
+		uu.Outbox.PopMessage(t, trmsg)
+		t.Equal(fireTime, trmsg.TickTime.AsTime())
+
+		resp, err := uu.Query.TriggerGet(ctx, &trigger_spb.TriggerGetRequest{
+			TriggerId: TriggerID,
+		})
+		t.NoError(err)
+		t.Equal("ACTIVE", resp.Trigger.Status.ShortString())
+	})
+}
+
+func TestCreateWhileTickBehind(tt *testing.T) {
+	flow, uu := NewUniverse(tt)
+	defer flow.RunSteps(tt)
+
+	TriggerID := id62.NewString()
+	lastTick := time.Date(2025, 2, 17, 18, 30, 0, 0, time.UTC)
+	created := time.Date(2025, 2, 17, 19, 10, 0, 0, time.UTC)
+
+	flow.Step("tick loop falls behind", func(ctx context.Context, t flowtest.Asserter) {
+		ctx = authtest.JWTContext(ctx)
+
+		_, err := uu.TickTopic.SelfTick(ctx, &trigger_tpb.SelfTickMessage{
+			LastTick: timestamppb.New(lastTick.Add(-5 * time.Second)),
+		})
+		t.NoError(err)
+
+		stmsg := &trigger_tpb.SelfTickMessage{}
+		uu.Outbox.PopMessage(t, stmsg)
+	})
+
+	flow.Step("trigger is scheduled from when it was created", func(ctx context.Context, t flowtest.Asserter) {
+		ctx = authtest.JWTContext(ctx)
+
+		uu.now = created
+
+		err := uu.CreateTrigger(ctx, t, triggerConfig{
+			TriggerID:   TriggerID,
+			TriggerName: "testTickBehind",
+			Cron:        "0 * * * *",
+		})
+		t.NoError(err)
+
+		resp, err := uu.Query.TriggerGet(ctx, &trigger_spb.TriggerGetRequest{
+			TriggerId: TriggerID,
+		})
+		t.NoError(err)
+		t.Equal(time.Date(2025, 2, 17, 20, 0, 0, 0, time.UTC), resp.Trigger.Data.NextFireAt.AsTime())
+	})
+
+	flow.Step("catching up does not fire for a slot before it was created", func(ctx context.Context, t flowtest.Asserter) {
+		ctx = authtest.JWTContext(ctx)
+
+		_, err := uu.TickTopic.SelfTick(ctx, &trigger_tpb.SelfTickMessage{
+			LastTick: timestamppb.New(time.Date(2025, 2, 17, 18, 59, 55, 0, time.UTC)),
+		})
+		t.NoError(err)
+
+		stmsg := &trigger_tpb.SelfTickMessage{}
+		uu.Outbox.PopMessage(t, stmsg)
+		uu.Outbox.AssertEmpty(t)
+	})
+}
+
+func TestSelfTickLongerInterval(tt *testing.T) {
+	flow, uu := NewUniverse(tt)
+	defer flow.RunSteps(tt)
+
+	TriggerID := id62.NewString()
+	lastTick := time.Date(2025, 2, 17, 18, 0, 0, 0, time.UTC)
+
+	flow.Step("create trigger", func(ctx context.Context, t flowtest.Asserter) {
+		ctx = authtest.JWTContext(ctx)
+
+		t.NoError(uu.TriggerWorker.SetTickInterval(time.Minute))
+
+		err := uu.CreateTrigger(ctx, t, triggerConfig{
+			TriggerID:   TriggerID,
+			TriggerName: "testLongerInterval",
+			Cron:        "*/15 * * * * *",
+		})
+		t.NoError(err)
+	})
+
+	flow.Step("one tick fires every slot of the interval", func(ctx context.Context, t flowtest.Asserter) {
+		ctx = authtest.JWTContext(ctx)
+
+		_, err := uu.TickTopic.SelfTick(ctx, &trigger_tpb.SelfTickMessage{
+			LastTick: timestamppb.New(lastTick),
+		})
+		t.NoError(err)
+
+		stmsg := &trigger_tpb.SelfTickMessage{}
+		uu.Outbox.PopMessage(t, stmsg)
+		t.Equal(lastTick.Add(time.Minute), stmsg.LastTick.AsTime())
+
+		for _, seconds := range []int{15, 30, 45, 60} {
+			trmsg := &trigger_tpb.TriggerReplyMessage{}
+			uu.Outbox.PopMessage(t, trmsg)
+			t.Equal(lastTick.Add(time.Duration(seconds)*time.Second), trmsg.TickTime.AsTime())
+		}
+		uu.Outbox.AssertEmpty(t)
+	})
+}
+
+func TestSelfTickDST(tt *testing.T) {
+	flow, uu := NewUniverse(tt)
+	defer flow.RunSteps(tt)
+
+	skipID := id62.NewString()
+	shiftID := id62.NewString()
+	fireBothID := id62.NewString()
+
+	// sends the self tick for each time, asserting how many triggers fired
+	walkTicks := func(ctx context.Context, t flowtest.Asserter, ticks map[time.Time]int) {
+		// the tick loop only moves forward, so walk the ticks in time order
+		times := make([]time.Time, 0, len(ticks))
+		for tick := range ticks {
+			times = append(times, tick)
+		}
+		slices.SortFunc(times, time.Time.Compare)
+
+		for _, tick := range times {
+			fires := ticks[tick]
+			_, err := uu.TickTopic.SelfTick(ctx, &trigger_tpb.SelfTickMessage{
+				LastTick: timestamppb.New(tick.Add(-5 * time.Second)),
+			})
+			t.NoError(err)
+
+			stmsg := &trigger_tpb.SelfTickMessage{}
+			uu.Outbox.PopMessage(t, stmsg)
+
+			for range fires {
+				trmsg := &trigger_tpb.TriggerReplyMessage{}
+				uu.Outbox.PopMessage(t, trmsg)
+				t.Equal(tick, trmsg.TickTime.AsTime())
+			}
+			uu.Outbox.AssertEmpty(t)
+		}
+	}
+
+	countTriggered := func(ctx context.Context, t flowtest.Asserter, triggerID string) int {
+		resp, err := uu.Query.TriggerEvents(ctx, &trigger_spb.TriggerEventsRequest{
+			TriggerId: triggerID,
+		})
+		t.NoError(err)
+
+		count := 0
+		for _, evt := range resp.Events {
+			if evt.Event.GetTriggered() != nil {
+				count++
+			}
+		}
+		return count
+	}
+
+	flow.Step("create triggers at 02:30 New York", func(ctx context.Context, t flowtest.Asserter) {
+		ctx = authtest.JWTContext(ctx)
+
+		for id, policy := range map[string]trigger_pb.DSTPolicy{
+			skipID:     trigger_pb.DSTPolicy_SKIP,
+			shiftID:    trigger_pb.DSTPolicy_SHIFT,
+			fireBothID: trigger_pb.DSTPolicy_FIRE_BOTH,
+		} {
+			err := uu.CreateTrigger(ctx, t, triggerConfig{
+				TriggerID:   id,
+				TriggerName: policy.ShortString(),
+				Cron:        "30 2 * * *",
+				Timezone:    "America/New_York",
+				DSTPolicy:   policy,
+			})
+			t.NoError(err)
+		}
+	})
+
+	flow.Step("walk across spring forward", func(ctx context.Context, t flowtest.Asserter) {
+		ctx = authtest.JWTContext(ctx)
+
+		// 2025-03-09 clocks go from 02:00 EST to 03:00 EDT at 07:00 UTC, so
+		// 02:30 never happens, shifted it is 03:30 EDT
+		walkTicks(ctx, t, map[time.Time]int{
+			time.Date(2025, 3, 9, 6, 55, 0, 0, time.UTC): 0,
+			time.Date(2025, 3, 9, 7, 0, 0, 0, time.UTC):  0,
+			time.Date(2025, 3, 9, 7, 30, 0, 0, time.UTC): 2,
+			time.Date(2025, 3, 9, 8, 30, 0, 0, time.UTC): 0,
+		})
+
+		t.Equal(0, countTriggered(ctx, t, skipID))
+		t.Equal(1, countTriggered(ctx, t, shiftID))
+		t.Equal(1, countTriggered(ctx, t, fireBothID))
+	})
+
+	flow.Step("move triggers to 01:30 New York", func(ctx context.Context, t flowtest.Asserter) {
+		ctx = authtest.JWTContext(ctx)
+
+		for id, policy := range map[string]trigger_pb.DSTPolicy{
+			skipID:     trigger_pb.DSTPolicy_SKIP,
+			shiftID:    trigger_pb.DSTPolicy_SHIFT,
+			fireBothID: trigger_pb.DSTPolicy_FIRE_BOTH,
+		} {
+			err := uu.UpdateTrigger(ctx, t, triggerConfig{
+				TriggerID:   id,
+				TriggerName: policy.ShortString(),
+				Cron:        "30 1 * * *",
+				Timezone:    "America/New_York",
+				DSTPolicy:   policy,
+			})
+			t.NoError(err)
+		}
+	})
+
+	flow.Step("walk across fall back", func(ctx context.Context, t flowtest.Asserter) {
+		ctx = authtest.JWTContext(ctx)
+
+		// 2025-11-02 clocks go from 02:00 EDT back to 01:00 EST at 06:00 UTC,
+		// so 01:30 happens at 05:30 and again at 06:30 UTC
+		walkTicks(ctx, t, map[time.Time]int{
+			time.Date(2025, 11, 2, 5, 30, 0, 0, time.UTC): 3,
+			time.Date(2025, 11, 2, 6, 0, 0, 0, time.UTC):  0,
+			time.Date(2025, 11, 2, 6, 30, 0, 0, time.UTC): 1,
+		})
+
+		t.Equal(1, countTriggered(ctx, t, skipID))
+		t.Equal(2, countTriggered(ctx, t, shiftID))
+		t.Equal(3, countTriggered(ctx, t, fireBothID))
+	})
+}
+
+func TestSelfTickJitter(tt *testing.T) {
+	flow, uu := NewUniverse(tt)
+	defer flow.RunSteps(tt)
+
+	TriggerID := id62.NewString()
+	tickTime := time.Date(2025, 2, 17, 19, 0, 0, 0, time.UTC)
+
+	flow.Step("create trigger", func(ctx context.Context, t flowtest.Asserter) {
+		ctx = authtest.JWTContext(ctx)
+
+		err := uu.CreateTrigger(ctx, t, triggerConfig{
+			TriggerID:     TriggerID,
+			TriggerName:   "testJitter",
+			Cron:          "0 * * * *",
+			JitterSeconds: 300,
+		})
+		t.NoError(err)
+	})
+
+	flow.Step("jitter over the max is rejected", func(ctx context.Context, t flowtest.Asserter) {
+		ctx = authtest.JWTContext(ctx)
+
+		err := uu.UpdateTrigger(ctx, t, triggerConfig{
+			TriggerID:     TriggerID,
+			TriggerName:   "testJitter",
+			Cron:          "0 * * * *",
+			JitterSeconds: 7200,
+		})
+		t.NotNil(err)
+	})
+
+	flow.Step("reply keeps the scheduled tick time", func(ctx context.Context, t flowtest.Asserter) {
+		ctx = authtest.JWTContext(ctx)
+
+		_, err := uu.TickTopic.SelfTick(ctx, &trigger_tpb.SelfTickMessage{
+			LastTick: timestamppb.New(tickTime.Add(-5 * time.Second)),
+		})
+		t.NoError(err)
+
+		stmsg := &trigger_tpb.SelfTickMessage{}
+		uu.Outbox.PopMessage(t, stmsg)
+
+		trmsg := &trigger_tpb.TriggerReplyMessage{}
+		uu.Outbox.PopMessage(t, trmsg)
+		t.Equal(tickTime, trmsg.TickTime.AsTime())
+	})
+}
+
+func TestSelfTickReplay(tt *testing.T) {
+	flow, uu := NewUniverse(tt)
+	defer flow.RunSteps(tt)
+
+	TriggerID := id62.NewString()
+	tickTime := time.Date(2025, 2, 17, 18, 0, 0, 0, time.UTC)
+	tickMsg := &trigger_tpb.SelfTickMessage{
+		LastTick: timestamppb.New(tickTime.Add(-5 * time.Second)),
+	}
+
+	flow.Step("create trigger", func(ctx context.Context, t flowtest.Asserter) {
+		ctx = authtest.JWTContext(ctx)
+
+		err := uu.CreateTrigger(ctx, t, triggerConfig{
+			TriggerID:   TriggerID,
+			TriggerName: "testReplay",
+			Cron:        "0 * * * *",
+		})
+		t.NoError(err)
+	})
+
+	flow.Step("tick fires the trigger", func(ctx context.Context, t flowtest.Asserter) {
+		ctx = authtest.JWTContext(ctx)
+
+		_, err := uu.TickTopic.SelfTick(ctx, tickMsg)
+		t.NoError(err)
+
+		stmsg := &trigger_tpb.SelfTickMessage{}
+		uu.Outbox.PopMessage(t, stmsg)
+
+		trmsg := &trigger_tpb.TriggerReplyMessage{}
+		uu.Outbox.PopMessage(t, trmsg)
+		t.Equal(tickTime, trmsg.TickTime.AsTime())
+	})
+
+	flow.Step("redelivered tick does not fire or continue the chain again", func(ctx context.Context, t flowtest.Asserter) {
+		ctx = authtest.JWTContext(ctx)
+
+		_, err := uu.TickTopic.SelfTick(ctx, tickMsg)
+		t.NoError(err)
+	})
+
+	flow.Step("fire for the same time is rejected by the ledger", func(ctx context.Context, t flowtest.Asserter) {
+		ctx = authtest.JWTContext(ctx)
+
+		err := uu.SendTriggerEvent(ctx, &trigger_pb.TriggerPSMEventSpec{
+			Keys: &trigger_pb.TriggerKeys{
+				TriggerId: TriggerID,
+			},
+			Cause: &psm_j5pb.Cause{
+				Type: &psm_j5pb.Cause_ExternalEvent{
+					ExternalEvent: &psm_j5pb.ExternalEventCause{
+						SystemName: "trigger",
+						EventName:  "trigger_tick",
+					},
+				},
+			},
+			Event: &trigger_pb.TriggerEventType_Triggered{
+				TriggerTime: timestamppb.New(tickTime),
+			},
+		})
+		t.Equal(true, errors.Is(err, states.ErrAlreadyFired))
+
+		resp, err := uu.Query.TriggerGet(ctx, &trigger_spb.TriggerGetRequest{
+			TriggerId: TriggerID,
+		})
+		t.NoError(err)
+		t.Equal(int32(1), resp.Trigger.Data.FireCount)
+	})
+
+	flow.Step("fire already in the ledger moves the trigger on", func(ctx context.Context, t flowtest.Asserter) {
+		ctx = authtest.JWTContext(ctx)
+
+		nextTime := tickTime.Add(time.Hour)
+		uu.mustRecordFire(ctx, t, TriggerID, nextTime)
+
+		_, err := uu.TickTopic.SelfTick(ctx, &trigger_tpb.SelfTickMessage{
+			LastTick: timestamppb.New(nextTime.Add(-5 * time.Second)),
+		})
+		t.NoError(err)
+
+		stmsg := &trigger_tpb.SelfTickMessage{}
+		uu.Outbox.PopMessage(t, stmsg)
+		uu.Outbox.AssertEmpty(t)
+
+		resp, err := uu.Query.TriggerGet(ctx, &trigger_spb.TriggerGetRequest{
+			TriggerId: TriggerID,
+		})
+		t.NoError(err)
+		t.Equal(int32(1), resp.Trigger.Data.FireCount)
+		t.Equal(nextTime.Add(time.Hour), resp.Trigger.Data.NextFireAt.AsTime())
+	})
+
+	flow.Step("ticks prune old fires from the ledger", func(ctx context.Context, t flowtest.Asserter) {
+		ctx = authtest.JWTContext(ctx)
+
+		t.Equal(2, uu.mustCountFires(ctx, t, TriggerID))
+
+		_, err := uu.TickTopic.SelfTick(ctx, &trigger_tpb.SelfTickMessage{
+			LastTick: timestamppb.New(tickTime.Add(24*time.Hour + 30*time.Second)),
+		})
+		t.NoError(err)
+
+		stmsg := &trigger_tpb.SelfTickMessage{}
+		uu.Outbox.PopMessage(t, stmsg)
+
+		t.Equal(1, uu.mustCountFires(ctx, t, TriggerID))
+	})
+}
+
+func TestSelfTickRetry(tt *testing.T) {
+	flow, uu := NewUniverse(tt)
+	defer flow.RunSteps(tt)
+
+	TriggerID := id62.NewString()
+	tickTime := time.Date(2025, 2, 17, 18, 0, 0, 0, time.UTC)
+
+	// sends the self tick for the time, returning the trigger replies
+	tick := func(ctx context.Context, t flowtest.Asserter, at time.Time, fires int) []*trigger_tpb.TriggerReplyMessage {
+		_, err := uu.TickTopic.SelfTick(ctx, &trigger_tpb.SelfTickMessage{
+			LastTick: timestamppb.New(at.Add(-5 * time.Second)),
+		})
+		t.NoError(err)
+
+		stmsg := &trigger_tpb.SelfTickMessage{}
+		uu.Outbox.PopMessage(t, stmsg)
+
+		replies := make([]*trigger_tpb.TriggerReplyMessage, fires)
+		for i := range replies {
+			replies[i] = &trigger_tpb.TriggerReplyMessage{}
+			uu.Outbox.PopMessage(t, replies[i])
+		}
+		uu.Outbox.AssertEmpty(t)
+
+		return replies
+	}
+
+	flow.Step("create trigger", func(ctx context.Context, t flowtest.Asserter) {
+		ctx = authtest.JWTContext(ctx)
+
+		err := uu.CreateTrigger(ctx, t, triggerConfig{
+			TriggerID:   TriggerID,
+			TriggerName: "testRetry",
+			Cron:        "0 * * * *",
+		})
+		t.NoError(err)
+
+		// schedules the trigger from the tick loop
+		tick(ctx, t, tickTime.Add(-5*time.Second), 0)
+	})
+
+	flow.Step("tick which fails for the trigger does not fire it", func(ctx context.Context, t flowtest.Asserter) {
+		ctx = authtest.JWTContext(ctx)
+
+		uu.mustFailFires(ctx, t, TriggerID)
+		tick(ctx, t, tickTime, 0)
+		uu.mustAllowFires(ctx, t)
+	})
+
+	flow.Step("next tick fires the missed time", func(ctx context.Context, t flowtest.Asserter) {
+		ctx = authtest.JWTContext(ctx)
+
+		replies := tick(ctx, t, tickTime.Add(5*time.Second), 1)
+		t.Equal(tickTime, replies[0].TickTime.AsTime())
+
+		resp, err := uu.Query.TriggerGet(ctx, &trigger_spb.TriggerGetRequest{
+			TriggerId: TriggerID,
+		})
+		t.NoError(err)
+		t.Equal(int32(1), resp.Trigger.Data.FireCount)
+		t.Equal(tickTime.Add(time.Hour), resp.Trigger.Data.NextFireAt.AsTime())
+	})
+}
+
+func TestSelfTickFaulted(tt *testing.T) {
+	flow, uu := NewUniverse(tt)
+	defer flow.RunSteps(tt)
+
+	GoodID := id62.NewString()
+	PoisonID := id62.NewString()
+	tickTime := time.Date(2025, 2, 17, 18, 0, 0, 0, time.UTC)
+
+	flow.Step("create triggers", func(ctx context.Context, t flowtest.Asserter) {
+		ctx = authtest.JWTContext(ctx)
+
+		for id, name := range map[string]string{GoodID: "testGood", PoisonID: "testPoison"} {
+			err := uu.CreateTrigger(ctx, t, triggerConfig{
+				TriggerID:   id,
+				TriggerName: name,
+				Cron:        "0 * * * *",
+			})
+			t.NoError(err)
+		}
+
+		uu.mustSetStoredCron(ctx, t, PoisonID, "0 * * *")
+	})
+
+	flow.Step("tick faults the poison trigger and fires the other", func(ctx context.Context, t flowtest.Asserter) {
+		ctx = authtest.JWTContext(ctx)
+
+		_, err := uu.TickTopic.SelfTick(ctx, &trigger_tpb.SelfTickMessage{
+			LastTick: timestamppb.New(tickTime.Add(-5 * time.Second)),
+		})
+		t.NoError(err)
+
+		stmsg := &trigger_tpb.SelfTickMessage{}
+		uu.Outbox.PopMessage(t, stmsg)
+
+		trmsg := &trigger_tpb.TriggerReplyMessage{}
+		uu.Outbox.PopMessage(t, trmsg)
+		t.Equal(tickTime, trmsg.TickTime.AsTime())
+
+		resp, err := uu.Query.TriggerEvents(ctx, &trigger_spb.TriggerEventsRequest{
+			TriggerId: PoisonID,
+		})
+		t.NoError(err)
+		t.NotEmpty(resp.Events[0].Event.GetFaulted().GetError())
+
+		get, err := uu.Query.TriggerGet(ctx, &trigger_spb.TriggerGetRequest{
+			TriggerId: PoisonID,
+		})
+		t.NoError(err)
+		t.Equal(trigger_pb.TriggerStatus_FAULTED, get.Trigger.Status)
+		t.Nil(get.Trigger.Data.NextFireAt)
+	})
+
+	flow.Step("resume is refused until the schedule is fixed", func(ctx context.Context, t flowtest.Asserter) {
+		ctx = authtest.JWTContext(ctx)
+
+		_, err := uu.TriggerCommand.ResumeTrigger(ctx, &trigger_spb.ResumeTriggerRequest{
+			TriggerId: PoisonID,
+		})
+		t.CodeError(err, codes.FailedPrecondition)
+	})
+
+	flow.Step("fix and resume the trigger", func(ctx context.Context, t flowtest.Asserter) {
+		ctx = authtest.JWTContext(ctx)
+
+		err := uu.UpdateTrigger(ctx, t, triggerConfig{
+			TriggerID:   PoisonID,
+			TriggerName: "testPoison",
+			Cron:        "30 * * * *",
+		})
+		t.NoError(err)
+
+		get, err := uu.Query.TriggerGet(ctx, &trigger_spb.TriggerGetRequest{
+			TriggerId: PoisonID,
+		})
+		t.NoError(err)
+		t.Equal(trigger_pb.TriggerStatus_FAULTED, get.Trigger.Status)
+
+		resp, err := uu.TriggerCommand.ResumeTrigger(ctx, &trigger_spb.ResumeTriggerRequest{
+			TriggerId: PoisonID,
+		})
+		t.NoError(err)
+		t.Equal(trigger_pb.TriggerStatus_ACTIVE, resp.Trigger.Status)
+		t.NotNil(resp.Trigger.Data.NextFireAt)
+	})
+}
+
 func TestInitSelfTick(tt *testing.T) {
 	flow, uu := NewUniverse(tt)
 	defer flow.RunSteps(tt)
@@ -145,3 +817,125 @@ func TestInitSelfTick(tt *testing.T) {
 		uu.Outbox.PopMessage(t, stmsg)
 	})
 }
+
+func TestTickStatus(tt *testing.T) {
+	flow, uu := NewUniverse(tt)
+	defer flow.RunSteps(tt)
+
+	tickTime := time.Date(2025, 2, 17, 18, 0, 0, 0, time.UTC)
+
+	flow.Step("no status before the first tick", func(ctx context.Context, t flowtest.Asserter) {
+		uu.mustTruncateSelfTable(ctx, t)
+
+		ctx = authtest.JWTContext(ctx)
+
+		resp, err := uu.Tick.TickStatus(ctx, &trigger_spb.TickStatusRequest{})
+		t.NoError(err)
+		t.Nil(resp.LastTick)
+	})
+
+	flow.Step("create trigger", func(ctx context.Context, t flowtest.Asserter) {
+		ctx = authtest.JWTContext(ctx)
+
+		err := uu.CreateTrigger(ctx, t, triggerConfig{
+			TriggerName: "testStatus",
+			Cron:        "0 * * * *",
+		})
+		t.NoError(err)
+	})
+
+	flow.Step("status reports the last tick", func(ctx context.Context, t flowtest.Asserter) {
+		ctx = authtest.JWTContext(ctx)
+
+		_, err := uu.TickTopic.SelfTick(ctx, &trigger_tpb.SelfTickMessage{
+			LastTick: timestamppb.New(tickTime.Add(-5 * time.Second)),
+		})
+		t.NoError(err)
+
+		stmsg := &trigger_tpb.SelfTickMessage{}
+		uu.Outbox.PopMessage(t, stmsg)
+
+		trmsg := &trigger_tpb.TriggerReplyMessage{}
+		uu.Outbox.PopMessage(t, trmsg)
+
+		resp, err := uu.Tick.TickStatus(ctx, &trigger_spb.TickStatusRequest{})
+		t.NoError(err)
+		t.Equal(tickTime, resp.LastTick.AsTime())
+		t.Equal(int32(1), resp.LastTickFired)
+		t.Equal(true, resp.LagSeconds > 0)
+	})
+}
+
+func TestSelfTickWatchdog(tt *testing.T) {
+	flow, uu := NewUniverse(tt)
+	defer flow.RunSteps(tt)
+
+	staleAfter := 5 * time.Minute
+
+	flow.Step("current chain is left alone", func(ctx context.Context, t flowtest.Asserter) {
+		uu.mustTruncateSelfTable(ctx, t)
+
+		err := uu.TriggerWorker.InitSelfTick(ctx)
+		t.NoError(err)
+
+		stmsg := &trigger_tpb.SelfTickMessage{}
+		uu.Outbox.PopMessage(t, stmsg)
+
+		recovered, err := uu.TriggerWorker.RecoverSelfTick(ctx, staleAfter)
+		t.NoError(err)
+		t.Equal(false, recovered)
+	})
+
+	lastTick := time.Now().In(time.UTC).Truncate(5 * time.Second).Add(-time.Hour)
+
+	flow.Step("lagging chain which still ticks is left alone", func(ctx context.Context, t flowtest.Asserter) {
+		uu.mustTruncateSelfTable(ctx, t)
+
+		err := uu.TriggerWorker.SendSelfTick(ctx, &lastTick, service.TickResult{})
+		t.NoError(err)
+
+		stmsg := &trigger_tpb.SelfTickMessage{}
+		uu.Outbox.PopMessage(t, stmsg)
+
+		recovered, err := uu.TriggerWorker.RecoverSelfTick(ctx, staleAfter)
+		t.NoError(err)
+		t.Equal(false, recovered)
+	})
+
+	flow.Step("stale chain is restarted from the last tick", func(ctx context.Context, t flowtest.Asserter) {
+		uu.mustSetTickedAt(ctx, t, time.Now().Add(-time.Hour))
+
+		recovered, err := uu.TriggerWorker.RecoverSelfTick(ctx, staleAfter)
+		t.NoError(err)
+		t.Equal(true, recovered)
+
+		recovery := &trigger_tpb.SelfTickMessage{}
+		uu.Outbox.PopMessage(t, recovery)
+		t.Equal(lastTick, recovery.LastTick.AsTime())
+	})
+
+	flow.Step("a second replica does not restart it again", func(ctx context.Context, t flowtest.Asserter) {
+		recovered, err := uu.TriggerWorker.RecoverSelfTick(ctx, staleAfter)
+		t.NoError(err)
+		t.Equal(false, recovered)
+	})
+
+	flow.Step("the recovered chain continues, the lost one does not fork it", func(ctx context.Context, t flowtest.Asserter) {
+		ctx = authtest.JWTContext(ctx)
+
+		msg := &trigger_tpb.SelfTickMessage{
+			LastTick: timestamppb.New(lastTick),
+		}
+
+		_, err := uu.TickTopic.SelfTick(ctx, msg)
+		t.NoError(err)
+
+		stmsg := &trigger_tpb.SelfTickMessage{}
+		uu.Outbox.PopMessage(t, stmsg)
+		t.Equal(lastTick.Add(5*time.Second), stmsg.LastTick.AsTime())
+
+		// the lost message turning up late is the same tick again
+		_, err = uu.TickTopic.SelfTick(ctx, msg)
+		t.NoError(err)
+	})
+}
@@ -2,14 +2,23 @@ package integration
 
 import (
 	"context"
+	"strings"
 	"testing"
 	"time"
 
+	sq "github.com/elgris/sqrl"
 	"github.com/pentops/flowtest"
+	"github.com/pentops/j5/gen/j5/list/v1/list_j5pb"
+	"github.com/pentops/j5/j5types/any_j5t"
 	"github.com/pentops/j5/lib/id62"
+	"github.com/pentops/j5/lib/psm"
 	"github.com/pentops/o5-auth/authtest"
+	"github.com/pentops/sqrlx.go/sqrlx"
 	"github.com/pentops/trigger/gen/o5/trigger/v1/trigger_spb"
 	"github.com/pentops/trigger/gen/o5/trigger/v1/trigger_tpb"
+	"github.com/pentops/trigger/states"
+	"github.com/pentops/trigger/utils"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
@@ -23,7 +32,7 @@ func TestManualTrigger(tt *testing.T) {
 	flow.Step("create trigger", func(ctx context.Context, t flowtest.Asserter) {
 		ctx = authtest.JWTContext(ctx)
 
-		err := uu.CreateTrigger(ctx, triggerConfig{
+		err := uu.CreateTrigger(ctx, t, triggerConfig{
 			TriggerID:   TriggerID,
 			AppName:     "test",
 			TriggerName: "TestCron",
@@ -56,3 +65,597 @@ func TestManualTrigger(tt *testing.T) {
 		t.Equal(TriggerTime, trmsg.TickTime)
 	})
 }
+
+func TestRunAtTrigger(tt *testing.T) {
+	flow, uu := NewUniverse(tt)
+	defer flow.RunSteps(tt)
+
+	TriggerID := id62.NewString()
+	runAt := time.Date(2025, 2, 17, 18, 30, 0, 0, time.UTC)
+	lastTick := runAt.Add(-5 * time.Second)
+
+	flow.Step("create trigger", func(ctx context.Context, t flowtest.Asserter) {
+		ctx = authtest.JWTContext(ctx)
+
+		err := uu.CreateTrigger(ctx, t, triggerConfig{
+			TriggerID:   TriggerID,
+			AppName:     "test",
+			TriggerName: "TestRunAt",
+			RunAt:       timestamppb.New(runAt),
+		})
+		t.NoError(err)
+
+		resp, err := uu.Query.TriggerGet(ctx, &trigger_spb.TriggerGetRequest{
+			TriggerId: TriggerID,
+		})
+		t.NoError(err)
+		t.Equal("ACTIVE", resp.Trigger.Status.ShortString())
+		t.Equal("", resp.Trigger.Data.Cron)
+	})
+
+	flow.Step("cron and run at together is rejected", func(ctx context.Context, t flowtest.Asserter) {
+		ctx = authtest.JWTContext(ctx)
+
+		err := uu.UpdateTrigger(ctx, t, triggerConfig{
+			TriggerID:   TriggerID,
+			AppName:     "test",
+			TriggerName: "TestRunAt",
+			Cron:        "0 * * * *",
+			RunAt:       timestamppb.New(runAt),
+		})
+		t.NotNil(err)
+	})
+
+	flow.Step("tick fires once and completes", func(ctx context.Context, t flowtest.Asserter) {
+		ctx = authtest.JWTContext(ctx)
+
+		_, err := uu.TickTopic.SelfTick(ctx, &trigger_tpb.SelfTickMessage{
+			LastTick: timestamppb.New(lastTick),
+		})
+		t.NoError(err)
+
+		stmsg := &trigger_tpb.SelfTickMessage{}
+		uu.Outbox.PopMessage(t, stmsg)
+
+		trmsg := &trigger_tpb.TriggerReplyMessage{}
+		uu.Outbox.PopMessage(t, trmsg)
+		t.Equal(runAt, trmsg.TickTime.AsTime())
+
+		resp, err := uu.Query.TriggerGet(ctx, &trigger_spb.TriggerGetRequest{
+			TriggerId: TriggerID,
+		})
+		t.NoError(err)
+		t.Equal("COMPLETED", resp.Trigger.Status.ShortString())
+	})
+
+	flow.Step("completed trigger does not fire again", func(ctx context.Context, t flowtest.Asserter) {
+		ctx = authtest.JWTContext(ctx)
+
+		_, err := uu.TickTopic.SelfTick(ctx, &trigger_tpb.SelfTickMessage{
+			LastTick: timestamppb.New(runAt),
+		})
+		t.NoError(err)
+
+		stmsg := &trigger_tpb.SelfTickMessage{}
+		uu.Outbox.PopMessage(t, stmsg)
+	})
+}
+
+func TestTriggerWindow(tt *testing.T) {
+	flow, uu := NewUniverse(tt)
+	defer flow.RunSteps(tt)
+
+	TriggerID := id62.NewString()
+	notBefore := time.Date(2025, 2, 17, 18, 30, 0, 0, time.UTC)
+	notAfter := time.Date(2025, 2, 17, 19, 0, 0, 0, time.UTC)
+
+	flow.Step("create trigger", func(ctx context.Context, t flowtest.Asserter) {
+		ctx = authtest.JWTContext(ctx)
+
+		err := uu.CreateTrigger(ctx, t, triggerConfig{
+			TriggerID:   TriggerID,
+			AppName:     "test",
+			TriggerName: "TestWindow",
+			Cron:        "0 * * * *",
+			NotBefore:   timestamppb.New(notBefore),
+			NotAfter:    timestamppb.New(notAfter),
+		})
+		t.NoError(err)
+	})
+
+	flow.Step("window ending before it starts is rejected", func(ctx context.Context, t flowtest.Asserter) {
+		ctx = authtest.JWTContext(ctx)
+
+		err := uu.UpdateTrigger(ctx, t, triggerConfig{
+			TriggerID:   TriggerID,
+			AppName:     "test",
+			TriggerName: "TestWindow",
+			Cron:        "0 * * * *",
+			NotBefore:   timestamppb.New(notAfter),
+			NotAfter:    timestamppb.New(notBefore),
+		})
+		t.NotNil(err)
+	})
+
+	flow.Step("tick before the window does not fire", func(ctx context.Context, t flowtest.Asserter) {
+		ctx = authtest.JWTContext(ctx)
+
+		_, err := uu.TickTopic.SelfTick(ctx, &trigger_tpb.SelfTickMessage{
+			LastTick: timestamppb.New(time.Date(2025, 2, 17, 17, 59, 55, 0, time.UTC)),
+		})
+		t.NoError(err)
+
+		stmsg := &trigger_tpb.SelfTickMessage{}
+		uu.Outbox.PopMessage(t, stmsg)
+	})
+
+	flow.Step("tick at the end of the window fires", func(ctx context.Context, t flowtest.Asserter) {
+		ctx = authtest.JWTContext(ctx)
+
+		_, err := uu.TickTopic.SelfTick(ctx, &trigger_tpb.SelfTickMessage{
+			LastTick: timestamppb.New(notAfter.Add(-5 * time.Second)),
+		})
+		t.NoError(err)
+
+		stmsg := &trigger_tpb.SelfTickMessage{}
+		uu.Outbox.PopMessage(t, stmsg)
+
+		trmsg := &trigger_tpb.TriggerReplyMessage{}
+		uu.Outbox.PopMessage(t, trmsg)
+		t.Equal(notAfter, trmsg.TickTime.AsTime())
+	})
+
+	flow.Step("tick after the window expires the trigger", func(ctx context.Context, t flowtest.Asserter) {
+		ctx = authtest.JWTContext(ctx)
+
+		_, err := uu.TickTopic.SelfTick(ctx, &trigger_tpb.SelfTickMessage{
+			LastTick: timestamppb.New(notAfter),
+		})
+		t.NoError(err)
+
+		stmsg := &trigger_tpb.SelfTickMessage{}
+		uu.Outbox.PopMessage(t, stmsg)
+
+		evtmsg := &trigger_tpb.TriggerEventMessage{}
+		uu.Outbox.PopMessage(t, evtmsg)
+		t.Equal(TriggerID, evtmsg.Keys.TriggerId)
+		t.Equal("EXPIRED", evtmsg.Status.ShortString())
+
+		resp, err := uu.Query.TriggerGet(ctx, &trigger_spb.TriggerGetRequest{
+			TriggerId: TriggerID,
+		})
+		t.NoError(err)
+		t.Equal("EXPIRED", resp.Trigger.Status.ShortString())
+	})
+}
+
+func TestTriggerWindowPaused(tt *testing.T) {
+	flow, uu := NewUniverse(tt)
+	defer flow.RunSteps(tt)
+
+	TriggerID := id62.NewString()
+	notAfter := time.Date(2025, 2, 17, 19, 0, 0, 0, time.UTC)
+
+	flow.Step("create and pause trigger", func(ctx context.Context, t flowtest.Asserter) {
+		ctx = authtest.JWTContext(ctx)
+
+		err := uu.CreateTrigger(ctx, t, triggerConfig{
+			TriggerID:   TriggerID,
+			AppName:     "test",
+			TriggerName: "TestWindowPaused",
+			Cron:        "0 * * * *",
+			NotAfter:    timestamppb.New(notAfter),
+		})
+		t.NoError(err)
+
+		_, err = uu.TriggerCommand.PauseTrigger(ctx, &trigger_spb.PauseTriggerRequest{
+			TriggerId: TriggerID,
+		})
+		t.NoError(err)
+	})
+
+	flow.Step("tick after the window expires the paused trigger", func(ctx context.Context, t flowtest.Asserter) {
+		ctx = authtest.JWTContext(ctx)
+
+		_, err := uu.TickTopic.SelfTick(ctx, &trigger_tpb.SelfTickMessage{
+			LastTick: timestamppb.New(notAfter),
+		})
+		t.NoError(err)
+
+		stmsg := &trigger_tpb.SelfTickMessage{}
+		uu.Outbox.PopMessage(t, stmsg)
+
+		evtmsg := &trigger_tpb.TriggerEventMessage{}
+		uu.Outbox.PopMessage(t, evtmsg)
+		t.Equal("EXPIRED", evtmsg.Status.ShortString())
+		uu.Outbox.AssertEmpty(t)
+
+		resp, err := uu.Query.TriggerGet(ctx, &trigger_spb.TriggerGetRequest{
+			TriggerId: TriggerID,
+		})
+		t.NoError(err)
+		t.Equal("EXPIRED", resp.Trigger.Status.ShortString())
+	})
+}
+
+func TestMaxFires(tt *testing.T) {
+	flow, uu := NewUniverse(tt)
+	defer flow.RunSteps(tt)
+
+	TriggerID := id62.NewString()
+	lastTick := time.Date(2025, 2, 17, 18, 29, 55, 0, time.UTC)
+
+	flow.Step("create trigger", func(ctx context.Context, t flowtest.Asserter) {
+		ctx = authtest.JWTContext(ctx)
+
+		err := uu.CreateTrigger(ctx, t, triggerConfig{
+			TriggerID:   TriggerID,
+			AppName:     "test",
+			TriggerName: "TestMaxFires",
+			Cron:        "*/5 * * * * *",
+			MaxFires:    proto.Int32(2),
+		})
+		t.NoError(err)
+	})
+
+	flow.Step("zero max fires is rejected", func(ctx context.Context, t flowtest.Asserter) {
+		ctx = authtest.JWTContext(ctx)
+
+		err := uu.UpdateTrigger(ctx, t, triggerConfig{
+			TriggerID:   TriggerID,
+			AppName:     "test",
+			TriggerName: "TestMaxFires",
+			Cron:        "*/5 * * * * *",
+			MaxFires:    proto.Int32(0),
+		})
+		t.NotNil(err)
+	})
+
+	flow.Step("first tick fires", func(ctx context.Context, t flowtest.Asserter) {
+		ctx = authtest.JWTContext(ctx)
+
+		_, err := uu.TickTopic.SelfTick(ctx, &trigger_tpb.SelfTickMessage{
+			LastTick: timestamppb.New(lastTick),
+		})
+		t.NoError(err)
+
+		stmsg := &trigger_tpb.SelfTickMessage{}
+		uu.Outbox.PopMessage(t, stmsg)
+
+		trmsg := &trigger_tpb.TriggerReplyMessage{}
+		uu.Outbox.PopMessage(t, trmsg)
+
+		resp, err := uu.Query.TriggerGet(ctx, &trigger_spb.TriggerGetRequest{
+			TriggerId: TriggerID,
+		})
+		t.NoError(err)
+		t.Equal("ACTIVE", resp.Trigger.Status.ShortString())
+		t.Equal(int32(1), resp.Trigger.Data.FireCount)
+	})
+
+	flow.Step("second tick fires and completes", func(ctx context.Context, t flowtest.Asserter) {
+		ctx = authtest.JWTContext(ctx)
+
+		_, err := uu.TickTopic.SelfTick(ctx, &trigger_tpb.SelfTickMessage{
+			LastTick: timestamppb.New(lastTick.Add(5 * time.Second)),
+		})
+		t.NoError(err)
+
+		stmsg := &trigger_tpb.SelfTickMessage{}
+		uu.Outbox.PopMessage(t, stmsg)
+
+		trmsg := &trigger_tpb.TriggerReplyMessage{}
+		uu.Outbox.PopMessage(t, trmsg)
+
+		resp, err := uu.Query.TriggerGet(ctx, &trigger_spb.TriggerGetRequest{
+			TriggerId: TriggerID,
+		})
+		t.NoError(err)
+		t.Equal("COMPLETED", resp.Trigger.Status.ShortString())
+		t.Equal(int32(2), resp.Trigger.Data.FireCount)
+	})
+
+	flow.Step("completed trigger does not fire again", func(ctx context.Context, t flowtest.Asserter) {
+		ctx = authtest.JWTContext(ctx)
+
+		_, err := uu.TickTopic.SelfTick(ctx, &trigger_tpb.SelfTickMessage{
+			LastTick: timestamppb.New(lastTick.Add(10 * time.Second)),
+		})
+		t.NoError(err)
+
+		stmsg := &trigger_tpb.SelfTickMessage{}
+		uu.Outbox.PopMessage(t, stmsg)
+	})
+}
+
+func TestMaxFiresLowered(tt *testing.T) {
+	flow, uu := NewUniverse(tt)
+	defer flow.RunSteps(tt)
+
+	TriggerID := id62.NewString()
+	lastTick := time.Date(2025, 2, 17, 18, 29, 55, 0, time.UTC)
+
+	flow.Step("create trigger and fire it", func(ctx context.Context, t flowtest.Asserter) {
+		ctx = authtest.JWTContext(ctx)
+
+		err := uu.CreateTrigger(ctx, t, triggerConfig{
+			TriggerID:   TriggerID,
+			AppName:     "test",
+			TriggerName: "TestMaxFiresLowered",
+			Cron:        "*/5 * * * * *",
+			MaxFires:    proto.Int32(3),
+		})
+		t.NoError(err)
+
+		_, err = uu.TickTopic.SelfTick(ctx, &trigger_tpb.SelfTickMessage{
+			LastTick: timestamppb.New(lastTick),
+		})
+		t.NoError(err)
+
+		stmsg := &trigger_tpb.SelfTickMessage{}
+		uu.Outbox.PopMessage(t, stmsg)
+
+		trmsg := &trigger_tpb.TriggerReplyMessage{}
+		uu.Outbox.PopMessage(t, trmsg)
+	})
+
+	flow.Step("lowering max fires to the fires so far completes the trigger", func(ctx context.Context, t flowtest.Asserter) {
+		ctx = authtest.JWTContext(ctx)
+
+		err := uu.UpdateTrigger(ctx, t, triggerConfig{
+			TriggerID:   TriggerID,
+			AppName:     "test",
+			TriggerName: "TestMaxFiresLowered",
+			Cron:        "*/5 * * * * *",
+			MaxFires:    proto.Int32(1),
+		})
+		t.NoError(err)
+
+		resp, err := uu.Query.TriggerGet(ctx, &trigger_spb.TriggerGetRequest{
+			TriggerId: TriggerID,
+		})
+		t.NoError(err)
+		t.Equal("COMPLETED", resp.Trigger.Status.ShortString())
+		t.Equal(int32(1), resp.Trigger.Data.FireCount)
+	})
+
+	flow.Step("completed trigger does not fire again", func(ctx context.Context, t flowtest.Asserter) {
+		ctx = authtest.JWTContext(ctx)
+
+		_, err := uu.TickTopic.SelfTick(ctx, &trigger_tpb.SelfTickMessage{
+			LastTick: timestamppb.New(lastTick.Add(5 * time.Second)),
+		})
+		t.NoError(err)
+
+		stmsg := &trigger_tpb.SelfTickMessage{}
+		uu.Outbox.PopMessage(t, stmsg)
+		uu.Outbox.AssertEmpty(t)
+	})
+}
+
+func TestTriggerPayload(tt *testing.T) {
+	flow, uu := NewUniverse(tt)
+	defer flow.RunSteps(tt)
+
+	TriggerID := id62.NewString()
+	tickTime := time.Date(2025, 2, 17, 18, 30, 0, 0, time.UTC)
+	payload := &any_j5t.Any{
+		TypeName: "test.v1.Report",
+		J5Json:   []byte(`{"reportId":"monthly"}`),
+	}
+
+	flow.Step("payload without a type is rejected", func(ctx context.Context, t flowtest.Asserter) {
+		ctx = authtest.JWTContext(ctx)
+
+		err := uu.CreateTrigger(ctx, t, triggerConfig{
+			TriggerID:   TriggerID,
+			TriggerName: "TestPayload",
+			Cron:        "30 18 * * *",
+			Payload: &any_j5t.Any{
+				J5Json: []byte(`{}`),
+			},
+		})
+		t.NotNil(err)
+	})
+
+	flow.Step("create trigger", func(ctx context.Context, t flowtest.Asserter) {
+		ctx = authtest.JWTContext(ctx)
+
+		err := uu.CreateTrigger(ctx, t, triggerConfig{
+			TriggerID:   TriggerID,
+			TriggerName: "TestPayload",
+			Cron:        "30 18 * * *",
+			Payload:     payload,
+		})
+		t.NoError(err)
+	})
+
+	flow.Step("tick reply has the payload", func(ctx context.Context, t flowtest.Asserter) {
+		ctx = authtest.JWTContext(ctx)
+
+		_, err := uu.TickTopic.SelfTick(ctx, &trigger_tpb.SelfTickMessage{
+			LastTick: timestamppb.New(tickTime.Add(-5 * time.Second)),
+		})
+		t.NoError(err)
+
+		stmsg := &trigger_tpb.SelfTickMessage{}
+		uu.Outbox.PopMessage(t, stmsg)
+
+		trmsg := &trigger_tpb.TriggerReplyMessage{}
+		uu.Outbox.PopMessage(t, trmsg)
+		t.Equal(payload.TypeName, trmsg.Payload.TypeName)
+		t.Equal(string(payload.J5Json), string(trmsg.Payload.J5Json))
+	})
+
+	flow.Step("manual reply has the payload", func(ctx context.Context, t flowtest.Asserter) {
+		ctx = authtest.JWTContext(ctx)
+
+		_, err := uu.TriggerCommand.ManuallyTrigger(ctx, &trigger_spb.ManuallyTriggerRequest{
+			TriggerId:   TriggerID,
+			TriggerTime: timestamppb.New(tickTime),
+		})
+		t.NoError(err)
+
+		trmsg := &trigger_tpb.TriggerReplyMessage{}
+		uu.Outbox.PopMessage(t, trmsg)
+		t.Equal(payload.TypeName, trmsg.Payload.TypeName)
+	})
+}
+
+func TestFireTimes(tt *testing.T) {
+	flow, uu := NewUniverse(tt)
+	defer flow.RunSteps(tt)
+
+	TriggerID := id62.NewString()
+	fireTime := time.Date(2025, 2, 17, 18, 0, 0, 0, time.UTC)
+
+	flow.Step("create trigger", func(ctx context.Context, t flowtest.Asserter) {
+		ctx = authtest.JWTContext(ctx)
+
+		err := uu.CreateTrigger(ctx, t, triggerConfig{
+			TriggerID:   TriggerID,
+			AppName:     "test",
+			TriggerName: "TestFireTimes",
+			Cron:        "0 * * * *",
+		})
+		t.NoError(err)
+
+		resp, err := uu.Query.TriggerGet(ctx, &trigger_spb.TriggerGetRequest{
+			TriggerId: TriggerID,
+		})
+		t.NoError(err)
+		t.Nil(resp.Trigger.Data.LastFiredAt)
+		t.NotNil(resp.Trigger.Data.NextFireAt)
+		t.Equal(0, resp.Trigger.Data.NextFireAt.AsTime().Minute())
+	})
+
+	flow.Step("tick records the fire times", func(ctx context.Context, t flowtest.Asserter) {
+		ctx = authtest.JWTContext(ctx)
+
+		_, err := uu.TickTopic.SelfTick(ctx, &trigger_tpb.SelfTickMessage{
+			LastTick: timestamppb.New(fireTime.Add(-5 * time.Second)),
+		})
+		t.NoError(err)
+
+		stmsg := &trigger_tpb.SelfTickMessage{}
+		uu.Outbox.PopMessage(t, stmsg)
+
+		trmsg := &trigger_tpb.TriggerReplyMessage{}
+		uu.Outbox.PopMessage(t, trmsg)
+
+		resp, err := uu.Query.TriggerGet(ctx, &trigger_spb.TriggerGetRequest{
+			TriggerId: TriggerID,
+		})
+		t.NoError(err)
+		t.Equal(fireTime, resp.Trigger.Data.LastFiredAt.AsTime())
+		t.Equal(fireTime.Add(time.Hour), resp.Trigger.Data.NextFireAt.AsTime())
+	})
+
+	flow.Step("due only from the next fire time", func(ctx context.Context, t flowtest.Asserter) {
+		isDue := func(tick time.Time) bool {
+			due, err := uu.TriggerWorker.DueTriggers(ctx, tick)
+			t.NoError(err)
+			for _, state := range due {
+				if state.Keys.TriggerId == TriggerID {
+					return true
+				}
+			}
+			return false
+		}
+
+		t.Equal(false, isDue(fireTime.Add(59*time.Minute)))
+		t.Equal(true, isDue(fireTime.Add(time.Hour)))
+	})
+
+	flow.Step("list sorts by next fire time", func(ctx context.Context, t flowtest.Asserter) {
+		ctx = authtest.JWTContext(ctx)
+
+		resp, err := uu.Query.TriggerList(ctx, &trigger_spb.TriggerListRequest{
+			Query: &list_j5pb.QueryRequest{
+				Sorts: []*list_j5pb.Sort{{
+					Field: "data.nextFireAt",
+				}},
+			},
+		})
+		t.NoError(err)
+		t.NotEmpty(resp.Trigger)
+		t.Equal(TriggerID, resp.Trigger[0].Keys.TriggerId)
+	})
+
+	flow.Step("list sort uses the next fire time index", func(ctx context.Context, t flowtest.Asserter) {
+		ctx = authtest.JWTContext(ctx)
+
+		sm, err := states.NewTriggerStateMachine()
+		t.NoError(err)
+
+		querySet, err := trigger_spb.NewTriggerPSMQuerySet(trigger_spb.DefaultTriggerPSMQuerySpec(sm.StateTableSpec()), psm.StateQueryOptions{})
+		t.NoError(err)
+
+		var listQuery sqrlx.Sqlizer
+		querySet.SetQueryLogger(func(query sqrlx.Sqlizer) {
+			listQuery = query
+		})
+
+		_, err = trigger_spb.NewTriggerQueryServiceImpl(uu.db, querySet).TriggerList(ctx, &trigger_spb.TriggerListRequest{
+			Query: &list_j5pb.QueryRequest{
+				Sorts: []*list_j5pb.Sort{{
+					Field: "data.nextFireAt",
+				}},
+			},
+		})
+		t.NoError(err)
+		t.NotNil(listQuery)
+
+		statement, args, err := listQuery.ToSql()
+		t.NoError(err)
+
+		var plan strings.Builder
+		err = uu.db.Transact(ctx, utils.MutableTxOptions, func(ctx context.Context, tx sqrlx.Transaction) error {
+			// the table is too small for an index to beat a scan otherwise
+			if _, err := tx.ExecRaw(ctx, "SET LOCAL enable_seqscan = off"); err != nil {
+				return err
+			}
+
+			rows, err := tx.Query(ctx, sq.Expr("EXPLAIN "+statement, args...))
+			if err != nil {
+				return err
+			}
+			defer rows.Close()
+
+			for rows.Next() {
+				var line string
+				if err := rows.Scan(&line); err != nil {
+					return err
+				}
+				plan.WriteString(line + "\n")
+			}
+			return rows.Err()
+		})
+		t.NoError(err)
+
+		if !strings.Contains(plan.String(), "trigger_next_fire_at_idx") {
+			t.Fatalf("list query does not use the next fire time index:\n%s", plan.String())
+		}
+	})
+
+	flow.Step("pause clears the next fire time", func(ctx context.Context, t flowtest.Asserter) {
+		ctx = authtest.JWTContext(ctx)
+
+		resp, err := uu.TriggerCommand.PauseTrigger(ctx, &trigger_spb.PauseTriggerRequest{
+			TriggerId: TriggerID,
+		})
+		t.NoError(err)
+		t.Nil(resp.Trigger.Data.NextFireAt)
+		t.Equal(fireTime, resp.Trigger.Data.LastFiredAt.AsTime())
+	})
+
+	flow.Step("resume sets the next fire time", func(ctx context.Context, t flowtest.Asserter) {
+		ctx = authtest.JWTContext(ctx)
+
+		resp, err := uu.TriggerCommand.ResumeTrigger(ctx, &trigger_spb.ResumeTriggerRequest{
+			TriggerId: TriggerID,
+		})
+		t.NoError(err)
+		t.NotNil(resp.Trigger.Data.NextFireAt)
+	})
+}
@@ -4,10 +4,12 @@ import (
 	"context"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/pentops/flowtest"
 	"github.com/pentops/j5/gen/j5/messaging/v1/messaging_j5pb"
 	"github.com/pentops/j5/gen/j5/state/v1/psm_j5pb"
+	"github.com/pentops/j5/j5types/any_j5t"
 	"github.com/pentops/j5/lib/id62"
 	"github.com/pentops/log.go/log"
 	"github.com/pentops/o5-messaging/outbox/outboxtest"
@@ -18,19 +20,29 @@ import (
 	"github.com/pentops/trigger/gen/o5/trigger/v1/trigger_tpb"
 	"github.com/pentops/trigger/service"
 	"github.com/pentops/trigger/utils"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 type Universe struct {
 	db *sqrlx.Wrapper
 
-	SM             *trigger_pb.TriggerPSM
-	Query          trigger_spb.TriggerQueryServiceClient
-	TriggerTopic   trigger_tpb.TriggerPublishTopicClient
-	TriggerCommand trigger_spb.TriggerCommandServiceClient
-	TickTopic      trigger_tpb.SelfTickTopicClient
-	TriggerWorker  *service.TriggerWorker
+	SM              *trigger_pb.TriggerPSM
+	Query           trigger_spb.TriggerQueryServiceClient
+	CalendarQuery   trigger_spb.CalendarQueryServiceClient
+	TriggerTopic    trigger_tpb.TriggerPublishTopicClient
+	TriggerCommand  trigger_spb.TriggerCommandServiceClient
+	CalendarCommand trigger_spb.CalendarCommandServiceClient
+	Preview         trigger_spb.TriggerPreviewServiceClient
+	Tick            trigger_spb.TriggerTickServiceClient
+	TickTopic       trigger_tpb.SelfTickTopicClient
+	TriggerWorker   *service.TriggerWorker
 
 	Outbox *outboxtest.OutboxAsserter
+
+	// now is the wall clock triggers are scheduled from. It is zero unless a
+	// test sets it, so triggers are scheduled from the last tick the test
+	// sent, as tests tick at fixed times in the past.
+	now time.Time
 }
 
 func NewUniverse(t *testing.T) (*flowtest.Stepper[*testing.T], *Universe) {
@@ -73,11 +85,19 @@ func setupUniverse(ctx context.Context, t flowtest.Asserter, uu *Universe) {
 
 	uu.SM = svc.SM
 	uu.Query = trigger_spb.NewTriggerQueryServiceClient(grpcPair.Client)
+	uu.CalendarQuery = trigger_spb.NewCalendarQueryServiceClient(grpcPair.Client)
 	uu.TriggerTopic = trigger_tpb.NewTriggerPublishTopicClient(grpcPair.Client)
 	uu.TriggerCommand = trigger_spb.NewTriggerCommandServiceClient(grpcPair.Client)
+	uu.CalendarCommand = trigger_spb.NewCalendarCommandServiceClient(grpcPair.Client)
+	uu.Preview = trigger_spb.NewTriggerPreviewServiceClient(grpcPair.Client)
+	uu.Tick = trigger_spb.NewTriggerTickServiceClient(grpcPair.Client)
 	uu.TickTopic = trigger_tpb.NewSelfTickTopicClient(grpcPair.Client)
 	uu.TriggerWorker = svc.TriggerWorker
 
+	svc.SetClock(func() time.Time {
+		return uu.now
+	})
+
 	svc.RegisterGRPC(grpcPair.Server)
 
 	grpcPair.ServeUntilDone(t, ctx)
@@ -103,10 +123,22 @@ type triggerConfig struct {
 	TriggerName     string
 	AppName         string
 	Cron            string
+	Timezone        string
+	DSTPolicy       trigger_pb.DSTPolicy
+	JitterSeconds   int32
+	CalendarID      *string
+	CalendarRoll    trigger_pb.CalendarRoll
+	Payload         *any_j5t.Any
+	CatchUp         trigger_pb.CatchUpPolicy
+	RunAt           *timestamppb.Timestamp
+	Interval        *trigger_pb.Interval
+	NotBefore       *timestamppb.Timestamp
+	NotAfter        *timestamppb.Timestamp
+	MaxFires        *int32
 	RequestMetadata *messaging_j5pb.RequestMetadata
 }
 
-func (uu *Universe) CreateTrigger(ctx context.Context, config triggerConfig) error {
+func (uu *Universe) CreateTrigger(ctx context.Context, t flowtest.Asserter, config triggerConfig) error {
 	requestMetadata := &messaging_j5pb.RequestMetadata{
 		ReplyTo: "test",
 		Context: []byte("testContext"),
@@ -128,6 +160,8 @@ func (uu *Universe) CreateTrigger(ctx context.Context, config triggerConfig) err
 	cron := "0 * * * *"
 	if config.Cron != "" {
 		cron = config.Cron
+	} else if config.RunAt != nil || config.Interval != nil {
+		cron = ""
 	}
 
 	var generatedTriggerID = id62.New().String()
@@ -142,10 +176,22 @@ func (uu *Universe) CreateTrigger(ctx context.Context, config triggerConfig) err
 		Action: &trigger_pb.ActionType{
 			Type: &trigger_pb.ActionType_Create_{
 				Create: &trigger_pb.ActionType_Create{
-					TriggerId:   triggerID,
-					TriggerName: triggerName,
-					AppName:     appName,
-					Cron:        cron,
+					TriggerId:     triggerID,
+					TriggerName:   triggerName,
+					AppName:       appName,
+					Cron:          cron,
+					Timezone:      config.Timezone,
+					DstPolicy:     config.DSTPolicy,
+					JitterSeconds: config.JitterSeconds,
+					CalendarId:    config.CalendarID,
+					CalendarRoll:  config.CalendarRoll,
+					Payload:       config.Payload,
+					CatchUp:       config.CatchUp,
+					RunAt:         config.RunAt,
+					Interval:      config.Interval,
+					NotBefore:     config.NotBefore,
+					NotAfter:      config.NotAfter,
+					MaxFires:      config.MaxFires,
 				},
 			},
 		},
@@ -156,10 +202,16 @@ func (uu *Universe) CreateTrigger(ctx context.Context, config triggerConfig) err
 		return fmt.Errorf("failed to universe create trigger: %w", err)
 	}
 
+	reply := &trigger_tpb.TriggerManageReplyMessage{}
+	uu.Outbox.PopMessage(t, reply)
+	if !reply.Success {
+		return fmt.Errorf("failed to universe create trigger: %s", reply.GetError())
+	}
+
 	return nil
 }
 
-func (uu *Universe) UpdateTrigger(ctx context.Context, config triggerConfig) error {
+func (uu *Universe) UpdateTrigger(ctx context.Context, t flowtest.Asserter, config triggerConfig) error {
 	requestMetadata := &messaging_j5pb.RequestMetadata{
 		ReplyTo: "test",
 		Context: []byte("testContext"),
@@ -181,6 +233,8 @@ func (uu *Universe) UpdateTrigger(ctx context.Context, config triggerConfig) err
 	cron := "0 * * * *"
 	if config.Cron != "" {
 		cron = config.Cron
+	} else if config.RunAt != nil || config.Interval != nil {
+		cron = ""
 	}
 
 	triggerID := id62.New().String()
@@ -193,10 +247,22 @@ func (uu *Universe) UpdateTrigger(ctx context.Context, config triggerConfig) err
 		Action: &trigger_pb.ActionType{
 			Type: &trigger_pb.ActionType_Update_{
 				Update: &trigger_pb.ActionType_Update{
-					TriggerId:   triggerID,
-					TriggerName: triggerName,
-					AppName:     appName,
-					Cron:        cron,
+					TriggerId:     triggerID,
+					TriggerName:   triggerName,
+					AppName:       appName,
+					Cron:          cron,
+					Timezone:      config.Timezone,
+					DstPolicy:     config.DSTPolicy,
+					JitterSeconds: config.JitterSeconds,
+					CalendarId:    config.CalendarID,
+					CalendarRoll:  config.CalendarRoll,
+					Payload:       config.Payload,
+					CatchUp:       config.CatchUp,
+					RunAt:         config.RunAt,
+					Interval:      config.Interval,
+					NotBefore:     config.NotBefore,
+					NotAfter:      config.NotAfter,
+					MaxFires:      config.MaxFires,
 				},
 			},
 		},
@@ -207,9 +273,76 @@ func (uu *Universe) UpdateTrigger(ctx context.Context, config triggerConfig) err
 		return fmt.Errorf("failed to universe update trigger: %w", err)
 	}
 
+	reply := &trigger_tpb.TriggerManageReplyMessage{}
+	uu.Outbox.PopMessage(t, reply)
+	if !reply.Success {
+		return fmt.Errorf("failed to universe update trigger: %s", reply.GetError())
+	}
+
 	return nil
 }
 
+// ManageTrigger sends the action through the manage topic and returns the
+// reply.
+func (uu *Universe) ManageTrigger(ctx context.Context, t flowtest.Asserter, action *trigger_pb.ActionType) *trigger_tpb.TriggerManageReplyMessage {
+	_, err := uu.TriggerWorker.TriggerManageRequest(ctx, &trigger_tpb.TriggerManageRequestMessage{
+		Request: &messaging_j5pb.RequestMetadata{
+			ReplyTo: "test",
+			Context: []byte("testContext"),
+		},
+		Action: action,
+	})
+	t.NoError(err)
+
+	reply := &trigger_tpb.TriggerManageReplyMessage{}
+	uu.Outbox.PopMessage(t, reply)
+	return reply
+}
+
+func (uu *Universe) SyncTriggers(ctx context.Context, t flowtest.Asserter, appName string, triggers ...*trigger_pb.TriggerDefinition) error {
+	req := &trigger_tpb.TriggerManageRequestMessage{
+		Request: &messaging_j5pb.RequestMetadata{
+			ReplyTo: "test",
+			Context: []byte("testContext"),
+		},
+		Action: &trigger_pb.ActionType{
+			Type: &trigger_pb.ActionType_Sync_{
+				Sync: &trigger_pb.ActionType_Sync{
+					AppName:  appName,
+					Triggers: triggers,
+				},
+			},
+		},
+	}
+
+	_, err := uu.TriggerWorker.TriggerManageRequest(ctx, req)
+	if err != nil {
+		return fmt.Errorf("failed to universe sync triggers: %w", err)
+	}
+
+	reply := &trigger_tpb.TriggerManageReplyMessage{}
+	uu.Outbox.PopMessage(t, reply)
+	if !reply.Success {
+		return fmt.Errorf("failed to universe sync triggers: %s", reply.GetError())
+	}
+
+	return nil
+}
+
+// AppTriggers returns the status of each trigger of the app by trigger name.
+func (uu *Universe) AppTriggers(ctx context.Context, t flowtest.Asserter, appName string) map[string][]string {
+	resp, err := uu.Query.TriggerList(ctx, &trigger_spb.TriggerListRequest{})
+	t.NoError(err)
+
+	statuses := map[string][]string{}
+	for _, trigger := range resp.Trigger {
+		if trigger.Data.AppName == appName {
+			statuses[trigger.Data.TriggerName] = append(statuses[trigger.Data.TriggerName], trigger.Status.ShortString())
+		}
+	}
+	return statuses
+}
+
 func (uu *Universe) ArchiveTrigger(ctx context.Context, triggerID string) error {
 	evt := &trigger_pb.TriggerPSMEventSpec{
 		Keys: &trigger_pb.TriggerKeys{
@@ -248,3 +381,82 @@ func (uu *Universe) mustTruncateSelfTable(ctx context.Context, t flowtest.Assert
 		t.Fatalf("failed mustTruncateSelfTable: %v", err)
 	}
 }
+
+// mustSetTickedAt sets when the self tick chain last advanced, as if no tick
+// has continued it since.
+func (uu *Universe) mustSetTickedAt(ctx context.Context, t flowtest.Asserter, tickedAt time.Time) {
+	err := uu.db.Transact(ctx, utils.MutableTxOptions, func(ctx context.Context, tx sqrlx.Transaction) error {
+		_, err := tx.ExecRaw(ctx, "UPDATE selftick SET ticked_at = $1 WHERE selftick_id = 'selftick'", tickedAt)
+		return err
+	})
+	if err != nil {
+		t.Fatalf("failed mustSetTickedAt: %v", err)
+	}
+}
+
+// mustSetStoredCron overwrites the cron of the stored trigger state, skipping
+// the state machine, as if it was stored by a version which parsed it.
+func (uu *Universe) mustSetStoredCron(ctx context.Context, t flowtest.Asserter, triggerID string, cron string) {
+	err := uu.db.Transact(ctx, utils.MutableTxOptions, func(ctx context.Context, tx sqrlx.Transaction) error {
+		_, err := tx.ExecRaw(ctx, "UPDATE trigger SET state = jsonb_set(state, '{data,cron}', to_jsonb($1::text)) WHERE trigger_id = $2", cron, triggerID)
+		return err
+	})
+	if err != nil {
+		t.Fatalf("failed mustSetStoredCron: %v", err)
+	}
+}
+
+// mustFailFires makes recording a fire of the trigger fail, as a transient
+// database error would, until mustAllowFires.
+func (uu *Universe) mustFailFires(ctx context.Context, t flowtest.Asserter, triggerID string) {
+	err := uu.db.Transact(ctx, utils.MutableTxOptions, func(ctx context.Context, tx sqrlx.Transaction) error {
+		_, err := tx.ExecRaw(ctx, "CREATE FUNCTION fail_fire() RETURNS trigger LANGUAGE plpgsql AS $$ BEGIN RAISE EXCEPTION 'fire failed'; END $$")
+		if err != nil {
+			return err
+		}
+		_, err = tx.ExecRaw(ctx, fmt.Sprintf("CREATE TRIGGER fail_fire BEFORE INSERT ON trigger_fire FOR EACH ROW WHEN (NEW.trigger_id = '%s') EXECUTE FUNCTION fail_fire()", triggerID))
+		return err
+	})
+	if err != nil {
+		t.Fatalf("failed mustFailFires: %v", err)
+	}
+}
+
+// mustAllowFires undoes mustFailFires.
+func (uu *Universe) mustAllowFires(ctx context.Context, t flowtest.Asserter) {
+	err := uu.db.Transact(ctx, utils.MutableTxOptions, func(ctx context.Context, tx sqrlx.Transaction) error {
+		_, err := tx.ExecRaw(ctx, "DROP TRIGGER fail_fire ON trigger_fire")
+		if err != nil {
+			return err
+		}
+		_, err = tx.ExecRaw(ctx, "DROP FUNCTION fail_fire()")
+		return err
+	})
+	if err != nil {
+		t.Fatalf("failed mustAllowFires: %v", err)
+	}
+}
+
+// mustRecordFire adds a fire of the trigger to the trigger_fire ledger, as a
+// tick which fired it would.
+func (uu *Universe) mustRecordFire(ctx context.Context, t flowtest.Asserter, triggerID string, triggerTime time.Time) {
+	err := uu.db.Transact(ctx, utils.MutableTxOptions, func(ctx context.Context, tx sqrlx.Transaction) error {
+		_, err := tx.ExecRaw(ctx, "INSERT INTO trigger_fire (trigger_id, trigger_time) VALUES ($1, $2)", triggerID, triggerTime)
+		return err
+	})
+	if err != nil {
+		t.Fatalf("failed mustRecordFire: %v", err)
+	}
+}
+
+// mustCountFires returns how many fires of the trigger are in the ledger.
+func (uu *Universe) mustCountFires(ctx context.Context, t flowtest.Asserter, triggerID string) int {
+	var count int
+	err := uu.db.Transact(ctx, utils.ReadOnlyTxOptions, func(ctx context.Context, tx sqrlx.Transaction) error {
+		return tx.QueryRowRaw(ctx, "SELECT count(*) FROM trigger_fire WHERE trigger_id = $1", triggerID).Scan(&count)
+	})
+	if err != nil {
+		t.Fatalf("failed mustCountFires: %v", err)
+	}
+	return count
+}
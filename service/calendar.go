@@ -0,0 +1,334 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	sq "github.com/elgris/sqrl"
+	"github.com/pentops/j5/gen/j5/state/v1/psm_j5pb"
+	"github.com/pentops/j5/lib/id62"
+	"github.com/pentops/j5/lib/j5codec"
+	"github.com/pentops/sqrlx.go/sqrlx"
+	"github.com/pentops/trigger/gen/o5/trigger/v1/trigger_pb"
+	"github.com/pentops/trigger/gen/o5/trigger/v1/trigger_tpb"
+	"github.com/pentops/trigger/states"
+	"github.com/pentops/trigger/utils"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// maxRollDays is how far back a fire time can be rolled forward from, which
+// bounds the search when a calendar excludes a long run of dates.
+const maxRollDays = 31
+
+// AllCalendars returns the data of every active calendar by calendar ID.
+func (w TriggerWorker) AllCalendars(ctx context.Context) (map[string]*trigger_pb.CalendarData, error) {
+	calendars := map[string]*trigger_pb.CalendarData{}
+
+	if err := w.db.Transact(ctx, utils.ReadOnlyTxOptions, func(ctx context.Context, tx sqrlx.Transaction) error {
+		rows, err := tx.Query(
+			ctx,
+			sq.Select("state").
+				From("calendar").
+				Where("state->>'status' = 'ACTIVE'"),
+		)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return nil
+			}
+			return fmt.Errorf("error while getting active calendars %v", err)
+		}
+
+		defer rows.Close()
+
+		for rows.Next() {
+			var data []byte
+			if err := rows.Scan(&data); err != nil {
+				return fmt.Errorf("failed to scan active calendar row %v", err)
+			}
+			cal := &trigger_pb.CalendarState{}
+			if err := j5codec.Global.JSONToProto(data, cal.ProtoReflect()); err != nil {
+				return fmt.Errorf("failed to unmarshal calendar state %v", err)
+			}
+
+			calendars[cal.Keys.CalendarId] = cal.Data
+		}
+
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return calendars, nil
+}
+
+// activeCalendar returns the data of the calendar, or nil when it is archived
+// or missing, as those exclude nothing.
+func activeCalendar(ctx context.Context, tx sqrlx.Transaction, calendarID string) (*trigger_pb.CalendarData, error) {
+	query := sq.Select("state").
+		From("calendar").
+		Where("calendar_id = ?", calendarID).
+		Where("state->>'status' = 'ACTIVE'")
+
+	var data []byte
+	if err := tx.QueryRow(ctx, query).Scan(&data); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get calendar %s: %w", calendarID, err)
+	}
+
+	cal := &trigger_pb.CalendarState{}
+	if err := j5codec.Global.JSONToProto(data, cal.ProtoReflect()); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal calendar state %v", err)
+	}
+
+	return cal.Data, nil
+}
+
+// checkCalendarActive returns ErrNotFound, rejecting the request, unless the
+// calendar exists and is active.
+func (w TriggerWorker) checkCalendarActive(ctx context.Context, calendarID string) error {
+	query := sq.Select("calendar_id").
+		From("calendar").
+		Where("calendar_id = ?", calendarID).
+		Where("state->>'status' = 'ACTIVE'")
+
+	if err := w.db.Transact(ctx, utils.ReadOnlyTxOptions, func(ctx context.Context, tx sqrlx.Transaction) error {
+		var id string
+		return tx.QueryRow(ctx, query).Scan(&id)
+	}); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return states.Reject(ErrNotFound)
+		}
+		return err
+	}
+
+	return nil
+}
+
+func (w *TriggerWorker) manageCalendar(ctx context.Context, req *trigger_tpb.TriggerManageRequestMessage) (*emptypb.Empty, error) {
+	var evt *trigger_pb.CalendarPSMEventSpec
+
+	switch req.Action.Type.(type) {
+	case *trigger_pb.ActionType_CreateCalendar_:
+		calendarID := id62.NewString()
+		if req.Action.GetCreateCalendar().CalendarId != nil {
+			calendarID = *req.Action.GetCreateCalendar().CalendarId
+		}
+
+		evt = &trigger_pb.CalendarPSMEventSpec{
+			Keys: &trigger_pb.CalendarKeys{
+				CalendarId: calendarID,
+			},
+			Cause: &psm_j5pb.Cause{
+				Type: &psm_j5pb.Cause_ExternalEvent{
+					ExternalEvent: &psm_j5pb.ExternalEventCause{
+						SystemName: "trigger",
+						EventName:  "calendar_create",
+					},
+				},
+			},
+			Event: &trigger_pb.CalendarEventType_Created{
+				Name:            req.Action.GetCreateCalendar().Name,
+				ExcludedDates:   req.Action.GetCreateCalendar().ExcludedDates,
+				ExcludeWeekends: req.Action.GetCreateCalendar().ExcludeWeekends,
+			},
+		}
+
+	case *trigger_pb.ActionType_UpdateCalendar_:
+		evt = &trigger_pb.CalendarPSMEventSpec{
+			Keys: &trigger_pb.CalendarKeys{
+				CalendarId: req.Action.GetUpdateCalendar().CalendarId,
+			},
+			Cause: &psm_j5pb.Cause{
+				Type: &psm_j5pb.Cause_ExternalEvent{
+					ExternalEvent: &psm_j5pb.ExternalEventCause{
+						SystemName: "trigger",
+						EventName:  "calendar_update",
+					},
+				},
+			},
+			Event: &trigger_pb.CalendarEventType_Updated{
+				Name:            req.Action.GetUpdateCalendar().Name,
+				ExcludedDates:   req.Action.GetUpdateCalendar().ExcludedDates,
+				ExcludeWeekends: req.Action.GetUpdateCalendar().ExcludeWeekends,
+			},
+		}
+
+	case *trigger_pb.ActionType_ArchiveCalendar_:
+		evt = &trigger_pb.CalendarPSMEventSpec{
+			Keys: &trigger_pb.CalendarKeys{
+				CalendarId: req.Action.GetArchiveCalendar().CalendarId,
+			},
+			Cause: &psm_j5pb.Cause{
+				Type: &psm_j5pb.Cause_ExternalEvent{
+					ExternalEvent: &psm_j5pb.ExternalEventCause{
+						SystemName: "trigger",
+						EventName:  "calendar_archive",
+					},
+				},
+			},
+			Event: &trigger_pb.CalendarEventType_Archived{},
+		}
+
+	default:
+		return nil, fmt.Errorf("unknown calendar action type %T", req.Action.Type)
+	}
+
+	err := w.db.Transact(ctx, utils.MutableTxOptions, func(ctx context.Context, tx sqrlx.Transaction) error {
+		state, err := w.calendarSM.TransitionInTx(ctx, tx, evt)
+		if err != nil {
+			return err
+		}
+
+		if err := rescheduleCalendarTriggers(ctx, tx, w.sm, state.Keys.CalendarId); err != nil {
+			return err
+		}
+
+		return w.sendManageReply(ctx, tx, req, &trigger_tpb.TriggerManageReplyMessage{
+			CalendarId: &state.Keys.CalendarId,
+			Success:    true,
+		})
+	})
+	if err != nil {
+		return w.rejectManageRequest(ctx, req, &trigger_tpb.TriggerManageReplyMessage{
+			CalendarId: &evt.Keys.CalendarId,
+		}, err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// rescheduleCalendarTriggers moves the next fire times of the active triggers
+// on the calendar after it changes, as the dates it excludes decide when they
+// fire.
+func rescheduleCalendarTriggers(ctx context.Context, tx sqrlx.Transaction, sm *trigger_pb.TriggerPSM, calendarID string) error {
+	lastTick, err := lastTickTime(ctx, tx)
+	if err != nil {
+		return err
+	}
+
+	triggers, err := calendarTriggers(ctx, tx, calendarID)
+	if err != nil {
+		return err
+	}
+
+	for _, state := range triggers {
+		err := rescheduleTrigger(ctx, tx, sm, state, lastTick, &psm_j5pb.Cause{
+			Type: &psm_j5pb.Cause_ExternalEvent{
+				ExternalEvent: &psm_j5pb.ExternalEventCause{
+					SystemName: "trigger",
+					EventName:  "calendar_change",
+				},
+			},
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// calendarTriggers returns the state of every active trigger on the calendar.
+func calendarTriggers(ctx context.Context, tx sqrlx.Transaction, calendarID string) ([]*trigger_pb.TriggerState, error) {
+	var triggers []*trigger_pb.TriggerState
+
+	rows, err := tx.Query(
+		ctx,
+		sq.Select("state").
+			From("trigger").
+			Where("state->>'status' = 'ACTIVE'").
+			Where("state->'data'->>'calendarId' = ?", calendarID),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("error while getting calendar triggers %v", err)
+	}
+
+	defer rows.Close()
+
+	for rows.Next() {
+		var data []byte
+		if err := rows.Scan(&data); err != nil {
+			return nil, fmt.Errorf("failed to scan calendar trigger row %v", err)
+		}
+		tm := &trigger_pb.TriggerState{}
+		if err := j5codec.Global.JSONToProto(data, tm.ProtoReflect()); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal trigger state %v", err)
+		}
+
+		triggers = append(triggers, tm)
+	}
+
+	return triggers, rows.Err()
+}
+
+// isBusinessDay reports whether the date of the local time is not excluded by
+// the calendar.
+func isBusinessDay(calendar *trigger_pb.CalendarData, local time.Time) bool {
+	if calendar.ExcludeWeekends {
+		if day := local.Weekday(); day == time.Saturday || day == time.Sunday {
+			return false
+		}
+	}
+
+	year, month, day := local.Date()
+	for _, excluded := range calendar.ExcludedDates {
+		if excluded.Year == int32(year) && excluded.Month == int32(month) && excluded.Day == int32(day) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// checkCalendar applies the trigger's calendar to the fire time found for this
+// tick. Nothing fires on an excluded date. When the calendar roll is
+// NEXT_BUSINESS_DAY, a business day also fires for the same time on the run of
+// excluded dates before it, returning the latest of those fire times and
+// rolled as true.
+func checkCalendar(data *trigger_pb.TriggerData, calendar *trigger_pb.CalendarData, thisTick time.Time, fireTime *time.Time) (*time.Time, bool, error) {
+	// archived or removed calendars exclude nothing
+	if calendar == nil {
+		return fireTime, false, nil
+	}
+
+	loc, err := utils.LoadTimezone(data.Timezone)
+	if err != nil {
+		return nil, false, err
+	}
+
+	local := thisTick.In(loc)
+	if !isBusinessDay(calendar, local) {
+		return nil, false, nil
+	}
+
+	if fireTime != nil {
+		return fireTime, false, nil
+	}
+
+	if data.CalendarRoll != trigger_pb.CalendarRoll_NEXT_BUSINESS_DAY {
+		return nil, false, nil
+	}
+
+	for days := 1; days <= maxRollDays; days++ {
+		// the same wall clock time on the earlier date
+		earlier := time.Date(local.Year(), local.Month(), local.Day()-days, local.Hour(), local.Minute(), local.Second(), 0, loc)
+		if isBusinessDay(calendar, earlier) {
+			break
+		}
+
+		slot, err := checkTrigger(data, earlier)
+		if err != nil {
+			return nil, false, err
+		}
+		if slot != nil {
+			return slot, true, nil
+		}
+	}
+
+	return nil, false, nil
+}
@@ -0,0 +1,156 @@
+package service
+
+import (
+	"context"
+
+	"github.com/pentops/j5/lib/id62"
+	"github.com/pentops/log.go/log"
+	"github.com/pentops/realms/j5auth"
+	"github.com/pentops/sqrlx.go/sqrlx"
+	"github.com/pentops/trigger/gen/o5/trigger/v1/trigger_pb"
+	"github.com/pentops/trigger/gen/o5/trigger/v1/trigger_spb"
+	"github.com/pentops/trigger/utils"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type CalendarCommand struct {
+	db        sqrlx.Transactor
+	sm        *trigger_pb.CalendarPSM
+	triggerSM *trigger_pb.TriggerPSM
+
+	trigger_spb.UnimplementedCalendarCommandServiceServer
+}
+
+func NewCalendarCommand(db sqrlx.Transactor, sm *trigger_pb.CalendarPSM, triggerSM *trigger_pb.TriggerPSM) (*CalendarCommand, error) {
+	return &CalendarCommand{
+		db:        db,
+		sm:        sm,
+		triggerSM: triggerSM,
+	}, nil
+}
+
+func (w *CalendarCommand) CreateCalendar(ctx context.Context, req *trigger_spb.CreateCalendarRequest) (*trigger_spb.CreateCalendarResponse, error) {
+	action, err := j5auth.GetAuthenticatedAction(ctx)
+	if err != nil {
+		log.WithError(ctx, err).Error("failed get authenticated action in create calendar")
+		return nil, status.Error(codes.NotFound, "")
+	}
+
+	calendarID := id62.NewString()
+	if req.CalendarId != nil {
+		calendarID = *req.CalendarId
+	}
+
+	evt := trigger_pb.CalendarPSMEventSpec{
+		Keys: &trigger_pb.CalendarKeys{
+			CalendarId: calendarID,
+		},
+		Action: action,
+		Event: &trigger_pb.CalendarEventType_Created{
+			Name:            req.Name,
+			ExcludedDates:   req.ExcludedDates,
+			ExcludeWeekends: req.ExcludeWeekends,
+		},
+	}
+
+	resp := &trigger_spb.CreateCalendarResponse{}
+
+	err = w.db.Transact(ctx, utils.MutableTxOptions, func(ctx context.Context, tx sqrlx.Transaction) error {
+		calendarState, err := w.sm.TransitionInTx(ctx, tx, &evt)
+		if err != nil {
+			log.WithError(ctx, err).Error("failed to create calendar")
+			return status.Error(codes.Internal, "failed to create calendar")
+		}
+		resp.Calendar = calendarState
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+func (w *CalendarCommand) UpdateCalendar(ctx context.Context, req *trigger_spb.UpdateCalendarRequest) (*trigger_spb.UpdateCalendarResponse, error) {
+	action, err := j5auth.GetAuthenticatedAction(ctx)
+	if err != nil {
+		log.WithError(ctx, err).Error("failed get authenticated action in update calendar")
+		return nil, status.Error(codes.NotFound, "")
+	}
+
+	evt := trigger_pb.CalendarPSMEventSpec{
+		Keys: &trigger_pb.CalendarKeys{
+			CalendarId: req.CalendarId,
+		},
+		Action: action,
+		Event: &trigger_pb.CalendarEventType_Updated{
+			Name:            req.Name,
+			ExcludedDates:   req.ExcludedDates,
+			ExcludeWeekends: req.ExcludeWeekends,
+		},
+	}
+
+	resp := &trigger_spb.UpdateCalendarResponse{}
+
+	err = w.db.Transact(ctx, utils.MutableTxOptions, func(ctx context.Context, tx sqrlx.Transaction) error {
+		calendarState, err := w.sm.TransitionInTx(ctx, tx, &evt)
+		if err != nil {
+			log.WithError(ctx, err).Error("failed to update calendar")
+			return status.Error(codes.Internal, "failed to update calendar")
+		}
+		resp.Calendar = calendarState
+
+		if err := rescheduleCalendarTriggers(ctx, tx, w.triggerSM, req.CalendarId); err != nil {
+			log.WithError(ctx, err).Error("failed to reschedule calendar triggers")
+			return status.Error(codes.Internal, "failed to update calendar")
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+func (w *CalendarCommand) ArchiveCalendar(ctx context.Context, req *trigger_spb.ArchiveCalendarRequest) (*trigger_spb.ArchiveCalendarResponse, error) {
+	action, err := j5auth.GetAuthenticatedAction(ctx)
+	if err != nil {
+		log.WithError(ctx, err).Error("failed get authenticated action in archive calendar")
+		return nil, status.Error(codes.NotFound, "")
+	}
+
+	evt := trigger_pb.CalendarPSMEventSpec{
+		Keys: &trigger_pb.CalendarKeys{
+			CalendarId: req.CalendarId,
+		},
+		Action: action,
+		Event:  &trigger_pb.CalendarEventType_Archived{},
+	}
+
+	resp := &trigger_spb.ArchiveCalendarResponse{}
+
+	err = w.db.Transact(ctx, utils.MutableTxOptions, func(ctx context.Context, tx sqrlx.Transaction) error {
+		calendarState, err := w.sm.TransitionInTx(ctx, tx, &evt)
+		if err != nil {
+			log.WithError(ctx, err).Error("failed to archive calendar")
+			return status.Error(codes.Internal, "failed to archive calendar")
+		}
+		resp.Calendar = calendarState
+
+		if err := rescheduleCalendarTriggers(ctx, tx, w.triggerSM, req.CalendarId); err != nil {
+			log.WithError(ctx, err).Error("failed to reschedule calendar triggers")
+			return status.Error(codes.Internal, "failed to archive calendar")
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
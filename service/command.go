@@ -2,12 +2,15 @@ package service
 
 import (
 	"context"
+	"time"
 
+	"github.com/pentops/j5/gen/j5/messaging/v1/messaging_j5pb"
 	"github.com/pentops/log.go/log"
 	"github.com/pentops/realms/j5auth"
 	"github.com/pentops/sqrlx.go/sqrlx"
 	"github.com/pentops/trigger/gen/o5/trigger/v1/trigger_pb"
 	"github.com/pentops/trigger/gen/o5/trigger/v1/trigger_spb"
+	"github.com/pentops/trigger/states"
 	"github.com/pentops/trigger/utils"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -17,13 +20,17 @@ type TriggerCommand struct {
 	db sqrlx.Transactor
 	sm *trigger_pb.TriggerPSM
 
+	// now is the wall clock new and updated triggers are scheduled from
+	now func() time.Time
+
 	trigger_spb.UnimplementedTriggerCommandServiceServer
 }
 
 func NewTriggerCommand(db sqrlx.Transactor, sm *trigger_pb.TriggerPSM) (*TriggerCommand, error) {
 	return &TriggerCommand{
-		db: db,
-		sm: sm,
+		db:  db,
+		sm:  sm,
+		now: time.Now,
 	}, nil
 }
 
@@ -47,8 +54,7 @@ func (w *TriggerCommand) PauseTrigger(ctx context.Context, req *trigger_spb.Paus
 	err = w.db.Transact(ctx, utils.MutableTxOptions, func(ctx context.Context, tx sqrlx.Transaction) error {
 		triggerState, err := w.sm.TransitionInTx(ctx, tx, &evt)
 		if err != nil {
-			log.WithError(ctx, err).Error("failed to pause trigger")
-			return status.Error(codes.Internal, "failed to pause trigger")
+			return transitionError(ctx, err, "failed to pause trigger")
 		}
 		resp.Trigger = triggerState
 
@@ -79,10 +85,24 @@ func (w *TriggerCommand) ResumeTrigger(ctx context.Context, req *trigger_spb.Res
 	resp := &trigger_spb.ResumeTriggerResponse{}
 
 	err = w.db.Transact(ctx, utils.MutableTxOptions, func(ctx context.Context, tx sqrlx.Transaction) error {
-		triggerState, err := w.sm.TransitionInTx(ctx, tx, &evt)
+		existing, err := getTrigger(ctx, tx, req.TriggerId)
+		if err != nil {
+			return err
+		}
+		if existing == nil {
+			return status.Error(codes.NotFound, "trigger not found")
+		}
+
+		// a faulted trigger needs its schedule fixed by an update first
+		if existing.Status == trigger_pb.TriggerStatus_FAULTED {
+			if _, err := checkTrigger(existing.Data, time.Now()); err != nil {
+				return status.Errorf(codes.FailedPrecondition, "trigger is faulted, update its schedule before resuming: %v", err)
+			}
+		}
+
+		triggerState, err := transitionTrigger(ctx, tx, w.sm, &evt, w.now())
 		if err != nil {
-			log.WithError(ctx, err).Error("failed to resume trigger")
-			return status.Error(codes.Internal, "failed to resume trigger")
+			return transitionError(ctx, err, "failed to resume trigger")
 		}
 		resp.Trigger = triggerState
 
@@ -117,8 +137,47 @@ func (w *TriggerCommand) ManuallyTrigger(ctx context.Context, req *trigger_spb.M
 	err = w.db.Transact(ctx, utils.MutableTxOptions, func(ctx context.Context, tx sqrlx.Transaction) error {
 		triggerState, err := w.sm.TransitionInTx(ctx, tx, &evt)
 		if err != nil {
-			log.WithError(ctx, err).Error("failed to manually trigger")
-			return status.Error(codes.Internal, "failed to manually trigger")
+			return transitionError(ctx, err, "failed to manually trigger")
+		}
+		resp.Trigger = triggerState
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+func (w *TriggerCommand) RestoreTrigger(ctx context.Context, req *trigger_spb.RestoreTriggerRequest) (*trigger_spb.RestoreTriggerResponse, error) {
+	action, err := j5auth.GetAuthenticatedAction(ctx)
+	if err != nil {
+		log.WithError(ctx, err).Error("failed get authenticated action in restore trigger")
+		return nil, status.Error(codes.NotFound, "")
+	}
+
+	if req.Reason == "" {
+		return nil, status.Error(codes.InvalidArgument, "reason is required")
+	}
+
+	evt := trigger_pb.TriggerPSMEventSpec{
+		Keys: &trigger_pb.TriggerKeys{
+			TriggerId: req.TriggerId,
+		},
+		Action: action,
+		Event: &trigger_pb.TriggerEventType_Restored{
+			Reason:   req.Reason,
+			Activate: req.Activate,
+		},
+	}
+
+	resp := &trigger_spb.RestoreTriggerResponse{}
+
+	err = w.db.Transact(ctx, utils.MutableTxOptions, func(ctx context.Context, tx sqrlx.Transaction) error {
+		triggerState, err := transitionTrigger(ctx, tx, w.sm, &evt, w.now())
+		if err != nil {
+			return transitionError(ctx, err, "failed to restore trigger")
 		}
 		resp.Trigger = triggerState
 
@@ -130,3 +189,141 @@ func (w *TriggerCommand) ManuallyTrigger(ctx context.Context, req *trigger_spb.M
 
 	return resp, nil
 }
+
+func (w *TriggerCommand) CreateTrigger(ctx context.Context, req *trigger_spb.CreateTriggerRequest) (*trigger_spb.CreateTriggerResponse, error) {
+	action, err := j5auth.GetAuthenticatedAction(ctx)
+	if err != nil {
+		log.WithError(ctx, err).Error("failed get authenticated action in create trigger")
+		return nil, status.Error(codes.NotFound, "")
+	}
+
+	if err := validateDefinition(req.Trigger); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	triggerID := idempotentTriggerID(req.AppName, req.Trigger.TriggerName)
+	if req.TriggerId != nil {
+		triggerID = *req.TriggerId
+	}
+
+	// there is no request context over RPC, replies only carry where they go
+	var requestMetadata *messaging_j5pb.RequestMetadata
+	if req.ReplyTo != "" {
+		requestMetadata = &messaging_j5pb.RequestMetadata{
+			ReplyTo: req.ReplyTo,
+		}
+	}
+
+	evt := trigger_pb.TriggerPSMEventSpec{
+		Keys: &trigger_pb.TriggerKeys{
+			TriggerId: triggerID,
+		},
+		Action: action,
+		Event:  definitionAsCreate(req.AppName, req.Trigger, requestMetadata),
+	}
+
+	resp := &trigger_spb.CreateTriggerResponse{}
+
+	err = w.db.Transact(ctx, utils.MutableTxOptions, func(ctx context.Context, tx sqrlx.Transaction) error {
+		if err := checkDefinitionCalendar(ctx, tx, req.Trigger); err != nil {
+			return err
+		}
+
+		triggerState, err := applyTriggerEvent(ctx, tx, w.sm, &evt, w.now())
+		if err != nil {
+			return transitionError(ctx, err, "failed to create trigger")
+		}
+		resp.Trigger = triggerState
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+func (w *TriggerCommand) UpdateTrigger(ctx context.Context, req *trigger_spb.UpdateTriggerRequest) (*trigger_spb.UpdateTriggerResponse, error) {
+	action, err := j5auth.GetAuthenticatedAction(ctx)
+	if err != nil {
+		log.WithError(ctx, err).Error("failed get authenticated action in update trigger")
+		return nil, status.Error(codes.NotFound, "")
+	}
+
+	if err := validateDefinition(req.Trigger); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	update := createAsUpdate(definitionAsCreate(req.AppName, req.Trigger, nil))
+
+	evt := trigger_pb.TriggerPSMEventSpec{
+		Keys: &trigger_pb.TriggerKeys{
+			TriggerId: req.TriggerId,
+		},
+		Action: action,
+		Event:  update,
+	}
+
+	resp := &trigger_spb.UpdateTriggerResponse{}
+
+	err = w.db.Transact(ctx, utils.MutableTxOptions, func(ctx context.Context, tx sqrlx.Transaction) error {
+		if err := checkDefinitionCalendar(ctx, tx, req.Trigger); err != nil {
+			return err
+		}
+
+		existing, err := getTrigger(ctx, tx, req.TriggerId)
+		if err != nil {
+			return err
+		}
+		if existing == nil {
+			return status.Error(codes.NotFound, "trigger not found")
+		}
+
+		// replies keep going where the trigger was created from
+		update.RequestMetadata = existing.Data.RequestMetadata
+
+		triggerState, err := transitionTrigger(ctx, tx, w.sm, &evt, w.now())
+		if err != nil {
+			return transitionError(ctx, err, "failed to update trigger")
+		}
+		resp.Trigger = triggerState
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// transitionError returns the status for a trigger transition which failed, a
+// FailedPrecondition when the state machine rejects the event for the trigger
+// as it stands, e.g. updating an archived trigger, otherwise Internal.
+func transitionError(ctx context.Context, err error, msg string) error {
+	if states.IsRejected(err) {
+		return status.Errorf(codes.FailedPrecondition, "%s: %v", msg, err)
+	}
+
+	log.WithError(ctx, err).Error(msg)
+	return status.Error(codes.Internal, msg)
+}
+
+// checkDefinitionCalendar returns a FailedPrecondition error when the calendar
+// of the trigger is not active.
+func checkDefinitionCalendar(ctx context.Context, tx sqrlx.Transaction, def *trigger_pb.TriggerDefinition) error {
+	if def.CalendarId == nil {
+		return nil
+	}
+
+	calendar, err := activeCalendar(ctx, tx, *def.CalendarId)
+	if err != nil {
+		return err
+	}
+	if calendar == nil {
+		return status.Errorf(codes.FailedPrecondition, "calendar %s is not active", *def.CalendarId)
+	}
+
+	return nil
+}
@@ -0,0 +1,70 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/pentops/log.go/log"
+	"github.com/pentops/sqrlx.go/sqrlx"
+	"go.opentelemetry.io/otel/metric"
+)
+
+const meterName = "github.com/pentops/trigger/service"
+
+// tickMetrics reports the health of the self tick chain. The worker records
+// against the global meter provider, which serve sets up with an exporter.
+type tickMetrics struct {
+	duration metric.Float64Histogram
+	fired    metric.Int64Histogram
+}
+
+func newTickMetrics(meter metric.Meter, db sqrlx.Transactor) (*tickMetrics, error) {
+	duration, err := meter.Float64Histogram("trigger.tick.duration",
+		metric.WithDescription("How long processing a self tick took"),
+		metric.WithUnit("s"),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("tick duration metric: %w", err)
+	}
+
+	fired, err := meter.Int64Histogram("trigger.tick.fired",
+		metric.WithDescription("How many triggers a self tick fired"),
+		metric.WithUnit("{trigger}"),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("tick fired metric: %w", err)
+	}
+
+	// the lag is read from the stored last tick when collected, so it keeps
+	// growing when the chain stalls and no tick is left to record it
+	_, err = meter.Float64ObservableGauge("trigger.tick.lag",
+		metric.WithDescription("How far the wall clock is past the last self tick"),
+		metric.WithUnit("s"),
+		metric.WithFloat64Callback(func(ctx context.Context, o metric.Float64Observer) error {
+			stats, err := getTickStats(ctx, db)
+			if err != nil {
+				log.WithError(ctx, err).Warn("failed to read last tick for lag metric")
+				return nil
+			}
+			if stats == nil {
+				return nil
+			}
+			o.Observe(tickLag(stats.LastTick, time.Now()).Seconds())
+			return nil
+		}),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("tick lag metric: %w", err)
+	}
+
+	return &tickMetrics{
+		duration: duration,
+		fired:    fired,
+	}, nil
+}
+
+func (m *tickMetrics) recordTick(ctx context.Context, result TickResult) {
+	m.duration.Record(ctx, result.Duration.Seconds())
+	m.fired.Record(ctx, int64(result.Fired))
+}
@@ -0,0 +1,408 @@
+package service
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/pentops/sqrlx.go/sqrlx"
+	"github.com/pentops/trigger/gen/o5/trigger/v1/trigger_pb"
+	"github.com/pentops/trigger/gen/o5/trigger/v1/trigger_spb"
+	"github.com/pentops/trigger/utils"
+	"github.com/robfig/cron/v3"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	defaultPreviewCount = 10
+	maxPreviewCount     = 100
+
+	// maxNextFireSearch bounds how far past the given time the next fire times
+	// of a trigger are looked for, which matters for calendars excluding a
+	// long run of dates. It matches how far the cron library looks ahead.
+	maxNextFireSearch = 5 * 366 * 24 * time.Hour
+
+	// maxDSTChange is the most clocks change by, schedule times this far past
+	// a fire time can still fire before it.
+	maxDSTChange = 2 * time.Hour
+)
+
+type PreviewService struct {
+	db sqrlx.Transactor
+
+	trigger_spb.UnimplementedTriggerPreviewServiceServer
+}
+
+func NewPreviewService(db sqrlx.Transactor) (*PreviewService, error) {
+	return &PreviewService{
+		db: db,
+	}, nil
+}
+
+func (ps *PreviewService) PreviewTrigger(ctx context.Context, req *trigger_spb.PreviewTriggerRequest) (*trigger_spb.PreviewTriggerResponse, error) {
+	count := int(req.Count)
+	if count <= 0 {
+		count = defaultPreviewCount
+	} else if count > maxPreviewCount {
+		return nil, status.Errorf(codes.InvalidArgument, "count must be at most %d", maxPreviewCount)
+	}
+
+	after := time.Now()
+	if req.After != nil {
+		after = req.After.AsTime()
+	}
+
+	data := &trigger_pb.TriggerData{
+		Cron:      req.Cron,
+		Timezone:  req.Timezone,
+		DstPolicy: req.DstPolicy,
+	}
+
+	var calendar *trigger_pb.CalendarData
+
+	if req.TriggerId != nil {
+		err := ps.db.Transact(ctx, utils.ReadOnlyTxOptions, func(ctx context.Context, tx sqrlx.Transaction) error {
+			state, err := getTrigger(ctx, tx, *req.TriggerId)
+			if err != nil {
+				return err
+			}
+			if state == nil {
+				return status.Error(codes.NotFound, "trigger not found")
+			}
+			if state.Status != trigger_pb.TriggerStatus_ACTIVE && state.Status != trigger_pb.TriggerStatus_PAUSED {
+				return status.Errorf(codes.FailedPrecondition, "trigger is %s and will not fire again", state.Status.ShortString())
+			}
+			data = state.Data
+
+			if data.CalendarId != nil {
+				calendar, err = activeCalendar(ctx, tx, *data.CalendarId)
+				if err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	} else if err := utils.ValidateSchedule(data.Cron, data.Timezone, nil, nil); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	fireTimes, err := nextFireTimes(data, calendar, after, count)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &trigger_spb.PreviewTriggerResponse{}
+	for _, fireTime := range fireTimes {
+		resp.FireTimes = append(resp.FireTimes, timestamppb.New(fireTime))
+	}
+
+	return resp, nil
+}
+
+// nextFireTime returns the first time after the given time which the trigger
+// fires for, or nil when it will not fire again. When no fire time is found
+// within maxNextFireSearch of the given time, but the schedule carries on, the
+// end of the search is returned so the trigger is checked again from there.
+func nextFireTime(data *trigger_pb.TriggerData, calendar *trigger_pb.CalendarData, after time.Time) (*time.Time, error) {
+	fireTimes, searchedTo, err := searchFireTimes(data, calendar, after, 1)
+	if err != nil {
+		return nil, err
+	}
+	if len(fireTimes) > 0 {
+		return &fireTimes[0], nil
+	}
+	return searchedTo, nil
+}
+
+// nextFireTimes returns up to count times after the given time which the
+// trigger fires for.
+func nextFireTimes(data *trigger_pb.TriggerData, calendar *trigger_pb.CalendarData, after time.Time, count int) ([]time.Time, error) {
+	fireTimes, _, err := searchFireTimes(data, calendar, after, count)
+	return fireTimes, err
+}
+
+// searchFireTimes returns up to count times after the given time which the
+// trigger fires for. Candidate times from the schedule are checked with
+// checkTrigger, so timezones and the DST policy apply as they do on each tick.
+// Fire times on dates excluded by the calendar are skipped, or rolled to the
+// same time on the next business day when the calendar roll is
+// NEXT_BUSINESS_DAY. When the search reaches maxNextFireSearch past the given
+// time before finding count fire times, the end of the search is returned
+// too.
+func searchFireTimes(data *trigger_pb.TriggerData, calendar *trigger_pb.CalendarData, after time.Time, count int) ([]time.Time, *time.Time, error) {
+	if data.MaxFires != nil {
+		count = min(count, int(*data.MaxFires-data.FireCount))
+	}
+	if count <= 0 {
+		return nil, nil, nil
+	}
+
+	if data.RunAt != nil {
+		runAt := data.RunAt.AsTime()
+		if !inWindow(data, runAt) {
+			return nil, nil, nil
+		}
+		if !runAt.After(after) {
+			// an overdue one-shot trigger fires on the next tick
+			runAt = after.Truncate(triggerCadence).Add(triggerCadence)
+		}
+		return []time.Time{runAt}, nil, nil
+	}
+
+	loc, err := utils.LoadTimezone(data.Timezone)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	rolls := calendar != nil && data.CalendarRoll == trigger_pb.CalendarRoll_NEXT_BUSINESS_DAY
+
+	from := after
+	if rolls {
+		// a schedule time before this can still roll forward past it
+		from = from.AddDate(0, 0, -maxRollDays)
+	}
+	if data.NotBefore != nil && data.NotBefore.AsTime().After(from) {
+		from = data.NotBefore.AsTime().Add(-time.Second)
+	}
+
+	sched, err := scheduleCandidates(data, from)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var fireTimes []time.Time
+	seen := map[int64]bool{}
+	addFireTime := func(fireTime time.Time) {
+		// times near a DST change are candidates for more than one wall clock
+		// time, and rolled fires merge with fires already on the business day
+		if !seen[fireTime.UnixNano()] {
+			seen[fireTime.UnixNano()] = true
+			fireTimes = append(fireTimes, fireTime)
+		}
+	}
+
+	afterWall := wallClock(after.In(loc))
+	searchEnd := after.Add(maxNextFireSearch)
+
+	// the fires rolled from the excluded date being searched, once count have
+	// been found later times on the date can only roll to later fire times
+	var rolledDate time.Time
+	rolledCount := 0
+
+	for {
+		candidates := sched.next()
+		if len(candidates) == 0 {
+			break
+		}
+
+		if len(fireTimes) >= count {
+			sortTimes(fireTimes)
+			if candidates[0].After(fireTimes[count-1].Add(maxDSTChange)) {
+				break
+			}
+		}
+		if data.NotAfter != nil && candidates[0].After(data.NotAfter.AsTime().Add(maxDSTChange)) {
+			break
+		}
+		if candidates[0].After(searchEnd) {
+			if len(fireTimes) < count {
+				return fireTimes, &searchEnd, nil
+			}
+			break
+		}
+
+		for _, candidate := range candidates {
+			local := candidate.In(loc)
+			businessDay := calendar == nil || isBusinessDay(calendar, local)
+
+			if !businessDay && !rolls {
+				// nothing on the date fires
+				sched.skipTo(nextWallDate(local))
+				continue
+			}
+			if businessDay && !candidate.After(after) {
+				// only rolled fires are looked for before the given time
+				sched.skipTo(minTime(nextWallDate(local), afterWall.Add(-maxDSTChange)))
+				continue
+			}
+
+			fireTime, err := checkTrigger(data, candidate)
+			if err != nil {
+				return nil, nil, err
+			}
+			if fireTime == nil || !inWindow(data, *fireTime) {
+				continue
+			}
+
+			if businessDay {
+				addFireTime(candidate)
+				continue
+			}
+
+			later, ok := rollForward(calendar, local)
+			if !ok {
+				sched.skipTo(nextWallDate(local))
+				continue
+			}
+
+			if !later.After(after) {
+				if wallDate(later).Before(wallDate(after.In(loc))) {
+					// the date rolls to a business day before the given time
+					sched.skipTo(nextWallDate(local))
+				} else {
+					// the wall clock time of the given time is the first on
+					// this date which can roll past it
+					sched.skipTo(wallDate(local).Add(afterWall.Sub(wallDate(afterWall))))
+				}
+				continue
+			}
+			if isExpired(data, later) {
+				continue
+			}
+
+			addFireTime(later)
+
+			if date := wallDate(local); !date.Equal(rolledDate) {
+				rolledDate = date
+				rolledCount = 0
+			}
+			rolledCount++
+			if rolledCount >= count {
+				sched.skipTo(nextWallDate(local))
+			}
+		}
+	}
+
+	sortTimes(fireTimes)
+	if len(fireTimes) > count {
+		fireTimes = fireTimes[:count]
+	}
+
+	return fireTimes, nil, nil
+}
+
+// rollForward returns the same wall clock time as the local time on the next
+// business day of the calendar, within maxRollDays.
+func rollForward(calendar *trigger_pb.CalendarData, local time.Time) (time.Time, bool) {
+	for days := 1; days <= maxRollDays; days++ {
+		later := time.Date(local.Year(), local.Month(), local.Day()+days, local.Hour(), local.Minute(), local.Second(), 0, local.Location())
+		if isBusinessDay(calendar, later) {
+			return later, true
+		}
+	}
+	return time.Time{}, false
+}
+
+// wallDate returns the start of the date of the local time, as a wall clock
+// time.
+func wallDate(local time.Time) time.Time {
+	return time.Date(local.Year(), local.Month(), local.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+// nextWallDate returns the start of the date after the local time, as a wall
+// clock time.
+func nextWallDate(local time.Time) time.Time {
+	return wallDate(local).AddDate(0, 0, 1)
+}
+
+func minTime(a, b time.Time) time.Time {
+	if a.Before(b) {
+		return a
+	}
+	return b
+}
+
+// candidateSchedule steps through the schedule of a cron or interval trigger,
+// see scheduleCandidates.
+type candidateSchedule struct {
+	// next returns the candidate fire times of the next step, or none once
+	// the schedule ends
+	next func() []time.Time
+
+	// skipTo moves the schedule on so the next step is at or after the wall
+	// clock time, it never moves the schedule back
+	skipTo func(wall time.Time)
+}
+
+// scheduleCandidates returns the steps of the schedule of a cron or interval
+// trigger after the given time. The candidates include every time
+// checkTrigger could fire on.
+func scheduleCandidates(data *trigger_pb.TriggerData, after time.Time) (*candidateSchedule, error) {
+	sched, err := triggerSchedule(data)
+	if err != nil {
+		return nil, err
+	}
+
+	loc, err := utils.LoadTimezone(data.Timezone)
+	if err != nil {
+		return nil, err
+	}
+
+	spec, ok := sched.(*cron.SpecSchedule)
+	if !ok {
+		last := after
+		return &candidateSchedule{
+			next: func() []time.Time {
+				last = sched.Next(last)
+				if last.IsZero() {
+					return nil
+				}
+				return []time.Time{last}
+			},
+			skipTo: func(wall time.Time) {
+				to := time.Date(wall.Year(), wall.Month(), wall.Day(), wall.Hour(), wall.Minute(), wall.Second(), 0, loc).Add(-time.Nanosecond)
+				if to.After(last) {
+					last = to
+				}
+			},
+		}, nil
+	}
+
+	// as in checkCron, the cron matches wall clock times held as UTC, each of
+	// which happens at up to two times, or is skipped and fires shifted by the
+	// DST policy, when the clocks change
+	wallSpec := *spec
+	wallSpec.Location = time.UTC
+	wall := wallClock(after.In(spec.Location)).Add(-maxDSTChange)
+
+	return &candidateSchedule{
+		next: func() []time.Time {
+			wall = wallSpec.Next(wall)
+			if wall.IsZero() {
+				return nil
+			}
+			return wallTimes(wall, spec.Location)
+		},
+		skipTo: func(to time.Time) {
+			if to = to.Add(-time.Second); to.After(wall) {
+				wall = to
+			}
+		},
+	}, nil
+}
+
+// wallTimes returns the times the wall clock time would be in loc, on the
+// offsets before and after any nearby DST change.
+func wallTimes(wall time.Time, loc *time.Location) []time.Time {
+	_, offsetBefore := wall.Add(-26 * time.Hour).In(loc).Zone()
+	_, offsetAfter := wall.Add(26 * time.Hour).In(loc).Zone()
+
+	times := []time.Time{wall.Add(-time.Duration(offsetBefore) * time.Second)}
+	if offsetAfter != offsetBefore {
+		times = append(times, wall.Add(-time.Duration(offsetAfter)*time.Second))
+	}
+	sortTimes(times)
+
+	return times
+}
+
+func sortTimes(times []time.Time) {
+	sort.Slice(times, func(i, j int) bool {
+		return times[i].Before(times[j])
+	})
+}
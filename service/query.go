@@ -9,11 +9,13 @@ import (
 )
 
 type QueryService struct {
-	triggerQuery *trigger_spb.TriggerQueryServiceImpl
+	triggerQuery  *trigger_spb.TriggerQueryServiceImpl
+	calendarQuery *trigger_spb.CalendarQueryServiceImpl
 }
 
 func NewQueryService(db j5query.Transactor,
 	triggerSpec trigger_spb.TriggerPSMQuerySpec,
+	calendarSpec trigger_spb.CalendarPSMQuerySpec,
 ) (*QueryService, error) {
 
 	triggerQuery, err := trigger_spb.NewTriggerPSMQuerySet(
@@ -24,11 +26,21 @@ func NewQueryService(db j5query.Transactor,
 		return nil, err
 	}
 
+	calendarQuery, err := trigger_spb.NewCalendarPSMQuerySet(
+		calendarSpec,
+		psm.StateQueryOptions{},
+	)
+	if err != nil {
+		return nil, err
+	}
+
 	return &QueryService{
-		triggerQuery: trigger_spb.NewTriggerQueryServiceImpl(db, triggerQuery),
+		triggerQuery:  trigger_spb.NewTriggerQueryServiceImpl(db, triggerQuery),
+		calendarQuery: trigger_spb.NewCalendarQueryServiceImpl(db, calendarQuery),
 	}, nil
 }
 
 func (qs *QueryService) RegisterGRPC(s grpc.ServiceRegistrar) {
 	trigger_spb.RegisterTriggerQueryServiceServer(s, qs.triggerQuery)
+	trigger_spb.RegisterCalendarQueryServiceServer(s, qs.calendarQuery)
 }
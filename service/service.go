@@ -2,6 +2,7 @@ package service
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/pentops/sqrlx.go/sqrlx"
 	"github.com/pentops/trigger/gen/o5/trigger/v1/trigger_pb"
@@ -12,10 +13,21 @@ import (
 )
 
 type Service struct {
-	SM             *trigger_pb.TriggerPSM
-	QueryService   *QueryService
-	TriggerWorker  *TriggerWorker
-	TriggerCommand *TriggerCommand
+	SM              *trigger_pb.TriggerPSM
+	CalendarSM      *trigger_pb.CalendarPSM
+	QueryService    *QueryService
+	TriggerWorker   *TriggerWorker
+	TriggerCommand  *TriggerCommand
+	CalendarCommand *CalendarCommand
+	PreviewService  *PreviewService
+	TickService     *TickStatusService
+}
+
+// SetClock replaces the wall clock new and updated triggers are scheduled
+// from, for tests which tick at fixed times.
+func (s *Service) SetClock(now func() time.Time) {
+	s.TriggerWorker.now = now
+	s.TriggerCommand.now = now
 }
 
 func BuildService(db sqrlx.Transactor) (*Service, error) {
@@ -24,12 +36,20 @@ func BuildService(db sqrlx.Transactor) (*Service, error) {
 		return nil, err
 	}
 
-	query, err := NewQueryService(db, trigger_spb.DefaultTriggerPSMQuerySpec(sm.StateTableSpec()))
+	calendarSM, err := states.NewCalendarStateMachine()
+	if err != nil {
+		return nil, err
+	}
+
+	query, err := NewQueryService(db,
+		trigger_spb.DefaultTriggerPSMQuerySpec(sm.StateTableSpec()),
+		trigger_spb.DefaultCalendarPSMQuerySpec(calendarSM.StateTableSpec()),
+	)
 	if err != nil {
 		return nil, fmt.Errorf("BuildService NewQueryService: %w", err)
 	}
 
-	triggerWorker, err := NewTriggerWorker(db, sm)
+	triggerWorker, err := NewTriggerWorker(db, sm, calendarSM)
 	if err != nil {
 		return nil, fmt.Errorf("BuildService NewTriggerWorker: %w", err)
 	}
@@ -39,17 +59,39 @@ func BuildService(db sqrlx.Transactor) (*Service, error) {
 		return nil, fmt.Errorf("BuildService NewTriggerCommand: %w", err)
 	}
 
+	calendarCommand, err := NewCalendarCommand(db, calendarSM, sm)
+	if err != nil {
+		return nil, fmt.Errorf("BuildService NewCalendarCommand: %w", err)
+	}
+
+	previewService, err := NewPreviewService(db)
+	if err != nil {
+		return nil, fmt.Errorf("BuildService NewPreviewService: %w", err)
+	}
+
+	tickService, err := NewTickStatusService(db)
+	if err != nil {
+		return nil, fmt.Errorf("BuildService NewTickStatusService: %w", err)
+	}
+
 	return &Service{
-		SM:             sm,
-		QueryService:   query,
-		TriggerWorker:  triggerWorker,
-		TriggerCommand: triggerCommand,
+		SM:              sm,
+		CalendarSM:      calendarSM,
+		QueryService:    query,
+		TriggerWorker:   triggerWorker,
+		TriggerCommand:  triggerCommand,
+		CalendarCommand: calendarCommand,
+		PreviewService:  previewService,
+		TickService:     tickService,
 	}, nil
 }
 
 func (a *Service) RegisterGRPC(server grpc.ServiceRegistrar) {
 	a.QueryService.RegisterGRPC(server)
 	trigger_spb.RegisterTriggerCommandServiceServer(server, a.TriggerCommand)
+	trigger_spb.RegisterCalendarCommandServiceServer(server, a.CalendarCommand)
+	trigger_spb.RegisterTriggerPreviewServiceServer(server, a.PreviewService)
+	trigger_spb.RegisterTriggerTickServiceServer(server, a.TickService)
 	trigger_tpb.RegisterTriggerPublishTopicServer(server, a.TriggerWorker)
 	trigger_tpb.RegisterSelfTickTopicServer(server, a.TriggerWorker)
 	trigger_tpb.RegisterTriggerManageRequestTopicServer(server, a.TriggerWorker)
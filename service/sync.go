@@ -0,0 +1,252 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	sq "github.com/elgris/sqrl"
+	"github.com/pentops/j5/gen/j5/messaging/v1/messaging_j5pb"
+	"github.com/pentops/j5/gen/j5/state/v1/psm_j5pb"
+	"github.com/pentops/j5/lib/id62"
+	"github.com/pentops/j5/lib/j5codec"
+	"github.com/pentops/sqrlx.go/sqrlx"
+	"github.com/pentops/trigger/gen/o5/trigger/v1/trigger_pb"
+	"github.com/pentops/trigger/gen/o5/trigger/v1/trigger_tpb"
+	"github.com/pentops/trigger/states"
+	"github.com/pentops/trigger/utils"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// syncTriggers reconciles the triggers of an app with the set it declared,
+// applying every change in one transaction.
+func (w *TriggerWorker) syncTriggers(ctx context.Context, req *trigger_tpb.TriggerManageRequestMessage) (*emptypb.Empty, error) {
+	sync := req.Action.GetSync()
+
+	if err := w.validateSync(ctx, sync); err != nil {
+		return w.rejectManageRequest(ctx, req, &trigger_tpb.TriggerManageReplyMessage{}, err)
+	}
+
+	err := w.db.Transact(ctx, utils.MutableTxOptions, func(ctx context.Context, tx sqrlx.Transaction) error {
+		existing, err := appTriggers(ctx, tx, sync.AppName)
+		if err != nil {
+			return err
+		}
+
+		after, err := scheduleFrom(ctx, tx, w.now())
+		if err != nil {
+			return err
+		}
+
+		for _, evt := range syncEvents(sync, existing, req.GetJ5RequestMetadata()) {
+			if _, err := transitionTriggerAfter(ctx, tx, w.sm, evt, after); err != nil {
+				return fmt.Errorf("sync trigger %s: %w", evt.Keys.TriggerId, err)
+			}
+		}
+
+		return w.sendManageReply(ctx, tx, req, &trigger_tpb.TriggerManageReplyMessage{
+			Success: true,
+		})
+	})
+	if err != nil {
+		return w.rejectManageRequest(ctx, req, &trigger_tpb.TriggerManageReplyMessage{}, err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (w *TriggerWorker) validateSync(ctx context.Context, sync *trigger_pb.ActionType_Sync) error {
+	if sync.AppName == "" {
+		return states.Reject(fmt.Errorf("app name is required"))
+	}
+
+	names := map[string]bool{}
+	for _, def := range sync.Triggers {
+		if names[def.TriggerName] {
+			return states.Reject(fmt.Errorf("trigger %q is declared more than once", def.TriggerName))
+		}
+		names[def.TriggerName] = true
+
+		if err := validateDefinition(def); err != nil {
+			return states.Reject(fmt.Errorf("trigger %q: %w", def.TriggerName, err))
+		}
+
+		if def.CalendarId != nil {
+			if err := w.checkCalendarActive(ctx, *def.CalendarId); err != nil {
+				return fmt.Errorf("trigger %q: calendar %s: %w", def.TriggerName, *def.CalendarId, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+func validateDefinition(def *trigger_pb.TriggerDefinition) error {
+	if def == nil {
+		return fmt.Errorf("trigger definition is required")
+	}
+	if def.TriggerName == "" {
+		return fmt.Errorf("trigger name is required")
+	}
+	return utils.ValidateDefinition(def)
+}
+
+// appTriggers returns the state of every trigger of the app, ordered by ID.
+func appTriggers(ctx context.Context, tx sqrlx.Transaction, appName string) ([]*trigger_pb.TriggerState, error) {
+	var triggers []*trigger_pb.TriggerState
+
+	rows, err := tx.Query(
+		ctx,
+		sq.Select("state").
+			From("trigger").
+			Where("state->'data'->>'appName' = ?", appName).
+			OrderBy("trigger_id"),
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("error while getting app triggers %v", err)
+	}
+
+	defer rows.Close()
+
+	for rows.Next() {
+		var data []byte
+		if err := rows.Scan(&data); err != nil {
+			return nil, fmt.Errorf("failed to scan app trigger row %v", err)
+		}
+		tm := &trigger_pb.TriggerState{}
+		if err := j5codec.Global.JSONToProto(data, tm.ProtoReflect()); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal trigger state %v", err)
+		}
+
+		triggers = append(triggers, tm)
+	}
+
+	return triggers, rows.Err()
+}
+
+// syncEvents returns the events which reconcile the existing triggers of an
+// app with the declared definitions.
+//
+// Each definition matches the live (active, paused or faulted) trigger of the
+// same name, preferring the one with the ID derived from the app and trigger
+// name, which is updated when its config differs. A definition without a live
+// trigger matches a completed or expired trigger with an identical config, so
+// one-shot triggers declared on every boot do not fire again, otherwise a
+// trigger is created. Live triggers left unmatched, including duplicates of a
+// name, are archived.
+func syncEvents(sync *trigger_pb.ActionType_Sync, existing []*trigger_pb.TriggerState, requestMetadata *messaging_j5pb.RequestMetadata) []*trigger_pb.TriggerPSMEventSpec {
+	byName := map[string][]*trigger_pb.TriggerState{}
+	takenIDs := map[string]bool{}
+	for _, state := range existing {
+		takenIDs[state.Keys.TriggerId] = true
+		if state.Status != trigger_pb.TriggerStatus_ARCHIVED {
+			byName[state.Data.TriggerName] = append(byName[state.Data.TriggerName], state)
+		}
+	}
+
+	var events []*trigger_pb.TriggerPSMEventSpec
+	matched := map[string]bool{}
+
+	for _, def := range sync.Triggers {
+		created := definitionAsCreate(sync.AppName, def, requestMetadata)
+		update := createAsUpdate(created)
+		derivedID := idempotentTriggerID(sync.AppName, def.TriggerName)
+
+		state := matchDefinition(byName[def.TriggerName], derivedID, update)
+		if state != nil {
+			matched[state.Keys.TriggerId] = true
+			if isLive(state) && !sameTriggerConfig(state.Data, update) {
+				events = append(events, syncEvent(state.Keys.TriggerId, update))
+			}
+			continue
+		}
+
+		triggerID := derivedID
+		if takenIDs[triggerID] {
+			// archived, or finished with a different config
+			triggerID = id62.NewString()
+		}
+		takenIDs[triggerID] = true
+		events = append(events, syncEvent(triggerID, created))
+	}
+
+	for _, state := range existing {
+		if isLive(state) && !matched[state.Keys.TriggerId] {
+			events = append(events, syncEvent(state.Keys.TriggerId, &trigger_pb.TriggerEventType_Archived{}))
+		}
+	}
+
+	return events
+}
+
+// matchDefinition picks the existing trigger a definition applies to, or nil
+// when a new trigger is needed.
+func matchDefinition(candidates []*trigger_pb.TriggerState, derivedID string, update *trigger_pb.TriggerEventType_Updated) *trigger_pb.TriggerState {
+	var live *trigger_pb.TriggerState
+	for _, state := range candidates {
+		if !isLive(state) {
+			continue
+		}
+		if state.Keys.TriggerId == derivedID {
+			return state
+		}
+		if live == nil {
+			live = state
+		}
+	}
+	if live != nil {
+		return live
+	}
+
+	for _, state := range candidates {
+		// each sync carries new request metadata, which alone should not
+		// recreate a trigger which has finished
+		finished := proto.Clone(update).(*trigger_pb.TriggerEventType_Updated)
+		finished.RequestMetadata = state.Data.RequestMetadata
+		if sameTriggerConfig(state.Data, finished) {
+			return state
+		}
+	}
+
+	return nil
+}
+
+// isLive reports whether the trigger can still be updated or archived.
+func isLive(state *trigger_pb.TriggerState) bool {
+	switch state.Status {
+	case trigger_pb.TriggerStatus_ACTIVE, trigger_pb.TriggerStatus_PAUSED, trigger_pb.TriggerStatus_FAULTED:
+		return true
+	}
+	return false
+}
+
+func definitionAsCreate(appName string, def *trigger_pb.TriggerDefinition, requestMetadata *messaging_j5pb.RequestMetadata) *trigger_pb.TriggerEventType_Created {
+	created := &trigger_pb.TriggerEventType_Created{
+		AppName:         appName,
+		RequestMetadata: requestMetadata,
+	}
+	utils.ApplyDefinition(created, def)
+	return created
+}
+
+func syncEvent(triggerID string, event trigger_pb.TriggerPSMEvent) *trigger_pb.TriggerPSMEventSpec {
+	return &trigger_pb.TriggerPSMEventSpec{
+		Keys: &trigger_pb.TriggerKeys{
+			TriggerId: triggerID,
+		},
+		Cause: &psm_j5pb.Cause{
+			Type: &psm_j5pb.Cause_ExternalEvent{
+				ExternalEvent: &psm_j5pb.ExternalEventCause{
+					SystemName: "trigger",
+					EventName:  "trigger_sync",
+				},
+			},
+		},
+		Event: event,
+	}
+}
@@ -0,0 +1,94 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	sq "github.com/elgris/sqrl"
+	"github.com/pentops/sqrlx.go/sqrlx"
+	"github.com/pentops/trigger/gen/o5/trigger/v1/trigger_spb"
+	"github.com/pentops/trigger/utils"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type TickStatusService struct {
+	db sqrlx.Transactor
+
+	trigger_spb.UnimplementedTriggerTickServiceServer
+}
+
+func NewTickStatusService(db sqrlx.Transactor) (*TickStatusService, error) {
+	return &TickStatusService{
+		db: db,
+	}, nil
+}
+
+func (ts *TickStatusService) TickStatus(ctx context.Context, req *trigger_spb.TickStatusRequest) (*trigger_spb.TickStatusResponse, error) {
+	stats, err := getTickStats(ctx, ts.db)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &trigger_spb.TickStatusResponse{}
+	if stats == nil {
+		// the chain has not started
+		return resp, nil
+	}
+
+	resp.LastTick = timestamppb.New(stats.LastTick)
+	resp.LagSeconds = int64(tickLag(stats.LastTick, time.Now()).Seconds())
+	resp.LastTickDurationMs = stats.Duration.Milliseconds()
+	resp.LastTickFired = int32(stats.Fired)
+
+	return resp, nil
+}
+
+// TickResult is what processing a self tick did, stored with the tick.
+type TickResult struct {
+	Duration time.Duration
+	Fired    int
+}
+
+// tickStats is the last tick stored in the selftick table.
+type tickStats struct {
+	LastTick time.Time
+	TickResult
+}
+
+// getTickStats returns the last tick stored in the selftick table, or nil
+// before the first tick.
+func getTickStats(ctx context.Context, db sqrlx.Transactor) (*tickStats, error) {
+	query := sq.Select("lasttick", "tick_duration_ms", "fired_count").
+		From("selftick").
+		Where("selftick_id = ?", "selftick")
+
+	var lastTick time.Time
+	var durationMs int64
+	var fired int
+	err := db.Transact(ctx, utils.ReadOnlyTxOptions, func(ctx context.Context, tx sqrlx.Transaction) error {
+		return tx.QueryRow(ctx, query).Scan(&lastTick, &durationMs, &fired)
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("get tick stats: %w", err)
+	}
+
+	return &tickStats{
+		LastTick: lastTick,
+		TickResult: TickResult{
+			Duration: time.Duration(durationMs) * time.Millisecond,
+			Fired:    fired,
+		},
+	}, nil
+}
+
+// tickLag returns how far now is past the last tick, or zero when the tick is
+// ahead of the clock.
+func tickLag(lastTick, now time.Time) time.Duration {
+	return max(now.Sub(lastTick), 0)
+}
@@ -5,27 +5,41 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
+	"sync"
+	"sync/atomic"
 	"time"
 
 	sq "github.com/elgris/sqrl"
+	"github.com/pentops/golib/gl"
 	"github.com/pentops/j5/gen/j5/state/v1/psm_j5pb"
-	"github.com/pentops/j5/lib/id62"
 	"github.com/pentops/j5/lib/j5codec"
 	"github.com/pentops/log.go/log"
 	"github.com/pentops/o5-messaging/outbox"
 	"github.com/pentops/sqrlx.go/sqrlx"
 	"github.com/pentops/trigger/gen/o5/trigger/v1/trigger_pb"
 	"github.com/pentops/trigger/gen/o5/trigger/v1/trigger_tpb"
+	"github.com/pentops/trigger/states"
 	"github.com/pentops/trigger/utils"
 	"github.com/robfig/cron/v3"
+	"go.opentelemetry.io/otel"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/emptypb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 type TriggerWorker struct {
-	db     sqrlx.Transactor
-	sm     *trigger_pb.TriggerPSM
-	sender *outbox.Sender
+	db         sqrlx.Transactor
+	sm         *trigger_pb.TriggerPSM
+	calendarSM *trigger_pb.CalendarPSM
+	sender     *outbox.Sender
+	metrics    *tickMetrics
+
+	// tickInterval is how often the self tick message is sent, each tick
+	// covers every tick resolution slot since the last
+	tickInterval time.Duration
+
+	// now is the wall clock new and updated triggers are scheduled from
+	now func() time.Time
 
 	trigger_tpb.UnimplementedTriggerPublishTopicServer
 	trigger_tpb.UnimplementedSelfTickTopicServer
@@ -34,29 +48,67 @@ type TriggerWorker struct {
 }
 
 const (
-	triggerCron    = "*/1 * * * *" // every 1 minutes
-	triggerCadence = 1 * time.Minute
+	triggerCron    = "*/5 * * * * *" // every 5 seconds
+	triggerCadence = utils.TickResolution
+
+	// lateTolerance is how far behind the wall clock a tick can be processed
+	// before it counts as late, and each trigger's catch-up policy applies.
+	lateTolerance = 1 * time.Minute
+
+	// maxTickInterval bounds the tick interval, slots at the start of a tick
+	// are handled this much after their time.
+	maxTickInterval = 1 * time.Minute
+
+	// retryWindow is how long a fire time missed because its tick failed for
+	// the trigger is retried on later ticks. Older next fire times were not
+	// set by a tick, e.g. before the tick loop started, and are not fired.
+	retryWindow = 10 * time.Minute
+
+	// fireLedgerRetention is how long fires are kept in the trigger_fire
+	// ledger, long past when a tick for them could be redelivered.
+	fireLedgerRetention = 24 * time.Hour
 )
 
 var ErrNotFound = errors.New("not found")
 
-func NewTriggerWorker(db sqrlx.Transactor, sm *trigger_pb.TriggerPSM) (*TriggerWorker, error) {
+func NewTriggerWorker(db sqrlx.Transactor, sm *trigger_pb.TriggerPSM, calendarSM *trigger_pb.CalendarPSM) (*TriggerWorker, error) {
 	sender := outbox.NewSender(outbox.DefaultConfig)
 
+	metrics, err := newTickMetrics(otel.Meter(meterName), db)
+	if err != nil {
+		return nil, err
+	}
+
 	return &TriggerWorker{
-		db:     db,
-		sender: sender,
-		sm:     sm,
+		db:           db,
+		sender:       sender,
+		sm:           sm,
+		calendarSM:   calendarSM,
+		metrics:      metrics,
+		tickInterval: triggerCadence,
+		now:          time.Now,
 	}, nil
 }
 
+// SetTickInterval sets how often the self tick message is sent, every tick
+// resolution by default. A longer interval sends fewer ticks, each handling
+// the slots since the last in turn, so triggers fire up to the interval after
+// their time.
+func (w *TriggerWorker) SetTickInterval(interval time.Duration) error {
+	if interval <= 0 || interval%triggerCadence != 0 || interval > maxTickInterval {
+		return fmt.Errorf("tick interval must be a multiple of %s up to %s, got %s", triggerCadence, maxTickInterval, interval)
+	}
+	w.tickInterval = interval
+	return nil
+}
+
 func (w TriggerWorker) InitSelfTick(ctx context.Context) error {
 	_, err := w.GetLastTick(ctx)
 	if err != nil {
 		if errors.Is(err, ErrNotFound) {
 			log.Info(ctx, "no previous self tick found, creating one")
-			now := time.Date(time.Now().Year(), time.Now().Month(), time.Now().Day(), time.Now().Hour(), time.Now().Minute()-1, 0, 0, time.UTC)
-			err = w.SendSelfTick(ctx, &now)
+			now := time.Now().In(time.UTC).Truncate(triggerCadence).Add(triggerCadence * -1)
+			err = w.SendSelfTick(ctx, &now, TickResult{})
 			if err != nil {
 				return fmt.Errorf("failed to sendSelfTick during InitSelfTick: %v", err)
 			}
@@ -69,15 +121,266 @@ func (w TriggerWorker) InitSelfTick(ctx context.Context) error {
 }
 
 func (w *TriggerWorker) TriggerManageRequest(ctx context.Context, req *trigger_tpb.TriggerManageRequestMessage) (*emptypb.Empty, error) {
+	switch req.Action.Type.(type) {
+	case *trigger_pb.ActionType_CreateCalendar_, *trigger_pb.ActionType_UpdateCalendar_, *trigger_pb.ActionType_ArchiveCalendar_:
+		return w.manageCalendar(ctx, req)
+	case *trigger_pb.ActionType_Sync_:
+		return w.syncTriggers(ctx, req)
+	}
+
+	evt, err := w.manageTriggerEvent(ctx, req)
+	if err != nil {
+		return w.rejectManageRequest(ctx, req, &trigger_tpb.TriggerManageReplyMessage{
+			TriggerId: requestedTriggerID(req.Action),
+		}, err)
+	}
+
+	err = w.db.Transact(ctx, utils.MutableTxOptions, func(ctx context.Context, tx sqrlx.Transaction) error {
+		state, err := applyTriggerEvent(ctx, tx, w.sm, evt, w.now())
+		if err != nil {
+			return err
+		}
+
+		return w.sendManageReply(ctx, tx, req, &trigger_tpb.TriggerManageReplyMessage{
+			TriggerId:  &state.Keys.TriggerId,
+			Success:    true,
+			NextFireAt: state.Data.NextFireAt,
+		})
+	})
+	if err != nil {
+		return w.rejectManageRequest(ctx, req, &trigger_tpb.TriggerManageReplyMessage{
+			TriggerId: &evt.Keys.TriggerId,
+		}, err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// applyTriggerEvent transitions the trigger with the event. Creating a trigger
+// which already exists is a no-op when the config is identical, otherwise the
+// create is applied as an update. A trigger which has stopped for good, or is
+// archived, is not brought back by a create, which is rejected.
+func applyTriggerEvent(ctx context.Context, tx sqrlx.Transaction, sm *trigger_pb.TriggerPSM, evt *trigger_pb.TriggerPSMEventSpec, now time.Time) (*trigger_pb.TriggerState, error) {
+	created, ok := evt.Event.(*trigger_pb.TriggerEventType_Created)
+	if !ok {
+		return transitionTrigger(ctx, tx, sm, evt, now)
+	}
+
+	existing, err := getTrigger(ctx, tx, evt.Keys.TriggerId)
+	if err != nil {
+		return nil, err
+	}
+	if existing == nil {
+		return transitionTrigger(ctx, tx, sm, evt, now)
+	}
+	if !isLive(existing) {
+		return nil, states.Reject(fmt.Errorf("trigger %s is %s, create it with another name or ID",
+			existing.Keys.TriggerId, existing.Status.ShortString()))
+	}
+
+	update := createAsUpdate(created)
+	if sameTriggerConfig(existing.Data, update) {
+		return existing, nil
+	}
+
+	evt.Event = update
+	return transitionTrigger(ctx, tx, sm, evt, now)
+}
+
+// transitionTrigger transitions the trigger with the event, after setting the
+// next fire time the event leaves the trigger with, from scheduleFrom.
+func transitionTrigger(ctx context.Context, tx sqrlx.Transaction, sm *trigger_pb.TriggerPSM, evt *trigger_pb.TriggerPSMEventSpec, now time.Time) (*trigger_pb.TriggerState, error) {
+	after, err := scheduleFrom(ctx, tx, now)
+	if err != nil {
+		return nil, err
+	}
+
+	return transitionTriggerAfter(ctx, tx, sm, evt, after)
+}
+
+// scheduleFrom returns the time new and updated triggers are next scheduled
+// after, the last tick, or now when the tick loop is behind, e.g. after an
+// outage, so a trigger does not fire for a slot from before it existed.
+func scheduleFrom(ctx context.Context, tx sqrlx.Transaction, now time.Time) (time.Time, error) {
+	lastTick, err := lastTickTime(ctx, tx)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	if now.After(lastTick) {
+		return now, nil
+	}
+	return lastTick, nil
+}
+
+// transitionTriggerAfter transitions the trigger with the event, after setting
+// the next fire time the event leaves the trigger with, after the given time.
+func transitionTriggerAfter(ctx context.Context, tx sqrlx.Transaction, sm *trigger_pb.TriggerPSM, evt *trigger_pb.TriggerPSMEventSpec, after time.Time) (*trigger_pb.TriggerState, error) {
+	if err := scheduleEvent(ctx, tx, evt, after); err != nil {
+		return nil, err
+	}
+
+	return sm.TransitionInTx(ctx, tx, evt)
+}
+
+// rescheduleTrigger moves the next fire time of the active trigger to after the
+// given time, when it has changed.
+func rescheduleTrigger(ctx context.Context, tx sqrlx.Transaction, sm *trigger_pb.TriggerPSM, state *trigger_pb.TriggerState, after time.Time, cause *psm_j5pb.Cause) error {
+	rescheduled := &trigger_pb.TriggerEventType_Rescheduled{}
+	evt := &trigger_pb.TriggerPSMEventSpec{
+		Keys: &trigger_pb.TriggerKeys{
+			TriggerId: state.Keys.TriggerId,
+		},
+		Cause: cause,
+		Event: rescheduled,
+	}
+
+	if err := scheduleEvent(ctx, tx, evt, after); err != nil {
+		return err
+	}
+	if proto.Equal(rescheduled.NextFireAt, state.Data.NextFireAt) {
+		return nil
+	}
+
+	if _, err := sm.TransitionInTx(ctx, tx, evt); err != nil {
+		return fmt.Errorf("reschedule trigger %s: %w", state.Keys.TriggerId, err)
+	}
+
+	return nil
+}
+
+// lastTickTime returns the time of the last tick, which the tick loop carries
+// on from. Before the loop has started it is the zero time, so every trigger
+// is due on the first tick, which fires or reschedules it.
+func lastTickTime(ctx context.Context, tx sqrlx.Transaction) (time.Time, error) {
+	query := sq.Select("lasttick").
+		From("selftick").
+		Where("selftick_id = ?", "selftick")
+
+	var lastTick time.Time
+	if err := tx.QueryRow(ctx, query).Scan(&lastTick); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return time.Time{}, nil
+		}
+		return time.Time{}, fmt.Errorf("failed to get last tick: %w", err)
+	}
+
+	return lastTick, nil
+}
+
+// scheduleEvent sets nextFireAt on events which leave the trigger active, for
+// the state machine to keep on the state. The next fire time depends on the
+// calendar of the trigger, which the state machine cannot load, so it is
+// found here from the trigger data the event leaves, after the given time,
+// the tick time for a Triggered event.
+func scheduleEvent(ctx context.Context, tx sqrlx.Transaction, evt *trigger_pb.TriggerPSMEventSpec, after time.Time) error {
+	if created, ok := evt.Event.(*trigger_pb.TriggerEventType_Created); ok {
+		data := updatedData(&trigger_pb.TriggerData{}, createAsUpdate(created))
+		nextFireAt, err := scheduledFireAt(ctx, tx, data, after)
+		if err != nil {
+			return err
+		}
+		created.NextFireAt = nextFireAt
+		return nil
+	}
+
+	existing, err := getTrigger(ctx, tx, evt.Keys.TriggerId)
+	if err != nil {
+		return err
+	}
+	if existing == nil {
+		// the state machine rejects the event
+		return nil
+	}
+
+	switch event := evt.Event.(type) {
+	case *trigger_pb.TriggerEventType_Updated:
+		if existing.Status != trigger_pb.TriggerStatus_ACTIVE {
+			return nil
+		}
+		event.NextFireAt, err = scheduledFireAt(ctx, tx, updatedData(existing.Data, event), after)
+
+	case *trigger_pb.TriggerEventType_Triggered:
+		if existing.Data.RunAt != nil {
+			// one-shot triggers complete once fired
+			return nil
+		}
+		data := proto.Clone(existing.Data).(*trigger_pb.TriggerData)
+		data.FireCount++
+		event.NextFireAt, err = scheduledFireAt(ctx, tx, data, after)
+
+	case *trigger_pb.TriggerEventType_Activated:
+		event.NextFireAt, err = scheduledFireAt(ctx, tx, existing.Data, after)
+
+	case *trigger_pb.TriggerEventType_Restored:
+		if !event.Activate {
+			return nil
+		}
+		event.NextFireAt, err = scheduledFireAt(ctx, tx, existing.Data, after)
+
+	case *trigger_pb.TriggerEventType_Rescheduled:
+		event.NextFireAt, err = scheduledFireAt(ctx, tx, existing.Data, after)
+	}
+
+	return err
+}
+
+// idempotentTriggerID derives the ID of a trigger created without one, so a
+// create re-sent for the same app and trigger name finds the same trigger.
+func idempotentTriggerID(appName, triggerName string) string {
+	return utils.NewIdempotentId62([]byte(fmt.Sprintf("%q/%q", appName, triggerName)))
+}
+
+// createAsUpdate returns an update event setting the config of the create.
+func createAsUpdate(created *trigger_pb.TriggerEventType_Created) *trigger_pb.TriggerEventType_Updated {
+	update := &trigger_pb.TriggerEventType_Updated{
+		AppName:         created.AppName,
+		RequestMetadata: created.RequestMetadata,
+	}
+	utils.ApplyDefinition(update, utils.DefinitionOf(created))
+	return update
+}
+
+// sameTriggerConfig reports whether applying the update would leave the
+// trigger data unchanged.
+func sameTriggerConfig(data *trigger_pb.TriggerData, update *trigger_pb.TriggerEventType_Updated) bool {
+	return proto.Equal(data, updatedData(data, update))
+}
+
+// updatedData returns a copy of the trigger data with the config of the update
+// applied, as the state machine applies it.
+func updatedData(data *trigger_pb.TriggerData, update *trigger_pb.TriggerEventType_Updated) *trigger_pb.TriggerData {
+	updated := proto.Clone(data).(*trigger_pb.TriggerData)
+	utils.ApplyDefinition(updated, utils.DefinitionOf(update))
+	updated.AppName = update.AppName
+	updated.RequestMetadata = update.RequestMetadata
+
+	return updated
+}
+
+// manageTriggerEvent validates a trigger action and builds the event for it.
+func (w *TriggerWorker) manageTriggerEvent(ctx context.Context, req *trigger_tpb.TriggerManageRequestMessage) (*trigger_pb.TriggerPSMEventSpec, error) {
 	var evt *trigger_pb.TriggerPSMEventSpec
 
 	switch req.Action.Type.(type) {
 	case *trigger_pb.ActionType_Create_:
-		if validateCron(req.Action.GetCreate().Cron) != nil {
-			return nil, fmt.Errorf("invalid cron string: %v", validateCron(req.Action.GetCreate().Cron).Error())
+		def := utils.DefinitionOf(req.Action.GetCreate())
+		if err := utils.ValidateDefinition(def); err != nil {
+			return nil, states.Reject(err)
+		}
+		if calendarID := def.CalendarId; calendarID != nil {
+			if err := w.checkCalendarActive(ctx, *calendarID); err != nil {
+				return nil, fmt.Errorf("calendar %s: %w", *calendarID, err)
+			}
+		}
+
+		created := &trigger_pb.TriggerEventType_Created{
+			AppName:         req.Action.GetCreate().AppName,
+			RequestMetadata: req.GetJ5RequestMetadata(),
 		}
+		utils.ApplyDefinition(created, def)
 
-		newTriggerID := id62.NewString()
+		newTriggerID := idempotentTriggerID(req.Action.GetCreate().AppName, req.Action.GetCreate().TriggerName)
 		triggerIDFromAction := req.GetAction().GetCreate().TriggerId
 		if triggerIDFromAction != nil {
 			newTriggerID = *triggerIDFromAction
@@ -95,19 +398,26 @@ func (w *TriggerWorker) TriggerManageRequest(ctx context.Context, req *trigger_t
 					},
 				},
 			},
-			Event: &trigger_pb.TriggerEventType_Created{
-				TriggerName:     req.Action.GetCreate().TriggerName,
-				AppName:         req.Action.GetCreate().AppName,
-				Cron:            req.Action.GetCreate().Cron,
-				RequestMetadata: req.GetJ5RequestMetadata(),
-			},
+			Event: created,
 		}
 
 	case *trigger_pb.ActionType_Update_:
-		if validateCron(req.Action.GetUpdate().Cron) != nil {
-			return nil, fmt.Errorf("invalid cron string: %v", validateCron(req.Action.GetUpdate().Cron).Error())
+		def := utils.DefinitionOf(req.Action.GetUpdate())
+		if err := utils.ValidateDefinition(def); err != nil {
+			return nil, states.Reject(err)
+		}
+		if calendarID := def.CalendarId; calendarID != nil {
+			if err := w.checkCalendarActive(ctx, *calendarID); err != nil {
+				return nil, fmt.Errorf("calendar %s: %w", *calendarID, err)
+			}
 		}
 
+		updated := &trigger_pb.TriggerEventType_Updated{
+			AppName:         req.Action.GetUpdate().AppName,
+			RequestMetadata: req.GetJ5RequestMetadata(),
+		}
+		utils.ApplyDefinition(updated, def)
+
 		evt = &trigger_pb.TriggerPSMEventSpec{
 			Keys: &trigger_pb.TriggerKeys{
 				TriggerId: req.Action.GetUpdate().TriggerId,
@@ -120,12 +430,7 @@ func (w *TriggerWorker) TriggerManageRequest(ctx context.Context, req *trigger_t
 					},
 				},
 			},
-			Event: &trigger_pb.TriggerEventType_Updated{
-				TriggerName:     req.Action.GetUpdate().TriggerName,
-				AppName:         req.Action.GetUpdate().AppName,
-				Cron:            req.Action.GetUpdate().Cron,
-				RequestMetadata: req.GetJ5RequestMetadata(),
-			},
+			Event: updated,
 		}
 
 	case *trigger_pb.ActionType_Archive_:
@@ -144,88 +449,487 @@ func (w *TriggerWorker) TriggerManageRequest(ctx context.Context, req *trigger_t
 			Event: &trigger_pb.TriggerEventType_Archived{},
 		}
 
+	case *trigger_pb.ActionType_Pause_:
+		evt = &trigger_pb.TriggerPSMEventSpec{
+			Keys: &trigger_pb.TriggerKeys{
+				TriggerId: req.Action.GetPause().TriggerId,
+			},
+			Cause: &psm_j5pb.Cause{
+				Type: &psm_j5pb.Cause_ExternalEvent{
+					ExternalEvent: &psm_j5pb.ExternalEventCause{
+						SystemName: "trigger",
+						EventName:  "trigger_pause",
+					},
+				},
+			},
+			Event: &trigger_pb.TriggerEventType_Paused{},
+		}
+
+	case *trigger_pb.ActionType_Resume_:
+		evt = &trigger_pb.TriggerPSMEventSpec{
+			Keys: &trigger_pb.TriggerKeys{
+				TriggerId: req.Action.GetResume().TriggerId,
+			},
+			Cause: &psm_j5pb.Cause{
+				Type: &psm_j5pb.Cause_ExternalEvent{
+					ExternalEvent: &psm_j5pb.ExternalEventCause{
+						SystemName: "trigger",
+						EventName:  "trigger_resume",
+					},
+				},
+			},
+			Event: &trigger_pb.TriggerEventType_Activated{},
+		}
+
+	case *trigger_pb.ActionType_ManuallyTrigger_:
+		triggerTime := req.Action.GetManuallyTrigger().TriggerTime
+		if triggerTime == nil {
+			triggerTime = timestamppb.Now()
+		}
+
+		evt = &trigger_pb.TriggerPSMEventSpec{
+			Keys: &trigger_pb.TriggerKeys{
+				TriggerId: req.Action.GetManuallyTrigger().TriggerId,
+			},
+			Cause: &psm_j5pb.Cause{
+				Type: &psm_j5pb.Cause_ExternalEvent{
+					ExternalEvent: &psm_j5pb.ExternalEventCause{
+						SystemName: "trigger",
+						EventName:  "trigger_manual",
+					},
+				},
+			},
+			Event: &trigger_pb.TriggerEventType_ManuallyTriggered{
+				TriggerTime: triggerTime,
+			},
+		}
+
+	case *trigger_pb.ActionType_Restore_:
+		evt = &trigger_pb.TriggerPSMEventSpec{
+			Keys: &trigger_pb.TriggerKeys{
+				TriggerId: req.Action.GetRestore().TriggerId,
+			},
+			Cause: &psm_j5pb.Cause{
+				Type: &psm_j5pb.Cause_ExternalEvent{
+					ExternalEvent: &psm_j5pb.ExternalEventCause{
+						SystemName: "trigger",
+						EventName:  "trigger_restore",
+					},
+				},
+			},
+			Event: &trigger_pb.TriggerEventType_Restored{
+				Reason:   req.Action.GetRestore().Reason,
+				Activate: req.Action.GetRestore().Activate,
+			},
+		}
+
 	default:
-		return nil, fmt.Errorf("unknown action type %T", req.Action.Type)
+		return nil, states.Reject(fmt.Errorf("unknown action type %T", req.Action.Type))
 	}
 
-	_, err := w.sm.Transition(ctx, w.db, evt)
+	return evt, nil
+}
+
+// requestedTriggerID returns the ID of the trigger an action is for, when the
+// requester set it.
+func requestedTriggerID(action *trigger_pb.ActionType) *string {
+	switch action.Type.(type) {
+	case *trigger_pb.ActionType_Create_:
+		return action.GetCreate().TriggerId
+	case *trigger_pb.ActionType_Update_:
+		return &action.GetUpdate().TriggerId
+	case *trigger_pb.ActionType_Archive_:
+		return &action.GetArchive().TriggerId
+	case *trigger_pb.ActionType_Pause_:
+		return &action.GetPause().TriggerId
+	case *trigger_pb.ActionType_Resume_:
+		return &action.GetResume().TriggerId
+	case *trigger_pb.ActionType_ManuallyTrigger_:
+		return &action.GetManuallyTrigger().TriggerId
+	case *trigger_pb.ActionType_Restore_:
+		return &action.GetRestore().TriggerId
+	}
+	return nil
+}
+
+// sendManageReply publishes the outcome of a manage request to the requester.
+// Requests without request metadata have nobody to reply to.
+func (w *TriggerWorker) sendManageReply(ctx context.Context, tx sqrlx.Transaction, req *trigger_tpb.TriggerManageRequestMessage, reply *trigger_tpb.TriggerManageReplyMessage) error {
+	if req.Request == nil {
+		return nil
+	}
+
+	reply.Request = req.Request
+	return w.sender.Send(ctx, tx, reply)
+}
+
+// rejectManageRequest replies to the requester with the reason a manage
+// request was rejected, and acknowledges the request in place of retrying it.
+// Any other failure, e.g. losing the database connection, is returned for the
+// request to be redelivered, as is a rejection with nobody to reply to, or a
+// reply which fails.
+func (w *TriggerWorker) rejectManageRequest(ctx context.Context, req *trigger_tpb.TriggerManageRequestMessage, reply *trigger_tpb.TriggerManageReplyMessage, cause error) (*emptypb.Empty, error) {
+	if req.Request == nil || !states.IsRejected(cause) {
+		return nil, cause
+	}
+
+	log.WithError(ctx, cause).Warn("rejected manage request")
+
+	reply.Success = false
+	reply.Error = gl.Ptr(cause.Error())
+
+	err := w.db.Transact(ctx, utils.MutableTxOptions, func(ctx context.Context, tx sqrlx.Transaction) error {
+		return w.sendManageReply(ctx, tx, req, reply)
+	})
 	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("failed to reply to rejected manage request (%v): %w", cause, err)
 	}
 
 	return &emptypb.Empty{}, nil
 }
 
+// scheduledFireAt returns when the trigger data is next scheduled to fire
+// after the given time, or nil when it will not fire again.
+func scheduledFireAt(ctx context.Context, tx sqrlx.Transaction, data *trigger_pb.TriggerData, after time.Time) (*timestamppb.Timestamp, error) {
+	var calendar *trigger_pb.CalendarData
+	if data.CalendarId != nil {
+		var err error
+		calendar, err = activeCalendar(ctx, tx, *data.CalendarId)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	next, err := nextFireTime(data, calendar, after)
+	if err != nil {
+		return nil, err
+	}
+	if next == nil {
+		return nil, nil
+	}
+
+	return timestamppb.New(*next), nil
+}
+
+// tickConcurrency bounds how many due triggers a tick transitions at once.
+const tickConcurrency = 8
+
 func (w *TriggerWorker) SelfTick(ctx context.Context, req *trigger_tpb.SelfTickMessage) (*emptypb.Empty, error) {
-	activeTriggers, err := w.AllActive(ctx)
+	started := time.Now()
+
+	slots, err := tickSlots(req.LastTick.AsTime(), w.tickInterval)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get trigger time %v", err)
+	}
+	triggerTime := slots[len(slots)-1]
+
+	calendars, err := w.AllCalendars(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("self tick: %w", err)
 	}
 
-	triggerTime, err := nextTick(req.LastTick.AsTime())
+	now := time.Now().In(time.UTC)
+
+	var fired atomic.Int64
+	for _, slot := range slots {
+		dueTriggers, err := w.DueTriggers(ctx, slot)
+		if err != nil {
+			return nil, fmt.Errorf("self tick: %w", err)
+		}
+
+		// a slot before the end of the tick is checked as of its own time,
+		// so it is only late when the tick is
+		slotNow := now.Add(slot.Sub(triggerTime))
+
+		// each trigger commits in its own transaction, a trigger which fails
+		// keeps its next fire time, which the next slot fires in place of its
+		// own, so it is logged rather than holding back every other trigger.
+		failed := runBatch(ctx, tickConcurrency, dueTriggers, func(ctx context.Context, trigger *trigger_pb.TriggerState) error {
+			didFire, err := w.tickTrigger(ctx, trigger, slot, calendars, slotNow)
+			if didFire {
+				fired.Add(1)
+			}
+			return err
+		})
+		for idx, err := range failed {
+			log.WithFields(ctx,
+				"triggerId", dueTriggers[idx].Keys.TriggerId,
+				"tickTime", slot.Format(time.RFC3339),
+				"error", err.Error(),
+			).Error("failed to tick trigger")
+		}
+
+		if err := ctx.Err(); err != nil {
+			return nil, fmt.Errorf("self tick: %w", err)
+		}
+	}
+
+	result := TickResult{
+		Duration: time.Since(started),
+		Fired:    int(fired.Load()),
+	}
+
+	err = w.SendSelfTick(ctx, &triggerTime, result)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get trigger time %v", err)
+		return nil, err
 	}
 
-	for _, trigger := range activeTriggers {
-		sendTriggerEvt, err := checkCron(trigger.Data.Cron, *triggerTime)
+	w.metrics.recordTick(ctx, result)
+
+	return &emptypb.Empty{}, nil
+}
+
+// tickSlots returns the slots the tick after lastTick covers, every tick
+// resolution up to the next multiple of the tick interval.
+func tickSlots(lastTick time.Time, interval time.Duration) ([]time.Time, error) {
+	end := lastTick.Truncate(interval).Add(interval)
+
+	var slots []time.Time
+	for slot := lastTick; slot.Before(end); {
+		next, err := nextTick(slot)
 		if err != nil {
 			return nil, err
 		}
+		slot = *next
+		slots = append(slots, slot)
+	}
 
-		if sendTriggerEvt {
-			evt := trigger_pb.TriggerPSMEventSpec{
-				Keys: &trigger_pb.TriggerKeys{
-					TriggerId: trigger.Keys.TriggerId,
-				},
-				Cause: &psm_j5pb.Cause{
-					Type: &psm_j5pb.Cause_ExternalEvent{
-						ExternalEvent: &psm_j5pb.ExternalEventCause{
-							SystemName: "trigger",
-							EventName:  "trigger_tick",
-						},
-					},
-				},
-				Event: &trigger_pb.TriggerEventType_Triggered{
-					TriggerTime: timestamppb.New(*triggerTime),
-				},
-			}
+	return slots, nil
+}
 
-			err := w.db.Transact(ctx, utils.MutableTxOptions, func(ctx context.Context, tx sqrlx.Transaction) error {
-				_, err := w.sm.TransitionInTx(ctx, tx, &evt)
-				if err != nil {
-					return fmt.Errorf("failed to trigger event: %w", err)
-				}
+// tickTrigger fires, expires or reschedules a single due trigger for the tick,
+// reporting whether it fired.
+func (w *TriggerWorker) tickTrigger(ctx context.Context, trigger *trigger_pb.TriggerState, triggerTime time.Time, calendars map[string]*trigger_pb.CalendarData, now time.Time) (bool, error) {
+	if isExpired(trigger.Data, triggerTime) {
+		return false, w.expireTrigger(ctx, trigger.Keys.TriggerId)
+	}
+	if trigger.Status != trigger_pb.TriggerStatus_ACTIVE {
+		// paused and faulted triggers are only due to expire
+		return false, nil
+	}
 
-				return nil
-			})
+	// a fire time left by an earlier tick which failed for the trigger is
+	// checked in place of this tick, late fires are then left to the catch-up
+	// policy, and the trigger is rescheduled from it so no time is skipped
+	checkTime := triggerTime
+	if missed := trigger.Data.NextFireAt; missed != nil && missed.AsTime().Before(triggerTime) && triggerTime.Sub(missed.AsTime()) <= retryWindow {
+		checkTime = missed.AsTime()
+	}
+
+	// the checks only read the stored trigger, so an error will not go away on
+	// the next tick, the trigger is quarantined until it is fixed
+	fireTime, err := checkTrigger(trigger.Data, checkTime)
+	if err != nil {
+		return false, w.faultTrigger(ctx, trigger.Keys.TriggerId, err)
+	}
+
+	rolled := false
+	if trigger.Data.CalendarId != nil {
+		calendar := calendars[*trigger.Data.CalendarId]
+		if calendar == nil {
+			log.WithField(ctx, "calendarId", *trigger.Data.CalendarId).Warn("trigger calendar is not active, ignoring it")
+		}
+		fireTime, rolled, err = checkCalendar(trigger.Data, calendar, checkTime, fireTime)
+		if err != nil {
+			return false, w.faultTrigger(ctx, trigger.Keys.TriggerId, err)
+		}
+	}
+
+	sendTriggerEvt := fireTime != nil && inWindow(trigger.Data, *fireTime)
+	late := sendTriggerEvt && isLate(*fireTime, now)
+	if rolled {
+		// a rolled fire is for an earlier date, it is only late if the tick is
+		late = sendTriggerEvt && isLate(checkTime, now)
+	}
+
+	// one-shot triggers fire however late they are, catch-up only applies
+	// to repeating schedules.
+	if late && trigger.Data.RunAt == nil {
+		sendTriggerEvt, err = checkCatchUp(trigger.Data, *fireTime, now)
+		if err != nil {
+			return false, w.faultTrigger(ctx, trigger.Keys.TriggerId, err)
+		}
+	}
+
+	if !sendTriggerEvt {
+		// the trigger was due, but not for this tick, e.g. a missed time
+		// which is skipped, or its calendar has changed
+		return false, w.db.Transact(ctx, utils.MutableTxOptions, func(ctx context.Context, tx sqrlx.Transaction) error {
+			return rescheduleTrigger(ctx, tx, w.sm, trigger, checkTime, tickCause())
+		})
+	}
+
+	evt := trigger_pb.TriggerPSMEventSpec{
+		Keys: &trigger_pb.TriggerKeys{
+			TriggerId: trigger.Keys.TriggerId,
+		},
+		Cause: tickCause(),
+		Event: &trigger_pb.TriggerEventType_Triggered{
+			TriggerTime: timestamppb.New(*fireTime),
+			Late:        late,
+		},
+	}
+
+	err = w.db.Transact(ctx, utils.MutableTxOptions, func(ctx context.Context, tx sqrlx.Transaction) error {
+		_, err := transitionTriggerAfter(ctx, tx, w.sm, &evt, checkTime)
+		if err != nil {
+			return fmt.Errorf("failed to trigger event: %w", err)
+		}
+
+		return nil
+	})
+	if errors.Is(err, states.ErrAlreadyFired) {
+		// a replayed tick, the first delivery already replied. The fire rolled
+		// back, so the trigger is moved past the time instead. It is reloaded
+		// as it may have changed since this tick read it, e.g. been paused or
+		// archived, which a reschedule must not undo or fail on.
+		log.WithField(ctx, "triggerId", trigger.Keys.TriggerId).Info("trigger already fired for this time, skipping")
+		return false, w.db.Transact(ctx, utils.MutableTxOptions, func(ctx context.Context, tx sqrlx.Transaction) error {
+			current, err := getTrigger(ctx, tx, trigger.Keys.TriggerId)
 			if err != nil {
-				return nil, err
+				return err
 			}
+			if current == nil || current.Status != trigger_pb.TriggerStatus_ACTIVE {
+				return nil
+			}
+			return rescheduleTrigger(ctx, tx, w.sm, current, checkTime, tickCause())
+		})
+	}
+	if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// runBatch calls fn for every item, running at most limit at once, and
+// returns the errors of the items which failed by their index. A failed item
+// does not stop the others, items not yet started when the context ends are
+// skipped with its error.
+func runBatch[T any](ctx context.Context, limit int, items []T, fn func(context.Context, T) error) map[int]error {
+	failed := map[int]error{}
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+
+	sem := make(chan struct{}, limit)
+	for idx, item := range items {
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+			mu.Lock()
+			failed[idx] = ctx.Err()
+			mu.Unlock()
+			continue
+		}
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			if err := fn(ctx, item); err != nil {
+				mu.Lock()
+				failed[idx] = err
+				mu.Unlock()
+			}
+		}()
+	}
+
+	wg.Wait()
+	return failed
+}
+
+func tickCause() *psm_j5pb.Cause {
+	return &psm_j5pb.Cause{
+		Type: &psm_j5pb.Cause_ExternalEvent{
+			ExternalEvent: &psm_j5pb.ExternalEventCause{
+				SystemName: "trigger",
+				EventName:  "trigger_tick",
+			},
+		},
+	}
+}
+
+func (w TriggerWorker) expireTrigger(ctx context.Context, triggerID string) error {
+	evt := trigger_pb.TriggerPSMEventSpec{
+		Keys: &trigger_pb.TriggerKeys{
+			TriggerId: triggerID,
+		},
+		Cause: &psm_j5pb.Cause{
+			Type: &psm_j5pb.Cause_ExternalEvent{
+				ExternalEvent: &psm_j5pb.ExternalEventCause{
+					SystemName: "trigger",
+					EventName:  "trigger_expire",
+				},
+			},
+		},
+		Event: &trigger_pb.TriggerEventType_Expired{},
+	}
+
+	err := w.db.Transact(ctx, utils.MutableTxOptions, func(ctx context.Context, tx sqrlx.Transaction) error {
+		_, err := w.sm.TransitionInTx(ctx, tx, &evt)
+		if err != nil {
+			return fmt.Errorf("failed to expire trigger: %w", err)
 		}
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// faultTrigger quarantines a trigger the tick could not check, recording why
+// on the event. It stays FAULTED until it is updated and resumed.
+func (w TriggerWorker) faultTrigger(ctx context.Context, triggerID string, cause error) error {
+	log.WithFields(ctx,
+		"triggerId", triggerID,
+		"error", cause.Error(),
+	).Warn("trigger could not be checked, faulting it")
+
+	evt := trigger_pb.TriggerPSMEventSpec{
+		Keys: &trigger_pb.TriggerKeys{
+			TriggerId: triggerID,
+		},
+		Cause: &psm_j5pb.Cause{
+			Type: &psm_j5pb.Cause_ExternalEvent{
+				ExternalEvent: &psm_j5pb.ExternalEventCause{
+					SystemName: "trigger",
+					EventName:  "trigger_fault",
+				},
+			},
+		},
+		Event: &trigger_pb.TriggerEventType_Faulted{
+			Error: cause.Error(),
+		},
 	}
 
-	err = w.SendSelfTick(ctx, triggerTime)
+	err := w.db.Transact(ctx, utils.MutableTxOptions, func(ctx context.Context, tx sqrlx.Transaction) error {
+		_, err := w.sm.TransitionInTx(ctx, tx, &evt)
+		if err != nil {
+			return fmt.Errorf("failed to fault trigger: %w", err)
+		}
+
+		return nil
+	})
 	if err != nil {
-		return nil, err
+		return err
 	}
 
-	return &emptypb.Empty{}, nil
+	return nil
 }
 
-func (w TriggerWorker) SendSelfTick(ctx context.Context, triggeredTime *time.Time) error {
+// SendSelfTick records the tick and sends the message for the next one. Only
+// a tick which moves the stored lasttick forward continues the chain, so a
+// duplicate of the chain, from a redelivered or recovered tick, ends here.
+func (w TriggerWorker) SendSelfTick(ctx context.Context, triggeredTime *time.Time, result TickResult) error {
 	err := w.db.Transact(ctx, utils.MutableTxOptions, func(ctx context.Context, tx sqrlx.Transaction) error {
-		// send self tick
 		msg := &trigger_tpb.SelfTickMessage{
 			LastTick: timestamppb.New(*triggeredTime),
 		}
 
-		delay := calcDelay(*triggeredTime, time.Now().In(time.UTC))
-		err := w.sender.SendDelayed(ctx, tx, delay, msg)
-		if err != nil {
-			return fmt.Errorf("failed to send delayed tick %v", err)
-		}
-
 		// upsert selftick table
 		asJSON, err := j5codec.Global.ProtoToJSON(msg.ProtoReflect())
 		if err != nil {
@@ -236,12 +940,37 @@ func (w TriggerWorker) SendSelfTick(ctx context.Context, triggeredTime *time.Tim
 			Key("selftick_id", "selftick").
 			Set("data", asJSON).
 			Set("lasttick", triggeredTime).
+			Set("tick_duration_ms", result.Duration.Milliseconds()).
+			Set("fired_count", result.Fired).
+			Set("ticked_at", time.Now().In(time.UTC)).
 			Where("EXCLUDED.lasttick > selftick.lasttick")
 
-		_, err = tx.Insert(ctx, query)
+		res, err := tx.Insert(ctx, query)
+		if err != nil {
+			return fmt.Errorf("failed to upsert selftick %v", err)
+		}
+
+		advanced, err := res.RowsAffected()
 		if err != nil {
 			return fmt.Errorf("failed to upsert selftick %v", err)
 		}
+		if advanced == 0 {
+			log.WithField(ctx, "tickTime", triggeredTime.Format(time.RFC3339)).Info("self tick is not past the last tick, not continuing the chain")
+			return nil
+		}
+
+		_, err = tx.Delete(ctx, sq.Delete("trigger_fire").
+			Where("trigger_time < ?", triggeredTime.Add(-fireLedgerRetention)))
+		if err != nil {
+			return fmt.Errorf("failed to prune trigger fires %v", err)
+		}
+
+		// send self tick
+		delay := calcDelay(*triggeredTime, time.Now().In(time.UTC), w.tickInterval)
+		err = w.sender.SendDelayed(ctx, tx, delay, msg)
+		if err != nil {
+			return fmt.Errorf("failed to send delayed tick %v", err)
+		}
 
 		return nil
 	})
@@ -252,7 +981,124 @@ func (w TriggerWorker) SendSelfTick(ctx context.Context, triggeredTime *time.Tim
 	return nil
 }
 
-func (w TriggerWorker) AllActive(ctx context.Context) ([]*trigger_pb.TriggerState, error) {
+// RecoverSelfTick restarts the self tick chain from the stored last tick when
+// the chain has not advanced for staleAfter, as the delayed message continuing
+// it was lost. The selftick row is locked while checking, and a recovery is sent at
+// most once per staleAfter, so replicas checking together do not both restart
+// the chain. It reports whether a tick was sent.
+func (w TriggerWorker) RecoverSelfTick(ctx context.Context, staleAfter time.Duration) (bool, error) {
+	recovered := false
+	noTick := false
+
+	err := w.db.Transact(ctx, utils.MutableTxOptions, func(ctx context.Context, tx sqrlx.Transaction) error {
+		recovered = false
+		noTick = false
+
+		var lastTick, tickedAt time.Time
+		var recoveredAt sql.NullTime
+		err := tx.QueryRow(ctx, sq.Select("lasttick", "ticked_at", "recovered_at").
+			From("selftick").
+			Where("selftick_id = ?", "selftick").
+			Suffix("FOR UPDATE"),
+		).Scan(&lastTick, &tickedAt, &recoveredAt)
+		if errors.Is(err, sql.ErrNoRows) {
+			noTick = true
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("failed to lock selftick %v", err)
+		}
+
+		now := time.Now().In(time.UTC)
+		if !isStaleTick(tickedAt, recoveredAt, now, staleAfter) {
+			return nil
+		}
+
+		log.WithFields(ctx,
+			"lastTick", lastTick.Format(time.RFC3339),
+			"tickedAt", tickedAt.Format(time.RFC3339),
+			"lag", tickLag(lastTick, now).String(),
+		).Warn("self tick chain is stale, restarting it")
+
+		err = w.sender.Send(ctx, tx, &trigger_tpb.SelfTickMessage{
+			LastTick: timestamppb.New(lastTick),
+		})
+		if err != nil {
+			return fmt.Errorf("failed to send recovery tick %v", err)
+		}
+
+		_, err = tx.Update(ctx, sq.Update("selftick").
+			Set("recovered_at", now).
+			Where("selftick_id = ?", "selftick"))
+		if err != nil {
+			return fmt.Errorf("failed to record selftick recovery %v", err)
+		}
+
+		recovered = true
+		return nil
+	})
+	if err != nil {
+		return false, err
+	}
+
+	if noTick {
+		// the row is gone, seed the chain as on startup
+		if err := w.InitSelfTick(ctx); err != nil {
+			return false, err
+		}
+		return true, nil
+	}
+
+	return recovered, nil
+}
+
+// isStaleTick reports whether the chain needs restarting, as it last advanced
+// more than staleAfter before now and it was not restarted within staleAfter.
+// How far the last tick is behind now does not matter, a chain catching up
+// after an outage is lagging but still alive.
+func isStaleTick(tickedAt time.Time, recoveredAt sql.NullTime, now time.Time, staleAfter time.Duration) bool {
+	if now.Sub(tickedAt) <= staleAfter {
+		return false
+	}
+
+	return !recoveredAt.Valid || now.Sub(recoveredAt.Time) > staleAfter
+}
+
+// RunWatchdog checks the self tick chain every interval until the context
+// ends, restarting it when it has not advanced for staleAfter. staleAfter must
+// be at least two tick intervals, so a tick which is only a little late does
+// not fork the chain.
+func (w TriggerWorker) RunWatchdog(ctx context.Context, interval, staleAfter time.Duration) error {
+	if interval <= 0 {
+		return fmt.Errorf("watchdog interval must be positive, got %s", interval)
+	}
+	if staleAfter <= 0 {
+		return fmt.Errorf("watchdog stale after must be positive, got %s", staleAfter)
+	}
+	if staleAfter < 2*w.tickInterval {
+		return fmt.Errorf("watchdog stale after must be at least twice the tick interval %s, got %s", w.tickInterval, staleAfter)
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+
+		if _, err := w.RecoverSelfTick(ctx, staleAfter); err != nil {
+			// the next check tries again
+			log.WithError(ctx, err).Error("self tick watchdog failed")
+		}
+	}
+}
+
+// DueTriggers returns the triggers the tick loop needs to check on this tick,
+// by the due_at column the state machine keeps, ordered by when they were due.
+func (w TriggerWorker) DueTriggers(ctx context.Context, thisTick time.Time) ([]*trigger_pb.TriggerState, error) {
 	var triggers []*trigger_pb.TriggerState
 
 	if err := w.db.Transact(ctx, utils.ReadOnlyTxOptions, func(ctx context.Context, tx sqrlx.Transaction) error {
@@ -260,13 +1106,14 @@ func (w TriggerWorker) AllActive(ctx context.Context) ([]*trigger_pb.TriggerStat
 			ctx,
 			sq.Select("state").
 				From("trigger").
-				Where("state->>'status' = 'ACTIVE'"),
+				Where("due_at <= ?", thisTick).
+				OrderBy("due_at", "trigger_id"),
 		)
 		if err != nil {
 			if errors.Is(err, sql.ErrNoRows) {
 				return nil
 			}
-			return fmt.Errorf("error while getting active triggers %v", err)
+			return fmt.Errorf("error while getting due triggers %v", err)
 		}
 
 		defer rows.Close()
@@ -274,7 +1121,7 @@ func (w TriggerWorker) AllActive(ctx context.Context) ([]*trigger_pb.TriggerStat
 		for rows.Next() {
 			var data []byte
 			if err := rows.Scan(&data); err != nil {
-				return fmt.Errorf("failed to scan active trigger row %v", err)
+				return fmt.Errorf("failed to scan due trigger row %v", err)
 			}
 			tm := &trigger_pb.TriggerState{}
 			if err := j5codec.Global.JSONToProto(data, tm.ProtoReflect()); err != nil {
@@ -284,7 +1131,7 @@ func (w TriggerWorker) AllActive(ctx context.Context) ([]*trigger_pb.TriggerStat
 			triggers = append(triggers, tm)
 		}
 
-		return nil
+		return rows.Err()
 	}); err != nil {
 		return nil, err
 	}
@@ -292,6 +1139,28 @@ func (w TriggerWorker) AllActive(ctx context.Context) ([]*trigger_pb.TriggerStat
 	return triggers, nil
 }
 
+// getTrigger returns the state of the trigger, or nil when it does not exist.
+func getTrigger(ctx context.Context, tx sqrlx.Transaction, triggerID string) (*trigger_pb.TriggerState, error) {
+	query := sq.Select("state").
+		From("trigger").
+		Where("trigger_id = ?", triggerID)
+
+	var data []byte
+	if err := tx.QueryRow(ctx, query).Scan(&data); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get trigger %s: %w", triggerID, err)
+	}
+
+	tm := &trigger_pb.TriggerState{}
+	if err := j5codec.Global.JSONToProto(data, tm.ProtoReflect()); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal trigger state %v", err)
+	}
+
+	return tm, nil
+}
+
 func (w TriggerWorker) GetLastTick(ctx context.Context) (*trigger_tpb.SelfTickMessage, error) {
 	query := sq.Select("data").
 		From("selftick").
@@ -316,21 +1185,179 @@ func (w TriggerWorker) GetLastTick(ctx context.Context) (*trigger_tpb.SelfTickMe
 	return msg, nil
 }
 
-func checkCron(c string, thisTick time.Time) (bool, error) {
-	sched, err := cron.ParseStandard(c)
+// checkTrigger returns the time the trigger is due to fire for on this tick,
+// or nil if it is not due.
+func checkTrigger(data *trigger_pb.TriggerData, thisTick time.Time) (*time.Time, error) {
+	if data.RunAt != nil {
+		// a one-shot trigger stays due from its run time until it has fired
+		runAt := data.RunAt.AsTime()
+		if runAt.After(thisTick) {
+			return nil, nil
+		}
+		return &runAt, nil
+	}
+
+	var due bool
+	var err error
+	if data.Interval != nil {
+		due, err = checkInterval(data.Interval, thisTick)
+	} else {
+		due, err = checkCron(data.Cron, data.Timezone, data.DstPolicy, thisTick)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if !due {
+		return nil, nil
+	}
+
+	return &thisTick, nil
+}
+
+// isExpired reports whether the notAfter time of the trigger has passed by
+// this tick.
+func isExpired(data *trigger_pb.TriggerData, thisTick time.Time) bool {
+	return data.NotAfter != nil && thisTick.After(data.NotAfter.AsTime())
+}
+
+// inWindow reports whether a fire time falls between the notBefore and
+// notAfter times of the trigger, inclusive.
+func inWindow(data *trigger_pb.TriggerData, fireTime time.Time) bool {
+	if data.NotBefore != nil && fireTime.Before(data.NotBefore.AsTime()) {
+		return false
+	}
+	if data.NotAfter != nil && fireTime.After(data.NotAfter.AsTime()) {
+		return false
+	}
+	return true
+}
+
+// triggerSchedule returns the repeating schedule of a cron or interval trigger.
+func triggerSchedule(data *trigger_pb.TriggerData) (cron.Schedule, error) {
+	if data.Interval != nil {
+		return utils.ParseInterval(data.Interval)
+	}
+	return utils.ParseCronIn(data.Cron, data.Timezone)
+}
+
+func checkInterval(interval *trigger_pb.Interval, thisTick time.Time) (bool, error) {
+	sched, err := utils.ParseInterval(interval)
 	if err != nil {
-		return false, fmt.Errorf("failed to parse cron string %v", err)
+		return false, fmt.Errorf("failed to parse interval %v", err)
 	}
 
 	lastTick := thisTick.Add(triggerCadence * -1)
-	// cannot compare current time with the cron, so go back one cadence,
-	// get the next which is current, then compare.
 	return sched.Next(lastTick).Equal(thisTick), nil
+}
+
+// checkCron reports whether the cron is due on this tick, evaluating it as
+// wall clock time in the timezone. Around daylight saving changes the DST
+// policy decides whether wall clock times which were skipped fire shifted
+// forward, and whether repeated wall clock times fire a second time.
+func checkCron(c, timezone string, dst trigger_pb.DSTPolicy, thisTick time.Time) (bool, error) {
+	sched, err := utils.ParseCronIn(c, timezone)
+	if err != nil {
+		return false, fmt.Errorf("failed to parse cron string %v", err)
+	}
+
+	spec, ok := sched.(*cron.SpecSchedule)
+	if !ok {
+		lastTick := thisTick.Add(triggerCadence * -1)
+		// cannot compare current time with the cron, so go back one cadence,
+		// get the next which is current, then compare.
+		return sched.Next(lastTick).Equal(thisTick), nil
+	}
+
+	// match the cron against wall clock times held as UTC, which has no DST
+	wallSpec := *spec
+	wallSpec.Location = time.UTC
+	matches := func(wall time.Time) bool {
+		return wallSpec.Next(wall.Add(-time.Second)).Equal(wall)
+	}
+
+	local := thisTick.In(spec.Location)
+	wall := wallClock(local)
+
+	// DST changes are months apart, so the offset a day ago is the offset from
+	// before any change this tick could be affected by.
+	_, offsetNow := local.Zone()
+	_, offsetBefore := thisTick.Add(-25 * time.Hour).In(spec.Location).Zone()
+	change := time.Duration(offsetBefore-offsetNow) * time.Second
 
+	switch {
+	case change > 0:
+		// clocks went back, the wall clock time may have already happened. A
+		// cron which fires every hour fires through the repeated hour as it
+		// does any other, only a time set to the hour is a repeat.
+		if !matches(wall) {
+			return false, nil
+		}
+		repeated := wallClock(thisTick.Add(-change).In(spec.Location)).Equal(wall)
+		return !repeated || firesEveryHour(spec) || dst == trigger_pb.DSTPolicy_FIRE_BOTH, nil
+
+	case change < 0:
+		// clocks went forward, on the old offset this tick would have been a
+		// wall clock time which was skipped
+		if dst != trigger_pb.DSTPolicy_SKIP {
+			skipped := wallClock(thisTick.In(time.FixedZone("", offsetBefore)))
+			if !wallClockExists(skipped, spec.Location) && matches(skipped) {
+				return true, nil
+			}
+		}
+		return matches(wall), nil
+
+	default:
+		return matches(wall), nil
+	}
+}
+
+// firesEveryHour reports whether the hour field of the cron matches every hour.
+func firesEveryHour(spec *cron.SpecSchedule) bool {
+	const allHours = 1<<24 - 1
+	return spec.Hour&allHours == allHours
+}
+
+// wallClock returns the wall clock time of t as a UTC time.
+func wallClock(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), 0, time.UTC)
+}
+
+// wallClockExists reports whether the wall clock time happens in loc, which it
+// does not when the clocks skip over it.
+func wallClockExists(wall time.Time, loc *time.Location) bool {
+	local := time.Date(wall.Year(), wall.Month(), wall.Day(), wall.Hour(), wall.Minute(), wall.Second(), 0, loc)
+	return wallClock(local).Equal(wall)
+}
+
+// isLate reports whether the tick is being processed more than lateTolerance
+// after its scheduled time, meaning the tick loop is catching up.
+func isLate(thisTick, now time.Time) bool {
+	return now.Sub(thisTick) > lateTolerance
+}
+
+// checkCatchUp decides whether a trigger which is due on a late tick should
+// still fire, according to its catch-up policy.
+func checkCatchUp(data *trigger_pb.TriggerData, thisTick, now time.Time) (bool, error) {
+	switch data.CatchUp {
+	case trigger_pb.CatchUpPolicy_SKIP:
+		return false, nil
+
+	case trigger_pb.CatchUpPolicy_FIRE_LATEST:
+		sched, err := triggerSchedule(data)
+		if err != nil {
+			return false, fmt.Errorf("failed to parse schedule %v", err)
+		}
+		// only fire if no later time has also been missed, the tick loop will
+		// reach that one instead.
+		return sched.Next(thisTick).After(now), nil
+
+	default:
+		return true, nil
+	}
 }
 
 func nextTick(lastTick time.Time) (*time.Time, error) {
-	sched, err := cron.ParseStandard(triggerCron)
+	sched, err := utils.ParseCron(triggerCron)
 	if err != nil {
 		return nil, fmt.Errorf("failed to parse cron string %v", err)
 	}
@@ -339,9 +1366,9 @@ func nextTick(lastTick time.Time) (*time.Time, error) {
 }
 
 // calcDelay calculates the delay for the next tick based on the this tick time and the time passed.
-// The max delay is 5 minutes. If the next tick is in the past, it returns 0.
-func calcDelay(thisTick, now time.Time) time.Duration {
-	nextTick := thisTick.Add(triggerCadence)
+// The delay is at most one interval, capped at 5 minutes. If the next tick is in the past, it returns 0.
+func calcDelay(thisTick, now time.Time, interval time.Duration) time.Duration {
+	nextTick := thisTick.Add(interval)
 
 	// next tick should have already happened, return 0
 	if nextTick.Before(now) {
@@ -350,11 +1377,3 @@ func calcDelay(thisTick, now time.Time) time.Duration {
 
 	return min(nextTick.Sub(now), 5*time.Minute)
 }
-
-func validateCron(c string) error {
-	_, err := cron.ParseStandard(c)
-	if err != nil {
-		return fmt.Errorf("invalid cron string: %w", err)
-	}
-	return nil
-}
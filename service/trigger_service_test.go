@@ -1,11 +1,30 @@
 package service
 
 import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"math"
 	"strings"
+	"sync"
 	"testing"
 	"time"
 
+	"github.com/pentops/golib/gl"
+	"github.com/pentops/j5/gen/j5/messaging/v1/messaging_j5pb"
+	"github.com/pentops/j5/j5types/any_j5t"
+	"github.com/pentops/j5/j5types/date_j5t"
+	"github.com/pentops/j5/lib/id62"
+	"github.com/pentops/sqrlx.go/sqrlx"
+	"github.com/pentops/trigger/gen/o5/trigger/v1/trigger_pb"
+	"github.com/pentops/trigger/gen/o5/trigger/v1/trigger_tpb"
 	"github.com/pentops/trigger/utils"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 func TestValidateCronString(t *testing.T) {
@@ -18,41 +37,115 @@ func TestValidateCronString(t *testing.T) {
 	if err == nil {
 		t.Error("validate cron string failed, expected an error")
 	}
+
+	err = utils.ValidateCronString("*/15 * * * * *")
+	if err != nil {
+		t.Error("validate cron string failed, expected seconds to be accepted")
+	}
+
+	err = utils.ValidateCronString("7 * * * * *")
+	if err == nil {
+		t.Error("validate cron string failed, expected an error for seconds off the tick cadence")
+	}
+}
+
+func TestJitterOffset(t *testing.T) {
+	if utils.JitterOffset("2cEg5bYSeSs0cIdcBDXCgn", 0) != 0 {
+		t.Error("jitter offset failed, expected no offset without a window")
+	}
+
+	first := utils.JitterOffset("2cEg5bYSeSs0cIdcBDXCgn", 300)
+	if first != utils.JitterOffset("2cEg5bYSeSs0cIdcBDXCgn", 300) {
+		t.Error("jitter offset failed, expected the same offset for the same trigger")
+	}
+
+	offsets := map[time.Duration]bool{}
+	for i := range 20 {
+		offset := utils.JitterOffset(fmt.Sprintf("trigger%d", i), 300)
+		if offset < 0 || offset >= 300*time.Second {
+			t.Errorf("jitter offset failed, %s is outside the window", offset)
+		}
+		offsets[offset] = true
+	}
+	if len(offsets) < 2 {
+		t.Error("jitter offset failed, expected triggers to be spread out")
+	}
+
+	if utils.ValidateJitter(-1) == nil {
+		t.Error("validate jitter failed, expected an error for a negative window")
+	}
+
+	if utils.ValidateJitter(int32((utils.MaxJitter+time.Second)/time.Second)) == nil {
+		t.Error("validate jitter failed, expected an error for a window over the max")
+	}
 }
 
 func TestCalcDelay(t *testing.T) {
 	now := mustParseTime(t, "2025-01-01 06:46:00")
 
 	// tick was in the past so should not have a delay
-	delay := calcDelay(mustParseTime(t, "2025-01-01 06:35:00"), now)
+	delay := calcDelay(mustParseTime(t, "2025-01-01 06:35:00"), now, triggerCadence)
 	if delay != 0 {
 		t.Error("calcDelay failed, expected 0")
 	}
 
 	// tick was in the past so should not have a delay
-	delay = calcDelay(mustParseTime(t, "2025-01-01 06:40:00"), now)
+	delay = calcDelay(mustParseTime(t, "2025-01-01 06:40:00"), now, triggerCadence)
 	if delay != 0 {
 		t.Error("calcDelay failed, expected 0")
 	}
 
-	// tick is just a minute in the in the past so should have a 1 minute delay
-	delay = calcDelay(mustParseTime(t, "2025-01-01 06:45:00"), now)
-	if time.Duration(delay.Seconds()) == 60 {
-		t.Error("calcDelay failed, expected 1 minute got ", delay.Minutes())
+	// tick is now so should wait a full cadence
+	delay = calcDelay(mustParseTime(t, "2025-01-01 06:46:00"), now, triggerCadence)
+	if delay != 5*time.Second {
+		t.Error("calcDelay failed, expected 5 seconds got ", delay.Seconds())
+	}
+
+	// tick is part way through the cadence so should wait the remainder
+	delay = calcDelay(mustParseTime(t, "2025-01-01 06:45:57"), now, triggerCadence)
+	if delay != 2*time.Second {
+		t.Error("calcDelay failed, expected 2 seconds got ", delay.Seconds())
+	}
+
+	// a longer tick interval waits until the end of the next interval
+	delay = calcDelay(mustParseTime(t, "2025-01-01 06:46:00"), now, time.Minute)
+	if delay != time.Minute {
+		t.Error("calcDelay failed, expected 60 seconds got ", delay.Seconds())
 	}
 }
 
+func TestTickSlots(t *testing.T) {
+	slots, err := tickSlots(mustParseTime(t, "2025-01-01 13:00:00Z"), triggerCadence)
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+	assertFired(t, "every slot", slots, "2025-01-01 13:00:05Z")
+
+	slots, err = tickSlots(mustParseTime(t, "2025-01-01 13:00:00Z"), 20*time.Second)
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+	assertFired(t, "interval", slots, "2025-01-01 13:00:05Z", "2025-01-01 13:00:10Z", "2025-01-01 13:00:15Z", "2025-01-01 13:00:20Z")
+
+	// a last tick off the interval, e.g. after it changed, catches up to it
+	slots, err = tickSlots(mustParseTime(t, "2025-01-01 13:00:50Z"), time.Minute)
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+	assertFired(t, "off the interval", slots, "2025-01-01 13:00:55Z", "2025-01-01 13:01:00Z")
+}
+
 func TestNextTick(t *testing.T) {
 	lastTick := mustParseTime(t, "2025-01-01 13:00:00Z")
 	tick, err := nextTick(lastTick)
 	if err != nil {
 		t.Error("nextTick failed, expected no error")
 	}
-	if tick.String() != "2025-01-01 13:01:00 +0000 UTC" {
-		t.Fatal("expected 2025-01-01 13:01:00, got", tick.String())
+	if tick.String() != "2025-01-01 13:00:05 +0000 UTC" {
+		t.Fatal("expected 2025-01-01 13:00:05, got", tick.String())
 	}
 
-	lastTick = mustParseTime(t, "2025-01-31 23:59:51")
+	lastTick = mustParseTime(t, "2025-01-31 23:59:56")
 	tick, err = nextTick(lastTick)
 	if err != nil {
 		t.Error("nextTick failed, expected no error")
@@ -64,14 +157,14 @@ func TestNextTick(t *testing.T) {
 
 func TestCheckCron(t *testing.T) {
 	// localized time during standard time
-	shouldTigger, err := checkCron("CRON_TZ=America/New_York 0 7 * * *", mustParseTime(t, "2025-01-04 12:00:00Z"))
+	shouldTigger, err := checkCron("CRON_TZ=America/New_York 0 7 * * *", "", trigger_pb.DSTPolicy_UNSPECIFIED, mustParseTime(t, "2025-01-04 12:00:00Z"))
 	if err != nil {
 		t.Error("expected no error")
 	}
 	if !shouldTigger {
 		t.Error("checkCron should return true")
 	}
-	shouldTigger, err = checkCron("CRON_TZ=America/New_York 0 8 * * *", mustParseTime(t, "2025-01-04 12:00:00Z"))
+	shouldTigger, err = checkCron("CRON_TZ=America/New_York 0 8 * * *", "", trigger_pb.DSTPolicy_UNSPECIFIED, mustParseTime(t, "2025-01-04 12:00:00Z"))
 	if err != nil {
 		t.Error("expected no error")
 	}
@@ -80,14 +173,14 @@ func TestCheckCron(t *testing.T) {
 	}
 
 	// localized time during daylight savings time
-	shouldTigger, err = checkCron("CRON_TZ=America/New_York 0 7 * * *", mustParseTime(t, "2025-08-04 11:00:00Z"))
+	shouldTigger, err = checkCron("CRON_TZ=America/New_York 0 7 * * *", "", trigger_pb.DSTPolicy_UNSPECIFIED, mustParseTime(t, "2025-08-04 11:00:00Z"))
 	if err != nil {
 		t.Error("expected no error")
 	}
 	if !shouldTigger {
 		t.Error("checkCron should return true")
 	}
-	shouldTigger, err = checkCron("CRON_TZ=America/New_York 0 8 * * *", mustParseTime(t, "2025-08-04 11:00:00Z"))
+	shouldTigger, err = checkCron("CRON_TZ=America/New_York 0 8 * * *", "", trigger_pb.DSTPolicy_UNSPECIFIED, mustParseTime(t, "2025-08-04 11:00:00Z"))
 	if err != nil {
 		t.Error("expected no error")
 	}
@@ -95,7 +188,7 @@ func TestCheckCron(t *testing.T) {
 		t.Error("checkCron should return false")
 	}
 
-	shouldTigger, err = checkCron("59 12 * * *", mustParseTime(t, "2025-01-01 13:00:00Z"))
+	shouldTigger, err = checkCron("59 12 * * *", "", trigger_pb.DSTPolicy_UNSPECIFIED, mustParseTime(t, "2025-01-01 13:00:00Z"))
 	if err != nil {
 		t.Error("expected no error")
 	}
@@ -103,7 +196,7 @@ func TestCheckCron(t *testing.T) {
 		t.Error("checkCron should return false")
 	}
 
-	shouldTigger, err = checkCron("0 13 * * *", mustParseTime(t, "2025-01-01 13:00:00Z"))
+	shouldTigger, err = checkCron("0 13 * * *", "", trigger_pb.DSTPolicy_UNSPECIFIED, mustParseTime(t, "2025-01-01 13:00:00Z"))
 	if err != nil {
 		t.Error("expected no error")
 	}
@@ -111,7 +204,7 @@ func TestCheckCron(t *testing.T) {
 		t.Error("checkCron should return true")
 	}
 
-	shouldTigger, err = checkCron("1 13 * * *", mustParseTime(t, "2025-01-01 13:00:00Z"))
+	shouldTigger, err = checkCron("1 13 * * *", "", trigger_pb.DSTPolicy_UNSPECIFIED, mustParseTime(t, "2025-01-01 13:00:00Z"))
 	if err != nil {
 		t.Error("expected no error")
 	}
@@ -119,7 +212,7 @@ func TestCheckCron(t *testing.T) {
 		t.Error("checkCron should return false")
 	}
 
-	shouldTigger, err = checkCron("5 13 * * *", mustParseTime(t, "2025-01-01 13:00:00Z"))
+	shouldTigger, err = checkCron("5 13 * * *", "", trigger_pb.DSTPolicy_UNSPECIFIED, mustParseTime(t, "2025-01-01 13:00:00Z"))
 	if err != nil {
 		t.Error("expected no error")
 	}
@@ -127,7 +220,7 @@ func TestCheckCron(t *testing.T) {
 		t.Error("checkCron should return false")
 	}
 
-	shouldTigger, err = checkCron("@hourly", mustParseTime(t, "2025-01-01 13:00:00Z"))
+	shouldTigger, err = checkCron("@hourly", "", trigger_pb.DSTPolicy_UNSPECIFIED, mustParseTime(t, "2025-01-01 13:00:00Z"))
 	if err != nil {
 		t.Error("expected no error")
 	}
@@ -135,7 +228,7 @@ func TestCheckCron(t *testing.T) {
 		t.Error("checkCron should return true")
 	}
 
-	shouldTigger, err = checkCron("@hourly", mustParseTime(t, "2025-01-01 13:01:00Z"))
+	shouldTigger, err = checkCron("@hourly", "", trigger_pb.DSTPolicy_UNSPECIFIED, mustParseTime(t, "2025-01-01 13:01:00Z"))
 	if err != nil {
 		t.Error("expected no error")
 	}
@@ -143,14 +236,14 @@ func TestCheckCron(t *testing.T) {
 		t.Error("checkCron should return false")
 	}
 
-	shouldTigger, err = checkCron("@yearly", mustParseTime(t, "2025-01-01 00:00:00Z"))
+	shouldTigger, err = checkCron("@yearly", "", trigger_pb.DSTPolicy_UNSPECIFIED, mustParseTime(t, "2025-01-01 00:00:00Z"))
 	if err != nil {
 		t.Error("expected no error")
 	}
 	if !shouldTigger {
 		t.Error("checkCron should return true")
 	}
-	shouldTigger, err = checkCron("@yearly", mustParseTime(t, "2025-01-01 13:01:00Z"))
+	shouldTigger, err = checkCron("@yearly", "", trigger_pb.DSTPolicy_UNSPECIFIED, mustParseTime(t, "2025-01-01 13:01:00Z"))
 	if err != nil {
 		t.Error("expected no error")
 	}
@@ -158,21 +251,21 @@ func TestCheckCron(t *testing.T) {
 		t.Error("checkCron should return false")
 	}
 
-	shouldTigger, err = checkCron("@monthly", mustParseTime(t, "2025-01-01 00:00:00Z"))
+	shouldTigger, err = checkCron("@monthly", "", trigger_pb.DSTPolicy_UNSPECIFIED, mustParseTime(t, "2025-01-01 00:00:00Z"))
 	if err != nil {
 		t.Error("expected no error")
 	}
 	if !shouldTigger {
 		t.Error("checkCron should return true")
 	}
-	shouldTigger, err = checkCron("@monthly", mustParseTime(t, "2024-12-31 23:23:23Z"))
+	shouldTigger, err = checkCron("@monthly", "", trigger_pb.DSTPolicy_UNSPECIFIED, mustParseTime(t, "2024-12-31 23:23:23Z"))
 	if err != nil {
 		t.Error("expected no error")
 	}
 	if shouldTigger {
 		t.Error("checkCron should return false")
 	}
-	shouldTigger, err = checkCron("@monthly", mustParseTime(t, "2025-01-02 00:01:00Z"))
+	shouldTigger, err = checkCron("@monthly", "", trigger_pb.DSTPolicy_UNSPECIFIED, mustParseTime(t, "2025-01-02 00:01:00Z"))
 	if err != nil {
 		t.Error("expected no error")
 	}
@@ -180,21 +273,21 @@ func TestCheckCron(t *testing.T) {
 		t.Error("checkCron should return false")
 	}
 
-	shouldTigger, err = checkCron("@weekly", mustParseTime(t, "2025-01-05 00:00:00Z"))
+	shouldTigger, err = checkCron("@weekly", "", trigger_pb.DSTPolicy_UNSPECIFIED, mustParseTime(t, "2025-01-05 00:00:00Z"))
 	if err != nil {
 		t.Error("expected no error")
 	}
 	if !shouldTigger {
 		t.Error("checkCron should return true")
 	}
-	shouldTigger, err = checkCron("@weekly", mustParseTime(t, "2025-02-04 23:23:23Z"))
+	shouldTigger, err = checkCron("@weekly", "", trigger_pb.DSTPolicy_UNSPECIFIED, mustParseTime(t, "2025-02-04 23:23:23Z"))
 	if err != nil {
 		t.Error("expected no error")
 	}
 	if shouldTigger {
 		t.Error("checkCron should return false")
 	}
-	shouldTigger, err = checkCron("@weekly", mustParseTime(t, "2025-02-05 00:01:00Z"))
+	shouldTigger, err = checkCron("@weekly", "", trigger_pb.DSTPolicy_UNSPECIFIED, mustParseTime(t, "2025-02-05 00:01:00Z"))
 	if err != nil {
 		t.Error("expected no error")
 	}
@@ -202,14 +295,14 @@ func TestCheckCron(t *testing.T) {
 		t.Error("checkCron should return false")
 	}
 
-	shouldTigger, err = checkCron("@daily", mustParseTime(t, "2025-02-06 00:00:00Z"))
+	shouldTigger, err = checkCron("@daily", "", trigger_pb.DSTPolicy_UNSPECIFIED, mustParseTime(t, "2025-02-06 00:00:00Z"))
 	if err != nil {
 		t.Error("expected no error")
 	}
 	if !shouldTigger {
 		t.Error("checkCron should return true")
 	}
-	shouldTigger, err = checkCron("@daily", mustParseTime(t, "2025-02-06 23:23:23Z"))
+	shouldTigger, err = checkCron("@daily", "", trigger_pb.DSTPolicy_UNSPECIFIED, mustParseTime(t, "2025-02-06 23:23:23Z"))
 	if err != nil {
 		t.Error("expected no error")
 	}
@@ -217,14 +310,14 @@ func TestCheckCron(t *testing.T) {
 		t.Error("checkCron should return false")
 	}
 
-	shouldTigger, err = checkCron("@hourly", mustParseTime(t, "2024-12-31 13:00:00Z"))
+	shouldTigger, err = checkCron("@hourly", "", trigger_pb.DSTPolicy_UNSPECIFIED, mustParseTime(t, "2024-12-31 13:00:00Z"))
 	if err != nil {
 		t.Error("expected no error")
 	}
 	if !shouldTigger {
 		t.Error("checkCron should return true")
 	}
-	shouldTigger, err = checkCron("@hourly", mustParseTime(t, "2024-12-31 23:59:00Z"))
+	shouldTigger, err = checkCron("@hourly", "", trigger_pb.DSTPolicy_UNSPECIFIED, mustParseTime(t, "2024-12-31 23:59:00Z"))
 	if err != nil {
 		t.Error("expected no error")
 	}
@@ -232,21 +325,21 @@ func TestCheckCron(t *testing.T) {
 		t.Error("checkCron should return false")
 	}
 
-	shouldTigger, err = checkCron("@yearly", mustParseTime(t, "2025-01-01 00:00:00Z"))
+	shouldTigger, err = checkCron("@yearly", "", trigger_pb.DSTPolicy_UNSPECIFIED, mustParseTime(t, "2025-01-01 00:00:00Z"))
 	if err != nil {
 		t.Error("expected no error")
 	}
 	if !shouldTigger {
 		t.Error("checkCron should return true")
 	}
-	shouldTigger, err = checkCron("@yearly", mustParseTime(t, "2024-12-31 23:59:00Z"))
+	shouldTigger, err = checkCron("@yearly", "", trigger_pb.DSTPolicy_UNSPECIFIED, mustParseTime(t, "2024-12-31 23:59:00Z"))
 	if err != nil {
 		t.Error("expected no error")
 	}
 	if shouldTigger {
 		t.Error("checkCron should return false")
 	}
-	shouldTigger, err = checkCron("@yearly", mustParseTime(t, "2025-01-01 00:01:00Z"))
+	shouldTigger, err = checkCron("@yearly", "", trigger_pb.DSTPolicy_UNSPECIFIED, mustParseTime(t, "2025-01-01 00:01:00Z"))
 	if err != nil {
 		t.Error("expected no error")
 	}
@@ -255,6 +348,911 @@ func TestCheckCron(t *testing.T) {
 	}
 }
 
+func TestCheckCronSeconds(t *testing.T) {
+	shouldTigger, err := checkCron("*/15 * * * * *", "", trigger_pb.DSTPolicy_UNSPECIFIED, mustParseTime(t, "2025-01-01 13:00:15Z"))
+	if err != nil {
+		t.Error("expected no error")
+	}
+	if !shouldTigger {
+		t.Error("checkCron should return true")
+	}
+
+	shouldTigger, err = checkCron("*/15 * * * * *", "", trigger_pb.DSTPolicy_UNSPECIFIED, mustParseTime(t, "2025-01-01 13:00:20Z"))
+	if err != nil {
+		t.Error("expected no error")
+	}
+	if shouldTigger {
+		t.Error("checkCron should return false")
+	}
+
+	shouldTigger, err = checkCron("*/10 * * * * *", "", trigger_pb.DSTPolicy_UNSPECIFIED, mustParseTime(t, "2025-01-01 13:00:50Z"))
+	if err != nil {
+		t.Error("expected no error")
+	}
+	if !shouldTigger {
+		t.Error("checkCron should return true")
+	}
+
+	// five field expressions only fire on the minute
+	shouldTigger, err = checkCron("* * * * *", "", trigger_pb.DSTPolicy_UNSPECIFIED, mustParseTime(t, "2025-01-01 13:00:05Z"))
+	if err != nil {
+		t.Error("expected no error")
+	}
+	if shouldTigger {
+		t.Error("checkCron should return false")
+	}
+
+	shouldTigger, err = checkCron("CRON_TZ=America/New_York 30 0 7 * * *", "", trigger_pb.DSTPolicy_UNSPECIFIED, mustParseTime(t, "2025-01-04 12:00:30Z"))
+	if err != nil {
+		t.Error("expected no error")
+	}
+	if !shouldTigger {
+		t.Error("checkCron should return true")
+	}
+}
+
+func TestCheckCronEvery(t *testing.T) {
+	start := mustParseTime(t, "2025-02-14 12:00:00Z")
+	end := mustParseTime(t, "2025-02-14 12:03:00Z")
+
+	fired := walkTicks(t, &trigger_pb.TriggerData{Cron: "@every 1m"}, start, end)
+	assertFired(t, "every minute", fired, "2025-02-14 12:01:00Z", "2025-02-14 12:02:00Z", "2025-02-14 12:03:00Z")
+
+	fired = walkTicks(t, &trigger_pb.TriggerData{Cron: "@every 90s"}, start, end)
+	assertFired(t, "every 90 seconds", fired, "2025-02-14 12:01:30Z", "2025-02-14 12:03:00Z")
+
+	// the schedule is anchored, not counted from when it is checked
+	next, err := nextFireTime(&trigger_pb.TriggerData{Cron: "@every 1h"}, nil, mustParseTime(t, "2025-02-14 12:34:56Z"))
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+	if next == nil || !next.Equal(mustParseTime(t, "2025-02-14 13:00:00Z")) {
+		t.Errorf("expected the next hour, got %v", next)
+	}
+
+	for _, c := range []string{"@every 7s", "@every 1s", "@every 1m2s"} {
+		if err := utils.ValidateSchedule(c, "", nil, nil); err == nil {
+			t.Errorf("%s: expected an error, the tick never lands on it", c)
+		}
+	}
+}
+
+func TestCheckCronTimezone(t *testing.T) {
+	// 07:00 in New York is 12:00 UTC in winter
+	shouldTigger, err := checkCron("0 7 * * *", "America/New_York", trigger_pb.DSTPolicy_UNSPECIFIED, mustParseTime(t, "2025-01-04 12:00:00Z"))
+	if err != nil {
+		t.Error("expected no error")
+	}
+	if !shouldTigger {
+		t.Error("checkCron should return true")
+	}
+
+	// and 11:00 UTC in summer
+	shouldTigger, err = checkCron("0 7 * * *", "America/New_York", trigger_pb.DSTPolicy_UNSPECIFIED, mustParseTime(t, "2025-08-04 11:00:00Z"))
+	if err != nil {
+		t.Error("expected no error")
+	}
+	if !shouldTigger {
+		t.Error("checkCron should return true")
+	}
+
+	// no timezone is UTC
+	shouldTigger, err = checkCron("0 7 * * *", "", trigger_pb.DSTPolicy_UNSPECIFIED, mustParseTime(t, "2025-01-04 12:00:00Z"))
+	if err != nil {
+		t.Error("expected no error")
+	}
+	if shouldTigger {
+		t.Error("checkCron should return false")
+	}
+
+	shouldTigger, err = checkCron("0 7 * * *", "", trigger_pb.DSTPolicy_UNSPECIFIED, mustParseTime(t, "2025-01-04 07:00:00Z"))
+	if err != nil {
+		t.Error("expected no error")
+	}
+	if !shouldTigger {
+		t.Error("checkCron should return true")
+	}
+}
+
+// walkTicks checks the trigger on every tick from after start up to and
+// including end, as the SelfTick loop would, returning the times it fired.
+func walkTicks(t *testing.T, data *trigger_pb.TriggerData, start, end time.Time) []time.Time {
+	var fired []time.Time
+	for tick := start.Add(triggerCadence); !tick.After(end); tick = tick.Add(triggerCadence) {
+		fireTime, err := checkTrigger(data, tick)
+		if err != nil {
+			t.Fatalf("unexpected error at %s: %v", tick, err)
+		}
+		if fireTime != nil {
+			fired = append(fired, *fireTime)
+		}
+	}
+	return fired
+}
+
+func assertFired(t *testing.T, name string, fired []time.Time, expected ...string) {
+	t.Helper()
+	if len(fired) != len(expected) {
+		t.Errorf("%s: expected %d fires, got %v", name, len(expected), fired)
+		return
+	}
+	for i, e := range expected {
+		if !fired[i].Equal(mustParseTime(t, e)) {
+			t.Errorf("%s: expected fire %d at %s, got %s", name, i, e, fired[i])
+		}
+	}
+}
+
+func TestCheckCronDSTSpringForward(t *testing.T) {
+	// 2025-03-09 New York clocks go from 02:00 EST to 03:00 EDT, 07:00 UTC
+	start := mustParseTime(t, "2025-03-09 05:00:00Z")
+	end := mustParseTime(t, "2025-03-09 10:00:00Z")
+
+	trigger := func(c string, dst trigger_pb.DSTPolicy) *trigger_pb.TriggerData {
+		return &trigger_pb.TriggerData{Cron: c, Timezone: "America/New_York", DstPolicy: dst}
+	}
+
+	// 02:30 does not exist
+	fired := walkTicks(t, trigger("30 2 * * *", trigger_pb.DSTPolicy_SKIP), start, end)
+	assertFired(t, "skip", fired)
+
+	fired = walkTicks(t, trigger("30 2 * * *", trigger_pb.DSTPolicy_SHIFT), start, end)
+	assertFired(t, "shift", fired, "2025-03-09 07:30:00Z")
+
+	fired = walkTicks(t, trigger("30 2 * * *", trigger_pb.DSTPolicy_FIRE_BOTH), start, end)
+	assertFired(t, "fire both", fired, "2025-03-09 07:30:00Z")
+
+	fired = walkTicks(t, trigger("30 2 * * *", trigger_pb.DSTPolicy_UNSPECIFIED), start, end)
+	assertFired(t, "unspecified", fired, "2025-03-09 07:30:00Z")
+
+	// a shifted time landing on a real one only fires once
+	fired = walkTicks(t, trigger("*/30 * * * *", trigger_pb.DSTPolicy_SHIFT), start, mustParseTime(t, "2025-03-09 08:00:00Z"))
+	assertFired(t, "half hourly", fired,
+		"2025-03-09 05:30:00Z",
+		"2025-03-09 06:00:00Z",
+		"2025-03-09 06:30:00Z",
+		"2025-03-09 07:00:00Z",
+		"2025-03-09 07:30:00Z",
+		"2025-03-09 08:00:00Z",
+	)
+
+	// times outside the change are not affected
+	fired = walkTicks(t, trigger("30 4 * * *", trigger_pb.DSTPolicy_SKIP), start, end)
+	assertFired(t, "after change", fired, "2025-03-09 08:30:00Z")
+}
+
+func TestCheckCronDSTFallBack(t *testing.T) {
+	// 2025-11-02 New York clocks go from 02:00 EDT back to 01:00 EST, 06:00 UTC
+	start := mustParseTime(t, "2025-11-02 03:00:00Z")
+	end := mustParseTime(t, "2025-11-02 09:00:00Z")
+
+	trigger := func(c string, dst trigger_pb.DSTPolicy) *trigger_pb.TriggerData {
+		return &trigger_pb.TriggerData{Cron: c, Timezone: "America/New_York", DstPolicy: dst}
+	}
+
+	// 01:30 happens twice
+	fired := walkTicks(t, trigger("30 1 * * *", trigger_pb.DSTPolicy_SKIP), start, end)
+	assertFired(t, "skip", fired, "2025-11-02 05:30:00Z")
+
+	fired = walkTicks(t, trigger("30 1 * * *", trigger_pb.DSTPolicy_SHIFT), start, end)
+	assertFired(t, "shift", fired, "2025-11-02 05:30:00Z")
+
+	fired = walkTicks(t, trigger("30 1 * * *", trigger_pb.DSTPolicy_FIRE_BOTH), start, end)
+	assertFired(t, "fire both", fired, "2025-11-02 05:30:00Z", "2025-11-02 06:30:00Z")
+
+	fired = walkTicks(t, trigger("30 1 * * *", trigger_pb.DSTPolicy_UNSPECIFIED), start, end)
+	assertFired(t, "unspecified", fired, "2025-11-02 05:30:00Z")
+
+	// times outside the change are not affected
+	fired = walkTicks(t, trigger("30 2 * * *", trigger_pb.DSTPolicy_SKIP), start, end)
+	assertFired(t, "after change", fired, "2025-11-02 07:30:00Z")
+
+	// a cron firing every hour fires through the repeated hour
+	for _, dst := range []trigger_pb.DSTPolicy{trigger_pb.DSTPolicy_UNSPECIFIED, trigger_pb.DSTPolicy_SKIP, trigger_pb.DSTPolicy_SHIFT, trigger_pb.DSTPolicy_FIRE_BOTH} {
+		fired = walkTicks(t, trigger("*/5 * * * *", dst), mustParseTime(t, "2025-11-02 05:59:55Z"), mustParseTime(t, "2025-11-02 06:59:55Z"))
+		if len(fired) != 12 {
+			t.Errorf("every five minutes %s: expected 12 fires in the repeated hour, got %v", dst.ShortString(), fired)
+		}
+	}
+}
+
+func TestNextFireTimesMatchTicks(t *testing.T) {
+	days := []struct {
+		name       string
+		start, end string
+	}{
+		{"spring forward", "2025-03-09 03:00:00Z", "2025-03-09 12:00:00Z"},
+		{"fall back", "2025-11-02 03:00:00Z", "2025-11-02 12:00:00Z"},
+		{"lord howe spring forward", "2025-10-04 13:00:00Z", "2025-10-04 18:00:00Z"},
+	}
+
+	triggers := []*trigger_pb.TriggerData{
+		{Cron: "30 1 * * *", Timezone: "America/New_York", DstPolicy: trigger_pb.DSTPolicy_SKIP},
+		{Cron: "30 1 * * *", Timezone: "America/New_York", DstPolicy: trigger_pb.DSTPolicy_FIRE_BOTH},
+		{Cron: "30 2 * * *", Timezone: "America/New_York", DstPolicy: trigger_pb.DSTPolicy_SKIP},
+		{Cron: "30 2 * * *", Timezone: "America/New_York", DstPolicy: trigger_pb.DSTPolicy_SHIFT},
+		{Cron: "*/20 * * * *", Timezone: "America/New_York", DstPolicy: trigger_pb.DSTPolicy_FIRE_BOTH},
+		{Cron: "*/20 * * * *", Timezone: "America/New_York", DstPolicy: trigger_pb.DSTPolicy_SHIFT},
+		{Cron: "CRON_TZ=America/New_York 30 1 * * *"},
+		{Cron: "15 2 * * *", Timezone: "Australia/Lord_Howe", DstPolicy: trigger_pb.DSTPolicy_SHIFT},
+		{Cron: "*/10 * * * *", Timezone: "Australia/Lord_Howe", DstPolicy: trigger_pb.DSTPolicy_FIRE_BOTH},
+		{Cron: "*/30 * * * * *"},
+	}
+
+	for _, day := range days {
+		start := mustParseTime(t, day.start)
+		end := mustParseTime(t, day.end)
+
+		for _, data := range triggers {
+			name := fmt.Sprintf("%s %s %s %s", day.name, data.Cron, data.Timezone, data.DstPolicy.ShortString())
+
+			expected := walkTicks(t, data, start, end)
+
+			// one more than expected, which must come after the end
+			fireTimes, err := nextFireTimes(data, nil, start, len(expected)+1)
+			if err != nil {
+				t.Fatalf("%s: unexpected error %v", name, err)
+			}
+			var got []time.Time
+			for _, fireTime := range fireTimes {
+				if !fireTime.After(end) {
+					got = append(got, fireTime)
+				}
+			}
+
+			if len(got) != len(expected) {
+				t.Errorf("%s: expected %v, got %v", name, expected, got)
+				continue
+			}
+			for i := range expected {
+				if !got[i].Equal(expected[i]) {
+					t.Errorf("%s: expected fire %d at %s, got %s", name, i, expected[i], got[i])
+				}
+			}
+		}
+	}
+}
+
+func TestValidateTimezone(t *testing.T) {
+	err := utils.ValidateSchedule("0 7 * * *", "Europe/London", nil, nil)
+	if err != nil {
+		t.Errorf("expected no error, got %v", err)
+	}
+
+	err = utils.ValidateSchedule("0 7 * * *", "Mars/Olympus_Mons", nil, nil)
+	if err == nil {
+		t.Error("expected error for an unknown timezone")
+	}
+
+	err = utils.ValidateSchedule("0 7 * * *", "Local", nil, nil)
+	if err == nil {
+		t.Error("expected error for the server local timezone")
+	}
+
+	err = utils.ValidateSchedule("CRON_TZ=America/New_York 0 7 * * *", "Europe/London", nil, nil)
+	if err == nil {
+		t.Error("expected error for a timezone and a CRON_TZ prefix")
+	}
+}
+
+func TestCheckTrigger(t *testing.T) {
+	thisTick := mustParseTime(t, "2025-01-01 13:00:00Z")
+
+	fireTime, err := checkTrigger(&trigger_pb.TriggerData{Cron: "0 13 * * *"}, thisTick)
+	if err != nil {
+		t.Error("expected no error")
+	}
+	if fireTime == nil || !fireTime.Equal(thisTick) {
+		t.Error("checkTrigger should fire cron triggers at the tick time")
+	}
+
+	fireTime, err = checkTrigger(&trigger_pb.TriggerData{Cron: "0 14 * * *"}, thisTick)
+	if err != nil {
+		t.Error("expected no error")
+	}
+	if fireTime != nil {
+		t.Error("checkTrigger should not fire")
+	}
+
+	runAt := mustParseTime(t, "2025-01-01 12:59:58Z")
+	fireTime, err = checkTrigger(&trigger_pb.TriggerData{RunAt: timestamppb.New(runAt)}, thisTick)
+	if err != nil {
+		t.Error("expected no error")
+	}
+	if fireTime == nil || !fireTime.Equal(runAt) {
+		t.Error("checkTrigger should fire one-shot triggers at the run time")
+	}
+
+	// run times which have long passed are still due
+	runAt = mustParseTime(t, "2024-06-01 00:00:00Z")
+	fireTime, err = checkTrigger(&trigger_pb.TriggerData{RunAt: timestamppb.New(runAt)}, thisTick)
+	if err != nil {
+		t.Error("expected no error")
+	}
+	if fireTime == nil || !fireTime.Equal(runAt) {
+		t.Error("checkTrigger should fire one-shot triggers which were missed")
+	}
+
+	fireTime, err = checkTrigger(&trigger_pb.TriggerData{RunAt: timestamppb.New(thisTick.Add(time.Second))}, thisTick)
+	if err != nil {
+		t.Error("expected no error")
+	}
+	if fireTime != nil {
+		t.Error("checkTrigger should not fire one-shot triggers early")
+	}
+}
+
+func TestCheckInterval(t *testing.T) {
+	// every 90 minutes starting at 08:15
+	interval := &trigger_pb.Interval{
+		EverySeconds: 90 * 60,
+		Anchor:       timestamppb.New(mustParseTime(t, "2025-01-01 08:15:00Z")),
+	}
+
+	shouldTigger, err := checkInterval(interval, mustParseTime(t, "2025-01-01 08:15:00Z"))
+	if err != nil {
+		t.Error("expected no error")
+	}
+	if !shouldTigger {
+		t.Error("checkInterval should fire at the anchor")
+	}
+
+	shouldTigger, err = checkInterval(interval, mustParseTime(t, "2025-01-01 09:45:00Z"))
+	if err != nil {
+		t.Error("expected no error")
+	}
+	if !shouldTigger {
+		t.Error("checkInterval should fire one interval after the anchor")
+	}
+
+	shouldTigger, err = checkInterval(interval, mustParseTime(t, "2025-01-02 08:15:00Z"))
+	if err != nil {
+		t.Error("expected no error")
+	}
+	if !shouldTigger {
+		t.Error("checkInterval should fire 16 intervals after the anchor")
+	}
+
+	shouldTigger, err = checkInterval(interval, mustParseTime(t, "2025-01-01 09:15:00Z"))
+	if err != nil {
+		t.Error("expected no error")
+	}
+	if shouldTigger {
+		t.Error("checkInterval should not fire between intervals")
+	}
+
+	shouldTigger, err = checkInterval(interval, mustParseTime(t, "2024-12-31 23:15:00Z"))
+	if err != nil {
+		t.Error("expected no error")
+	}
+	if shouldTigger {
+		t.Error("checkInterval should not fire before the anchor")
+	}
+
+	// every 36 hours
+	interval = &trigger_pb.Interval{
+		EverySeconds: 36 * 60 * 60,
+		Anchor:       timestamppb.New(mustParseTime(t, "2025-01-01 00:00:00Z")),
+	}
+
+	shouldTigger, err = checkInterval(interval, mustParseTime(t, "2025-01-02 12:00:00Z"))
+	if err != nil {
+		t.Error("expected no error")
+	}
+	if !shouldTigger {
+		t.Error("checkInterval should fire after 36 hours")
+	}
+
+	_, err = checkInterval(&trigger_pb.Interval{
+		EverySeconds: 7,
+		Anchor:       timestamppb.New(mustParseTime(t, "2025-01-01 00:00:00Z")),
+	}, mustParseTime(t, "2025-01-01 00:00:00Z"))
+	if err == nil {
+		t.Error("checkInterval should reject intervals off the tick cadence")
+	}
+
+	_, err = checkInterval(&trigger_pb.Interval{
+		EverySeconds: math.MaxInt64 / 1000,
+		Anchor:       timestamppb.New(mustParseTime(t, "2025-01-01 00:00:00Z")),
+	}, mustParseTime(t, "2025-01-01 00:00:00Z"))
+	if err == nil {
+		t.Error("checkInterval should reject intervals longer than the maximum")
+	}
+}
+
+func TestTriggerWindow(t *testing.T) {
+	data := &trigger_pb.TriggerData{
+		Cron:      "0 * * * *",
+		NotBefore: timestamppb.New(mustParseTime(t, "2025-01-01 09:00:00Z")),
+		NotAfter:  timestamppb.New(mustParseTime(t, "2025-01-01 17:00:00Z")),
+	}
+
+	if inWindow(data, mustParseTime(t, "2025-01-01 08:00:00Z")) {
+		t.Error("inWindow failed, expected times before notBefore to be outside")
+	}
+
+	if !inWindow(data, mustParseTime(t, "2025-01-01 09:00:00Z")) {
+		t.Error("inWindow failed, expected notBefore to be inside")
+	}
+
+	if !inWindow(data, mustParseTime(t, "2025-01-01 17:00:00Z")) {
+		t.Error("inWindow failed, expected notAfter to be inside")
+	}
+
+	if inWindow(data, mustParseTime(t, "2025-01-01 18:00:00Z")) {
+		t.Error("inWindow failed, expected times after notAfter to be outside")
+	}
+
+	if !inWindow(&trigger_pb.TriggerData{Cron: "0 * * * *"}, mustParseTime(t, "2025-01-01 18:00:00Z")) {
+		t.Error("inWindow failed, expected triggers without a window to always be inside")
+	}
+
+	if isExpired(data, mustParseTime(t, "2025-01-01 17:00:00Z")) {
+		t.Error("isExpired failed, expected the notAfter tick to still fire")
+	}
+
+	if !isExpired(data, mustParseTime(t, "2025-01-01 17:00:05Z")) {
+		t.Error("isExpired failed, expected ticks after notAfter to expire")
+	}
+
+	err := utils.ValidateWindow(data.NotAfter, data.NotBefore)
+	if err == nil {
+		t.Error("validateWindow failed, expected notAfter before notBefore to be rejected")
+	}
+}
+
+func TestCheckCalendar(t *testing.T) {
+	// Monday 17th is a holiday
+	calendar := &trigger_pb.CalendarData{
+		Name:            "test",
+		ExcludedDates:   []*date_j5t.Date{date_j5t.NewDate(2025, 2, 17)},
+		ExcludeWeekends: true,
+	}
+
+	skip := &trigger_pb.TriggerData{Cron: "30 18 17 * *"}
+	roll := &trigger_pb.TriggerData{Cron: "30 18 17 * *", CalendarRoll: trigger_pb.CalendarRoll_NEXT_BUSINESS_DAY}
+
+	holiday := mustParseTime(t, "2025-02-17 18:30:00Z")
+	nextDay := mustParseTime(t, "2025-02-18 18:30:00Z")
+
+	fireTime, _, err := checkCalendar(skip, calendar, holiday, &holiday)
+	if err != nil {
+		t.Error("expected no error")
+	}
+	if fireTime != nil {
+		t.Error("checkCalendar should not fire on an excluded date")
+	}
+
+	fireTime, _, err = checkCalendar(skip, nil, holiday, &holiday)
+	if err != nil {
+		t.Error("expected no error")
+	}
+	if fireTime == nil || !fireTime.Equal(holiday) {
+		t.Error("checkCalendar should fire without an active calendar")
+	}
+
+	fireTime, _, err = checkCalendar(skip, calendar, nextDay, nil)
+	if err != nil {
+		t.Error("expected no error")
+	}
+	if fireTime != nil {
+		t.Error("checkCalendar should not roll skipped fires forward")
+	}
+
+	fireTime, rolled, err := checkCalendar(roll, calendar, nextDay, nil)
+	if err != nil {
+		t.Error("expected no error")
+	}
+	if fireTime == nil || !fireTime.Equal(holiday) || !rolled {
+		t.Errorf("checkCalendar should roll the holiday forward, got %v", fireTime)
+	}
+
+	// Saturday 15th rolls over the weekend and the holiday
+	roll.Cron = "30 18 15 * *"
+	fireTime, rolled, err = checkCalendar(roll, calendar, nextDay, nil)
+	if err != nil {
+		t.Error("expected no error")
+	}
+	if fireTime == nil || !fireTime.Equal(mustParseTime(t, "2025-02-15 18:30:00Z")) || !rolled {
+		t.Errorf("checkCalendar should roll the weekend forward, got %v", fireTime)
+	}
+
+	// only to the first business day
+	fireTime, _, err = checkCalendar(roll, calendar, mustParseTime(t, "2025-02-19 18:30:00Z"), nil)
+	if err != nil {
+		t.Error("expected no error")
+	}
+	if fireTime != nil {
+		t.Error("checkCalendar should only roll to the next business day")
+	}
+}
+
+func TestNextFireTime(t *testing.T) {
+	now := mustParseTime(t, "2025-02-14 12:00:02Z")
+
+	assertNext := func(name string, data *trigger_pb.TriggerData, calendar *trigger_pb.CalendarData, expected string) {
+		t.Helper()
+		next, err := nextFireTime(data, calendar, now)
+		if err != nil {
+			t.Fatalf("%s: unexpected error %v", name, err)
+		}
+		if expected == "" {
+			if next != nil {
+				t.Errorf("%s: expected no next fire time, got %v", name, next)
+			}
+			return
+		}
+		if next == nil || !next.Equal(mustParseTime(t, expected)) {
+			t.Errorf("%s: expected next fire time %s, got %v", name, expected, next)
+		}
+	}
+
+	assertNext("cron", &trigger_pb.TriggerData{Cron: "30 18 * * *"}, nil, "2025-02-14 18:30:00Z")
+
+	assertNext("interval", &trigger_pb.TriggerData{
+		Interval: &trigger_pb.Interval{
+			EverySeconds: 600,
+			Anchor:       timestamppb.New(mustParseTime(t, "2025-01-01 00:00:00Z")),
+		},
+	}, nil, "2025-02-14 12:10:00Z")
+
+	assertNext("run at", &trigger_pb.TriggerData{
+		RunAt: timestamppb.New(mustParseTime(t, "2025-02-20 09:00:00Z")),
+	}, nil, "2025-02-20 09:00:00Z")
+
+	assertNext("overdue run at", &trigger_pb.TriggerData{
+		RunAt: timestamppb.New(mustParseTime(t, "2025-02-01 09:00:00Z")),
+	}, nil, "2025-02-14 12:00:05Z")
+
+	assertNext("not before", &trigger_pb.TriggerData{
+		Cron:      "30 18 * * *",
+		NotBefore: timestamppb.New(mustParseTime(t, "2025-02-16 18:30:00Z")),
+	}, nil, "2025-02-16 18:30:00Z")
+
+	assertNext("not after", &trigger_pb.TriggerData{
+		Cron:     "30 18 * * *",
+		NotAfter: timestamppb.New(mustParseTime(t, "2025-02-14 18:00:00Z")),
+	}, nil, "")
+
+	assertNext("max fires", &trigger_pb.TriggerData{
+		Cron:      "30 18 * * *",
+		MaxFires:  gl.Ptr(int32(2)),
+		FireCount: 2,
+	}, nil, "")
+
+	// Friday 14th, the weekend and Monday 17th are excluded
+	calendar := &trigger_pb.CalendarData{
+		Name:            "test",
+		ExcludedDates:   []*date_j5t.Date{date_j5t.NewDate(2025, 2, 14), date_j5t.NewDate(2025, 2, 17)},
+		ExcludeWeekends: true,
+	}
+
+	assertNext("calendar skip", &trigger_pb.TriggerData{Cron: "30 18 * * *"}, calendar, "2025-02-18 18:30:00Z")
+
+	assertNext("calendar roll", &trigger_pb.TriggerData{
+		Cron:         "30 18 15 * *",
+		CalendarRoll: trigger_pb.CalendarRoll_NEXT_BUSINESS_DAY,
+	}, calendar, "2025-02-18 18:30:00Z")
+
+	assertNext("calendar roll after a business day fire", &trigger_pb.TriggerData{
+		Cron:         "0 9,18 * * *",
+		CalendarRoll: trigger_pb.CalendarRoll_NEXT_BUSINESS_DAY,
+	}, calendar, "2025-02-18 09:00:00Z")
+
+	assertNext("calendar roll of a time already passed", &trigger_pb.TriggerData{
+		Cron:         "0 9 14 * *",
+		CalendarRoll: trigger_pb.CalendarRoll_NEXT_BUSINESS_DAY,
+	}, calendar, "2025-02-18 09:00:00Z")
+}
+
+func TestNextFireTimeDenseCron(t *testing.T) {
+	weekends := &trigger_pb.CalendarData{
+		Name:            "test",
+		ExcludeWeekends: true,
+	}
+
+	tcs := []struct {
+		name     string
+		data     *trigger_pb.TriggerData
+		after    string
+		expected []string
+	}{{
+		name:     "seconds over a weekend",
+		data:     &trigger_pb.TriggerData{Cron: "*/5 * * * * *"},
+		after:    "2025-02-15 00:00:00Z",
+		expected: []string{"2025-02-17 00:00:00Z", "2025-02-17 00:00:05Z"},
+	}, {
+		name:     "minutes over a weekend",
+		data:     &trigger_pb.TriggerData{Cron: "* * * * *"},
+		after:    "2025-02-15 00:00:00Z",
+		expected: []string{"2025-02-17 00:00:00Z", "2025-02-17 00:01:00Z"},
+	}, {
+		name:     "seconds rolled over a weekend",
+		data:     &trigger_pb.TriggerData{Cron: "*/5 * * * * *", CalendarRoll: trigger_pb.CalendarRoll_NEXT_BUSINESS_DAY},
+		after:    "2025-02-15 00:00:00Z",
+		expected: []string{"2025-02-17 00:00:00Z", "2025-02-17 00:00:05Z"},
+	}, {
+		name:     "minutes rolled over a weekend",
+		data:     &trigger_pb.TriggerData{Cron: "* * * * *", CalendarRoll: trigger_pb.CalendarRoll_NEXT_BUSINESS_DAY},
+		after:    "2025-02-15 00:00:00Z",
+		expected: []string{"2025-02-17 00:00:00Z", "2025-02-17 00:01:00Z"},
+	}, {
+		name:     "seconds rolled on a business day",
+		data:     &trigger_pb.TriggerData{Cron: "*/5 * * * * *", CalendarRoll: trigger_pb.CalendarRoll_NEXT_BUSINESS_DAY},
+		after:    "2025-02-17 12:00:02Z",
+		expected: []string{"2025-02-17 12:00:05Z", "2025-02-17 12:00:10Z"},
+	}, {
+		name:     "minutes rolled on a business day",
+		data:     &trigger_pb.TriggerData{Cron: "* * * * *", CalendarRoll: trigger_pb.CalendarRoll_NEXT_BUSINESS_DAY},
+		after:    "2025-02-17 12:00:02Z",
+		expected: []string{"2025-02-17 12:01:00Z", "2025-02-17 12:02:00Z"},
+	}}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			fireTimes, err := nextFireTimes(tc.data, weekends, mustParseTime(t, tc.after), len(tc.expected))
+			if err != nil {
+				t.Fatalf("unexpected error %v", err)
+			}
+			if len(fireTimes) != len(tc.expected) {
+				t.Fatalf("expected %v, got %v", tc.expected, fireTimes)
+			}
+			for i, expected := range tc.expected {
+				if !fireTimes[i].Equal(mustParseTime(t, expected)) {
+					t.Errorf("expected fire %d at %s, got %s", i, expected, fireTimes[i])
+				}
+			}
+		})
+	}
+
+	t.Run("never on a business day", func(t *testing.T) {
+		// the schedule carries on, so the trigger is checked again at the end
+		// of the search rather than never
+		after := mustParseTime(t, "2025-02-15 00:00:00Z")
+		next, err := nextFireTime(&trigger_pb.TriggerData{Cron: "0 9 * * SAT,SUN"}, weekends, after)
+		if err != nil {
+			t.Fatalf("unexpected error %v", err)
+		}
+		if next == nil || !next.Equal(after.Add(maxNextFireSearch)) {
+			t.Errorf("expected the end of the search, got %v", next)
+		}
+	})
+}
+
+func TestIdempotentCreate(t *testing.T) {
+	if idempotentTriggerID("app", "trigger") != idempotentTriggerID("app", "trigger") {
+		t.Error("idempotentTriggerID should derive the same ID for the same app and trigger name")
+	}
+
+	if idempotentTriggerID("app", "trigger") == idempotentTriggerID("app", "other") {
+		t.Error("idempotentTriggerID should derive different IDs for different trigger names")
+	}
+
+	if idempotentTriggerID("a/b", "c") == idempotentTriggerID("a", "b/c") {
+		t.Error("idempotentTriggerID should not confuse the app and trigger names")
+	}
+
+	data := &trigger_pb.TriggerData{
+		AppName:     "app",
+		TriggerName: "trigger",
+		Cron:        "0 7 * * *",
+		FireCount:   3,
+	}
+
+	update := &trigger_pb.TriggerEventType_Updated{
+		AppName:     "app",
+		TriggerName: "trigger",
+		Cron:        "0 7 * * *",
+	}
+	if !sameTriggerConfig(data, update) {
+		t.Error("sameTriggerConfig should ignore the fire count")
+	}
+
+	update.Cron = "0 8 * * *"
+	if sameTriggerConfig(data, update) {
+		t.Error("sameTriggerConfig should detect a changed cron")
+	}
+}
+
+func TestSyncEvents(t *testing.T) {
+	runAt := timestamppb.New(mustParseTime(t, "2025-02-14 09:00:00Z"))
+
+	existingTrigger := func(triggerID string, status trigger_pb.TriggerStatus, data *trigger_pb.TriggerData) *trigger_pb.TriggerState {
+		data.AppName = "app"
+		return &trigger_pb.TriggerState{
+			Keys:   &trigger_pb.TriggerKeys{TriggerId: triggerID},
+			Status: status,
+			Data:   data,
+		}
+	}
+
+	duplicateID := id62.NewString()
+	goneID := id62.NewString()
+	quarantinedID := id62.NewString()
+
+	existing := []*trigger_pb.TriggerState{
+		existingTrigger(idempotentTriggerID("app", "keep"), trigger_pb.TriggerStatus_ACTIVE, &trigger_pb.TriggerData{TriggerName: "keep", Cron: "0 7 * * *"}),
+		existingTrigger(idempotentTriggerID("app", "change"), trigger_pb.TriggerStatus_PAUSED, &trigger_pb.TriggerData{TriggerName: "change", Cron: "0 7 * * *"}),
+		existingTrigger(duplicateID, trigger_pb.TriggerStatus_ACTIVE, &trigger_pb.TriggerData{TriggerName: "keep", Cron: "0 7 * * *"}),
+		existingTrigger(goneID, trigger_pb.TriggerStatus_ACTIVE, &trigger_pb.TriggerData{TriggerName: "gone", Cron: "0 7 * * *"}),
+		existingTrigger(id62.NewString(), trigger_pb.TriggerStatus_COMPLETED, &trigger_pb.TriggerData{
+			TriggerName:     "once",
+			RunAt:           runAt,
+			FireCount:       1,
+			RequestMetadata: &messaging_j5pb.RequestMetadata{ReplyTo: "previous boot"},
+		}),
+		existingTrigger(id62.NewString(), trigger_pb.TriggerStatus_ARCHIVED, &trigger_pb.TriggerData{TriggerName: "old", Cron: "0 7 * * *"}),
+		existingTrigger(idempotentTriggerID("app", "broken"), trigger_pb.TriggerStatus_FAULTED, &trigger_pb.TriggerData{TriggerName: "broken", Cron: "0 7 * * *"}),
+		existingTrigger(quarantinedID, trigger_pb.TriggerStatus_FAULTED, &trigger_pb.TriggerData{TriggerName: "quarantined", Cron: "0 7 * * *"}),
+	}
+
+	events := syncEvents(&trigger_pb.ActionType_Sync{
+		AppName: "app",
+		Triggers: []*trigger_pb.TriggerDefinition{
+			{TriggerName: "keep", Cron: "0 7 * * *"},
+			{TriggerName: "change", Cron: "0 8 * * *"},
+			{TriggerName: "once", RunAt: runAt},
+			{TriggerName: "new", Cron: "0 9 * * *"},
+			{TriggerName: "broken", Cron: "0 10 * * *"},
+		},
+	}, existing, nil)
+
+	if len(events) != 6 {
+		t.Fatalf("expected 6 events, got %d", len(events))
+	}
+
+	expected := []struct {
+		triggerID string
+		event     trigger_pb.TriggerPSMEvent
+	}{
+		{idempotentTriggerID("app", "change"), &trigger_pb.TriggerEventType_Updated{}},
+		{idempotentTriggerID("app", "new"), &trigger_pb.TriggerEventType_Created{}},
+		{idempotentTriggerID("app", "broken"), &trigger_pb.TriggerEventType_Updated{}},
+		{duplicateID, &trigger_pb.TriggerEventType_Archived{}},
+		{goneID, &trigger_pb.TriggerEventType_Archived{}},
+		{quarantinedID, &trigger_pb.TriggerEventType_Archived{}},
+	}
+
+	for i, want := range expected {
+		if events[i].Keys.TriggerId != want.triggerID {
+			t.Errorf("event %d: expected trigger %s, got %s", i, want.triggerID, events[i].Keys.TriggerId)
+		}
+		if fmt.Sprintf("%T", events[i].Event) != fmt.Sprintf("%T", want.event) {
+			t.Errorf("event %d: expected %T, got %T", i, want.event, events[i].Event)
+		}
+	}
+
+	if cron := events[0].Event.(*trigger_pb.TriggerEventType_Updated).Cron; cron != "0 8 * * *" {
+		t.Errorf("expected the update to set the declared cron, got %s", cron)
+	}
+}
+
+func TestApplyDefinition(t *testing.T) {
+	def := &trigger_pb.TriggerDefinition{
+		TriggerName:   "full",
+		Cron:          "0 7 * * *",
+		CatchUp:       trigger_pb.CatchUpPolicy_CATCH_UP_POLICY_FIRE_LATEST,
+		NotBefore:     timestamppb.New(mustParseTime(t, "2025-02-14 09:00:00Z")),
+		NotAfter:      timestamppb.New(mustParseTime(t, "2025-03-14 09:00:00Z")),
+		MaxFires:      gl.Ptr(int32(0)),
+		Timezone:      "Europe/London",
+		DstPolicy:     trigger_pb.DSTPolicy_DST_POLICY_FIRE_BOTH,
+		JitterSeconds: 30,
+		CalendarId:    gl.Ptr(id62.NewString()),
+		CalendarRoll:  trigger_pb.CalendarRoll_CALENDAR_ROLL_NEXT_BUSINESS_DAY,
+	}
+
+	carriers := []proto.Message{
+		&trigger_pb.ActionType_Create{},
+		&trigger_pb.ActionType_Update{},
+		&trigger_pb.TriggerEventType_Created{},
+		&trigger_pb.TriggerEventType_Updated{},
+		&trigger_pb.TriggerData{},
+	}
+
+	for _, carrier := range carriers {
+		utils.ApplyDefinition(carrier, def)
+		if got := utils.DefinitionOf(carrier); !proto.Equal(def, got) {
+			t.Errorf("%T: expected %v, got %v", carrier, def, got)
+		}
+
+		utils.ApplyDefinition(carrier, &trigger_pb.TriggerDefinition{TriggerName: "bare"})
+		if got := utils.DefinitionOf(carrier); got.MaxFires != nil || got.NotAfter != nil || got.Cron != "" {
+			t.Errorf("%T: expected unset fields to be cleared, got %v", carrier, got)
+		}
+	}
+}
+
+func TestValidatePayload(t *testing.T) {
+	err := utils.ValidatePayload(nil)
+	if err != nil {
+		t.Error("validate payload failed, expected no payload to be valid")
+	}
+
+	err = utils.ValidatePayload(&any_j5t.Any{TypeName: "test.v1.Report", J5Json: []byte(`{"id":"1"}`)})
+	if err != nil {
+		t.Errorf("validate payload failed, expected JSON to be valid, got %v", err)
+	}
+
+	err = utils.ValidatePayload(&any_j5t.Any{J5Json: []byte(`{"id":"1"}`)})
+	if err == nil {
+		t.Error("validate payload failed, expected an error without a type name")
+	}
+
+	ts, err := proto.Marshal(timestamppb.New(mustParseTime(t, "2025-01-01 00:00:00Z")))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = utils.ValidatePayload(&any_j5t.Any{TypeName: "google.protobuf.Timestamp", Proto: ts})
+	if err != nil {
+		t.Errorf("validate payload failed, expected a known proto type to be valid, got %v", err)
+	}
+
+	err = utils.ValidatePayload(&any_j5t.Any{TypeName: "test.v1.Unknown", Proto: ts})
+	if err == nil {
+		t.Error("validate payload failed, expected an error for an unknown proto type")
+	}
+}
+
+func TestIsLate(t *testing.T) {
+	now := mustParseTime(t, "2025-01-01 06:46:20Z")
+
+	if isLate(mustParseTime(t, "2025-01-01 06:46:00Z"), now) {
+		t.Error("isLate failed, expected the current tick to be on time")
+	}
+
+	if !isLate(mustParseTime(t, "2025-01-01 06:40:00Z"), now) {
+		t.Error("isLate failed, expected an old tick to be late")
+	}
+}
+
+func TestCheckCatchUp(t *testing.T) {
+	now := mustParseTime(t, "2025-01-01 13:30:00Z")
+
+	// every 5 minutes, 13:00 was missed but so were 13:05 through 13:30
+	fire, err := checkCatchUp(&trigger_pb.TriggerData{Cron: "*/5 * * * *", CatchUp: trigger_pb.CatchUpPolicy_FIRE_ALL}, mustParseTime(t, "2025-01-01 13:00:00Z"), now)
+	if err != nil {
+		t.Error("expected no error")
+	}
+	if !fire {
+		t.Error("checkCatchUp should fire all missed times")
+	}
+
+	fire, err = checkCatchUp(&trigger_pb.TriggerData{Cron: "*/5 * * * *", CatchUp: trigger_pb.CatchUpPolicy_UNSPECIFIED}, mustParseTime(t, "2025-01-01 13:00:00Z"), now)
+	if err != nil {
+		t.Error("expected no error")
+	}
+	if !fire {
+		t.Error("checkCatchUp should default to firing all missed times")
+	}
+
+	fire, err = checkCatchUp(&trigger_pb.TriggerData{Cron: "*/5 * * * *", CatchUp: trigger_pb.CatchUpPolicy_SKIP}, mustParseTime(t, "2025-01-01 13:00:00Z"), now)
+	if err != nil {
+		t.Error("expected no error")
+	}
+	if fire {
+		t.Error("checkCatchUp should skip missed times")
+	}
+
+	fire, err = checkCatchUp(&trigger_pb.TriggerData{Cron: "*/5 * * * *", CatchUp: trigger_pb.CatchUpPolicy_FIRE_LATEST}, mustParseTime(t, "2025-01-01 13:00:00Z"), now)
+	if err != nil {
+		t.Error("expected no error")
+	}
+	if fire {
+		t.Error("checkCatchUp should not fire when a later time was also missed")
+	}
+
+	// hourly, 13:00 is the most recent missed time
+	fire, err = checkCatchUp(&trigger_pb.TriggerData{Cron: "0 * * * *", CatchUp: trigger_pb.CatchUpPolicy_FIRE_LATEST}, mustParseTime(t, "2025-01-01 13:00:00Z"), now)
+	if err != nil {
+		t.Error("expected no error")
+	}
+	if !fire {
+		t.Error("checkCatchUp should fire the latest missed time")
+	}
+}
+
 func mustParseTime(t *testing.T, s string) time.Time {
 	parseString := "2006-01-02 15:04:05"
 	if strings.Contains(s, "Z") {
@@ -267,3 +1265,221 @@ func mustParseTime(t *testing.T, s string) time.Time {
 	}
 	return tm
 }
+
+func TestRunBatch(t *testing.T) {
+	items := []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}
+
+	var mu sync.Mutex
+	running, maxRunning := 0, 0
+	ran := map[int]bool{}
+
+	failed := runBatch(context.Background(), 3, items, func(ctx context.Context, item int) error {
+		mu.Lock()
+		running++
+		maxRunning = max(maxRunning, running)
+		ran[item] = true
+		mu.Unlock()
+
+		time.Sleep(5 * time.Millisecond)
+
+		mu.Lock()
+		running--
+		mu.Unlock()
+
+		if item%4 == 0 {
+			return fmt.Errorf("item %d failed", item)
+		}
+		return nil
+	})
+
+	if maxRunning > 3 {
+		t.Errorf("runBatch ran %d at once, expected at most 3", maxRunning)
+	}
+
+	if len(ran) != len(items) {
+		t.Errorf("runBatch ran %d items, expected a failure not to stop the others", len(ran))
+	}
+
+	if len(failed) != 2 || failed[3] == nil || failed[7] == nil {
+		t.Errorf("runBatch failed %v, expected the items at index 3 and 7", failed)
+	}
+}
+
+func TestTickLag(t *testing.T) {
+	now := mustParseTime(t, "2025-01-01 06:46:20Z")
+
+	if lag := tickLag(mustParseTime(t, "2025-01-01 06:46:00Z"), now); lag != 20*time.Second {
+		t.Errorf("tickLag got %s, expected 20s behind the last tick", lag)
+	}
+
+	if lag := tickLag(mustParseTime(t, "2025-01-01 06:47:00Z"), now); lag != 0 {
+		t.Errorf("tickLag got %s, expected no lag for a tick ahead of the clock", lag)
+	}
+}
+
+func TestTickMetrics(t *testing.T) {
+	ctx := context.Background()
+	reader := sdkmetric.NewManualReader()
+	provider := sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader))
+
+	// the lag is not observed when the last tick cannot be read
+	metrics, err := newTickMetrics(provider.Meter(meterName), &countingDB{err: driver.ErrBadConn})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	metrics.recordTick(ctx, TickResult{Duration: 250 * time.Millisecond, Fired: 3})
+	metrics.recordTick(ctx, TickResult{Duration: 750 * time.Millisecond, Fired: 1})
+
+	rm := metricdata.ResourceMetrics{}
+	if err := reader.Collect(ctx, &rm); err != nil {
+		t.Fatal(err)
+	}
+
+	collected := map[string]metricdata.Aggregation{}
+	for _, sm := range rm.ScopeMetrics {
+		for _, m := range sm.Metrics {
+			collected[m.Name] = m.Data
+		}
+	}
+
+	duration, ok := collected["trigger.tick.duration"].(metricdata.Histogram[float64])
+	if !ok || len(duration.DataPoints) != 1 {
+		t.Fatalf("trigger.tick.duration got %v, expected one histogram point", collected["trigger.tick.duration"])
+	}
+	if dp := duration.DataPoints[0]; dp.Count != 2 || dp.Sum != 1 {
+		t.Errorf("trigger.tick.duration got count %d sum %v, expected 2 ticks taking 1s", dp.Count, dp.Sum)
+	}
+
+	fired, ok := collected["trigger.tick.fired"].(metricdata.Histogram[int64])
+	if !ok || len(fired.DataPoints) != 1 {
+		t.Fatalf("trigger.tick.fired got %v, expected one histogram point", collected["trigger.tick.fired"])
+	}
+	if dp := fired.DataPoints[0]; dp.Count != 2 || dp.Sum != 4 {
+		t.Errorf("trigger.tick.fired got count %d sum %d, expected 2 ticks firing 4", dp.Count, dp.Sum)
+	}
+
+	if lag, ok := collected["trigger.tick.lag"].(metricdata.Gauge[float64]); ok && len(lag.DataPoints) != 0 {
+		t.Errorf("trigger.tick.lag got %v, expected no observation", lag.DataPoints)
+	}
+}
+
+func TestIsStaleTick(t *testing.T) {
+	now := mustParseTime(t, "2025-01-01 06:46:20Z")
+	staleAfter := 5 * time.Minute
+
+	for _, tc := range []struct {
+		name        string
+		tickedAt    string
+		recoveredAt string
+		want        bool
+	}{{
+		name:     "ticked recently",
+		tickedAt: "2025-01-01 06:46:15Z",
+		want:     false,
+	}, {
+		name:     "stale tick",
+		tickedAt: "2025-01-01 06:30:00Z",
+		want:     true,
+	}, {
+		name:        "stale tick recovered recently",
+		tickedAt:    "2025-01-01 06:30:00Z",
+		recoveredAt: "2025-01-01 06:44:00Z",
+		want:        false,
+	}, {
+		name:        "stale tick recovered long ago",
+		tickedAt:    "2025-01-01 06:30:00Z",
+		recoveredAt: "2025-01-01 06:35:00Z",
+		want:        true,
+	}} {
+		t.Run(tc.name, func(t *testing.T) {
+			recoveredAt := sql.NullTime{}
+			if tc.recoveredAt != "" {
+				recoveredAt = sql.NullTime{Time: mustParseTime(t, tc.recoveredAt), Valid: true}
+			}
+
+			got := isStaleTick(mustParseTime(t, tc.tickedAt), recoveredAt, now, staleAfter)
+			if got != tc.want {
+				t.Errorf("isStaleTick got %v, expected %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestRunWatchdogInvalidDurations(t *testing.T) {
+	w := TriggerWorker{tickInterval: 30 * time.Second}
+
+	if err := w.RunWatchdog(context.Background(), 0, 5*time.Minute); err == nil {
+		t.Error("RunWatchdog should reject a zero interval")
+	}
+
+	if err := w.RunWatchdog(context.Background(), time.Minute, -time.Minute); err == nil {
+		t.Error("RunWatchdog should reject a negative stale after")
+	}
+
+	if err := w.RunWatchdog(context.Background(), time.Minute, 45*time.Second); err == nil {
+		t.Error("RunWatchdog should reject a stale after under two tick intervals")
+	}
+}
+
+// countingDB counts transactions without running them, failing each with err
+// when it is set.
+type countingDB struct {
+	err   error
+	calls int
+}
+
+func (db *countingDB) Transact(ctx context.Context, opts *sqrlx.TxOptions, cb sqrlx.Callback) error {
+	db.calls++
+	return db.err
+}
+
+func TestManageRequestErrors(t *testing.T) {
+	request := &messaging_j5pb.RequestMetadata{
+		ReplyTo: "test",
+	}
+
+	// a database fault is returned for the request to be redelivered, without
+	// replying to it
+	db := &countingDB{err: driver.ErrBadConn}
+	w := &TriggerWorker{db: db}
+	_, err := w.TriggerManageRequest(context.Background(), &trigger_tpb.TriggerManageRequestMessage{
+		Request: request,
+		Action: &trigger_pb.ActionType{
+			Type: &trigger_pb.ActionType_Pause_{
+				Pause: &trigger_pb.ActionType_Pause{
+					TriggerId: id62.NewString(),
+				},
+			},
+		},
+	})
+	if !errors.Is(err, driver.ErrBadConn) {
+		t.Errorf("TriggerManageRequest got %v, expected the database error", err)
+	}
+	if db.calls != 1 {
+		t.Errorf("TriggerManageRequest ran %d transactions, expected no reply", db.calls)
+	}
+
+	// an invalid request is replied to and acknowledged
+	db = &countingDB{}
+	w = &TriggerWorker{db: db}
+	_, err = w.TriggerManageRequest(context.Background(), &trigger_tpb.TriggerManageRequestMessage{
+		Request: request,
+		Action: &trigger_pb.ActionType{
+			Type: &trigger_pb.ActionType_Update_{
+				Update: &trigger_pb.ActionType_Update{
+					TriggerId:   id62.NewString(),
+					AppName:     "test",
+					TriggerName: "test",
+					Cron:        "0 Fail 1 * *",
+				},
+			},
+		},
+	})
+	if err != nil {
+		t.Errorf("TriggerManageRequest got %v, expected the rejection to be acknowledged", err)
+	}
+	if db.calls != 1 {
+		t.Errorf("TriggerManageRequest ran %d transactions, expected one to reply", db.calls)
+	}
+}
@@ -0,0 +1,49 @@
+package states
+
+import (
+	"github.com/pentops/trigger/gen/o5/trigger/v1/trigger_pb"
+)
+
+func NewCalendarStateMachine() (*trigger_pb.CalendarPSM, error) {
+	config := trigger_pb.CalendarPSMBuilder()
+
+	sm, err := config.BuildStateMachine()
+	if err != nil {
+		return nil, err
+	}
+
+	// CREATED -> ACTIVE
+	sm.From(0).
+		OnEvent(trigger_pb.CalendarPSMEventCreated).
+		SetStatus(trigger_pb.CalendarStatus_ACTIVE).
+		Mutate(trigger_pb.CalendarPSMMutation(func(
+			state *trigger_pb.CalendarData,
+			event *trigger_pb.CalendarEventType_Created,
+		) error {
+			state.Name = event.Name
+			state.ExcludedDates = event.ExcludedDates
+			state.ExcludeWeekends = event.ExcludeWeekends
+			return nil
+		}))
+
+	// ACTIVE -> UPDATED
+	sm.From(trigger_pb.CalendarStatus_ACTIVE).
+		OnEvent(trigger_pb.CalendarPSMEventUpdated).
+		SetStatus(trigger_pb.CalendarStatus_ACTIVE).
+		Mutate(trigger_pb.CalendarPSMMutation(func(
+			state *trigger_pb.CalendarData,
+			event *trigger_pb.CalendarEventType_Updated,
+		) error {
+			state.Name = event.Name
+			state.ExcludedDates = event.ExcludedDates
+			state.ExcludeWeekends = event.ExcludeWeekends
+			return nil
+		}))
+
+	// ACTIVE -> ARCHIVED
+	sm.From(trigger_pb.CalendarStatus_ACTIVE).
+		OnEvent(trigger_pb.CalendarPSMEventArchived).
+		SetStatus(trigger_pb.CalendarStatus_ARCHIVED)
+
+	return sm, nil
+}
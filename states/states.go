@@ -2,11 +2,16 @@ package states
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"strings"
+	"time"
 
+	sq "github.com/elgris/sqrl"
+	"github.com/pentops/sqrlx.go/sqrlx"
 	"github.com/pentops/trigger/gen/o5/trigger/v1/trigger_pb"
 	"github.com/pentops/trigger/gen/o5/trigger/v1/trigger_tpb"
-	"github.com/robfig/cron/v3"
+	"github.com/pentops/trigger/utils"
 )
 
 func NewTriggerStateMachine() (*trigger_pb.TriggerPSM, error) {
@@ -25,15 +30,15 @@ func NewTriggerStateMachine() (*trigger_pb.TriggerPSM, error) {
 			state *trigger_pb.TriggerData,
 			event *trigger_pb.TriggerEventType_Created,
 		) error {
-			err := validateCronString(event.Cron)
-			if err != nil {
-				return fmt.Errorf("update trigger: %w", err)
+			def := utils.DefinitionOf(event)
+			if err := utils.ValidateDefinition(def); err != nil {
+				return Reject(fmt.Errorf("update trigger: %w", err))
 			}
 
-			state.Cron = event.Cron
+			utils.ApplyDefinition(state, def)
 			state.AppName = event.AppName
-			state.TriggerName = event.TriggerName
 			state.RequestMetadata = event.RequestMetadata
+			state.NextFireAt = event.NextFireAt
 			return nil
 		}))
 
@@ -45,21 +50,39 @@ func NewTriggerStateMachine() (*trigger_pb.TriggerPSM, error) {
 			state *trigger_pb.TriggerData,
 			event *trigger_pb.TriggerEventType_Updated,
 		) error {
-			err := validateCronString(event.Cron)
-			if err != nil {
-				return fmt.Errorf("update trigger: %w", err)
+			def := utils.DefinitionOf(event)
+			if err := utils.ValidateDefinition(def); err != nil {
+				return Reject(fmt.Errorf("update trigger: %w", err))
 			}
 
-			state.Cron = event.Cron
+			utils.ApplyDefinition(state, def)
 			state.AppName = event.AppName
-			state.TriggerName = event.TriggerName
 			state.RequestMetadata = event.RequestMetadata
+			state.NextFireAt = event.NextFireAt
 			return nil
-		}))
+		})).
+		LogicHook(completeReachedMaxFires)
 
 	// ACTIVE -> TRIGGERED
 	sm.From(trigger_pb.TriggerStatus_ACTIVE).
 		OnEvent(trigger_pb.TriggerPSMEventTriggered).
+		Hook(trigger_pb.TriggerPSMDataHook(func(
+			ctx context.Context,
+			tx sqrlx.Transaction,
+			state *trigger_pb.TriggerState,
+			event *trigger_pb.TriggerEventType_Triggered,
+		) error {
+			return recordFire(ctx, tx, state.Keys.TriggerId, event.TriggerTime.AsTime())
+		})).
+		Mutate(trigger_pb.TriggerPSMMutation(func(
+			state *trigger_pb.TriggerData,
+			event *trigger_pb.TriggerEventType_Triggered,
+		) error {
+			state.FireCount++
+			state.LastFiredAt = event.TriggerTime
+			state.NextFireAt = event.NextFireAt
+			return nil
+		})).
 		LogicHook(trigger_pb.TriggerPSMLogicHook(func(
 			ctx context.Context,
 			tb trigger_pb.TriggerPSMHookBaton,
@@ -70,10 +93,90 @@ func NewTriggerStateMachine() (*trigger_pb.TriggerPSM, error) {
 			reply := &trigger_tpb.TriggerReplyMessage{
 				Request:  state.Data.RequestMetadata,
 				TickTime: event.TriggerTime,
+				Late:     event.Late,
+				Payload:  state.Data.Payload,
 			}
 
-			tb.SideEffect(reply)
+			// spread out replies of triggers sharing a schedule, the tick time
+			// still identifies the scheduled slot
+			jitter := utils.JitterOffset(state.Keys.TriggerId, state.Data.JitterSeconds)
+			if jitter > 0 {
+				tb.DelayedSideEffect(reply, jitter)
+			} else {
+				tb.SideEffect(reply)
+			}
+
+			// one-shot triggers only ever fire once, others may have a limit
+			if state.Data.RunAt != nil || maxFiresReached(state.Data) {
+				tb.ChainEvent(&trigger_pb.TriggerEventType_Completed{})
+			}
+
+			return nil
+		}))
+
+	// ACTIVE, PAUSED, FAULTED -> COMPLETED
+	sm.From(trigger_pb.TriggerStatus_ACTIVE, trigger_pb.TriggerStatus_PAUSED, trigger_pb.TriggerStatus_FAULTED).
+		OnEvent(trigger_pb.TriggerPSMEventCompleted).
+		SetStatus(trigger_pb.TriggerStatus_COMPLETED).
+		Mutate(trigger_pb.TriggerPSMMutation(func(
+			state *trigger_pb.TriggerData,
+			event *trigger_pb.TriggerEventType_Completed,
+		) error {
+			state.NextFireAt = nil
+			return nil
+		}))
+
+	// ACTIVE, PAUSED, FAULTED -> EXPIRED
+	sm.From(trigger_pb.TriggerStatus_ACTIVE, trigger_pb.TriggerStatus_PAUSED, trigger_pb.TriggerStatus_FAULTED).
+		OnEvent(trigger_pb.TriggerPSMEventExpired).
+		SetStatus(trigger_pb.TriggerStatus_EXPIRED).
+		Mutate(trigger_pb.TriggerPSMMutation(func(
+			state *trigger_pb.TriggerData,
+			event *trigger_pb.TriggerEventType_Expired,
+		) error {
+			state.NextFireAt = nil
+			return nil
+		})).
+		LogicHook(trigger_pb.TriggerPSMLogicHook(func(
+			ctx context.Context,
+			tb trigger_pb.TriggerPSMHookBaton,
+			state *trigger_pb.TriggerState,
+			event *trigger_pb.TriggerEventType_Expired,
+		) error {
+
+			// let consumers know the trigger has stopped for good
+			evt := tb.FullCause()
+			tb.SideEffect(&trigger_tpb.TriggerEventMessage{
+				Metadata: evt.EventPublishMetadata(),
+				Keys:     evt.Keys,
+				Event:    evt.Event,
+				Data:     state.Data,
+				Status:   state.Status,
+			})
+
+			return nil
+		}))
 
+	// ACTIVE -> RESCHEDULED
+	sm.From(trigger_pb.TriggerStatus_ACTIVE).
+		OnEvent(trigger_pb.TriggerPSMEventRescheduled).
+		Mutate(trigger_pb.TriggerPSMMutation(func(
+			state *trigger_pb.TriggerData,
+			event *trigger_pb.TriggerEventType_Rescheduled,
+		) error {
+			state.NextFireAt = event.NextFireAt
+			return nil
+		}))
+
+	// ACTIVE -> FAULTED
+	sm.From(trigger_pb.TriggerStatus_ACTIVE).
+		OnEvent(trigger_pb.TriggerPSMEventFaulted).
+		SetStatus(trigger_pb.TriggerStatus_FAULTED).
+		Mutate(trigger_pb.TriggerPSMMutation(func(
+			state *trigger_pb.TriggerData,
+			event *trigger_pb.TriggerEventType_Faulted,
+		) error {
+			state.NextFireAt = nil
 			return nil
 		}))
 
@@ -90,6 +193,7 @@ func NewTriggerStateMachine() (*trigger_pb.TriggerPSM, error) {
 			reply := &trigger_tpb.TriggerReplyMessage{
 				Request:  state.Data.RequestMetadata,
 				TickTime: event.TriggerTime,
+				Payload:  state.Data.Payload,
 			}
 
 			tb.SideEffect(reply)
@@ -100,50 +204,243 @@ func NewTriggerStateMachine() (*trigger_pb.TriggerPSM, error) {
 	// ACTIVE -> PAUSED
 	sm.From(trigger_pb.TriggerStatus_ACTIVE).
 		OnEvent(trigger_pb.TriggerPSMEventPaused).
-		SetStatus(trigger_pb.TriggerStatus_PAUSED)
+		SetStatus(trigger_pb.TriggerStatus_PAUSED).
+		Mutate(trigger_pb.TriggerPSMMutation(func(
+			state *trigger_pb.TriggerData,
+			event *trigger_pb.TriggerEventType_Paused,
+		) error {
+			state.NextFireAt = nil
+			return nil
+		}))
 
 	// ACTIVE -> ARCHIVED
 	sm.From(trigger_pb.TriggerStatus_ACTIVE).
 		OnEvent(trigger_pb.TriggerPSMEventArchived).
-		SetStatus(trigger_pb.TriggerStatus_ARCHIVED)
+		SetStatus(trigger_pb.TriggerStatus_ARCHIVED).
+		Mutate(trigger_pb.TriggerPSMMutation(func(
+			state *trigger_pb.TriggerData,
+			event *trigger_pb.TriggerEventType_Archived,
+		) error {
+			state.NextFireAt = nil
+			return nil
+		}))
 
 	// PAUSED -> ACTIVE
 	sm.From(trigger_pb.TriggerStatus_PAUSED).
 		OnEvent(trigger_pb.TriggerPSMEventActivated).
-		SetStatus(trigger_pb.TriggerStatus_ACTIVE)
+		SetStatus(trigger_pb.TriggerStatus_ACTIVE).
+		Mutate(trigger_pb.TriggerPSMMutation(func(
+			state *trigger_pb.TriggerData,
+			event *trigger_pb.TriggerEventType_Activated,
+		) error {
+			state.NextFireAt = event.NextFireAt
+			return nil
+		}))
 
-	// PAUSED -> PAUSED update event
-	sm.From(trigger_pb.TriggerStatus_PAUSED).
+	// FAULTED -> ACTIVE once the schedule parses again
+	sm.From(trigger_pb.TriggerStatus_FAULTED).
+		OnEvent(trigger_pb.TriggerPSMEventActivated).
+		SetStatus(trigger_pb.TriggerStatus_ACTIVE).
+		Mutate(trigger_pb.TriggerPSMMutation(func(
+			state *trigger_pb.TriggerData,
+			event *trigger_pb.TriggerEventType_Activated,
+		) error {
+			err := utils.ValidateSchedule(state.Cron, state.Timezone, state.RunAt, state.Interval)
+			if err != nil {
+				return Reject(fmt.Errorf("resume faulted trigger: %w", err))
+			}
+
+			state.NextFireAt = event.NextFireAt
+			return nil
+		}))
+
+	// PAUSED -> PAUSED, FAULTED -> FAULTED update event
+	sm.From(trigger_pb.TriggerStatus_PAUSED, trigger_pb.TriggerStatus_FAULTED).
 		OnEvent(trigger_pb.TriggerPSMEventUpdated).
-		SetStatus(trigger_pb.TriggerStatus_PAUSED).
 		Mutate(trigger_pb.TriggerPSMMutation(func(
 			state *trigger_pb.TriggerData,
 			event *trigger_pb.TriggerEventType_Updated,
 		) error {
-			err := validateCronString(event.Cron)
-			if err != nil {
-				return fmt.Errorf("update trigger: %w", err)
+			def := utils.DefinitionOf(event)
+			if err := utils.ValidateDefinition(def); err != nil {
+				return Reject(fmt.Errorf("update trigger: %w", err))
 			}
 
-			state.Cron = event.Cron
+			utils.ApplyDefinition(state, def)
 			state.AppName = event.AppName
-			state.TriggerName = event.TriggerName
 			state.RequestMetadata = event.RequestMetadata
+			state.NextFireAt = event.NextFireAt
 			return nil
-		}))
+		})).
+		LogicHook(completeReachedMaxFires)
 
-	// PAUSED -> ARCHIVED
-	sm.From(trigger_pb.TriggerStatus_PAUSED).
+	// PAUSED, FAULTED -> ARCHIVED
+	sm.From(trigger_pb.TriggerStatus_PAUSED, trigger_pb.TriggerStatus_FAULTED).
 		OnEvent(trigger_pb.TriggerPSMEventArchived).
-		SetStatus(trigger_pb.TriggerStatus_ARCHIVED)
+		SetStatus(trigger_pb.TriggerStatus_ARCHIVED).
+		Mutate(trigger_pb.TriggerPSMMutation(func(
+			state *trigger_pb.TriggerData,
+			event *trigger_pb.TriggerEventType_Archived,
+		) error {
+			state.NextFireAt = nil
+			return nil
+		}))
+
+	// ARCHIVED -> PAUSED, then ACTIVE when activate is set
+	sm.From(trigger_pb.TriggerStatus_ARCHIVED).
+		OnEvent(trigger_pb.TriggerPSMEventRestored).
+		SetStatus(trigger_pb.TriggerStatus_PAUSED).
+		LogicHook(trigger_pb.TriggerPSMLogicHook(func(
+			ctx context.Context,
+			tb trigger_pb.TriggerPSMHookBaton,
+			state *trigger_pb.TriggerState,
+			event *trigger_pb.TriggerEventType_Restored,
+		) error {
+			if event.Reason == "" {
+				return Reject(fmt.Errorf("restore trigger: reason is required"))
+			}
+
+			if event.Activate {
+				tb.ChainEvent(&trigger_pb.TriggerEventType_Activated{
+					NextFireAt: event.NextFireAt,
+				})
+			}
+
+			return nil
+		}))
+
+	// the tick loop looks up due triggers by the indexed due_at column rather
+	// than loading every active trigger
+	sm.StateDataHook(trigger_pb.TriggerPSMGeneralStateDataHook(func(
+		ctx context.Context,
+		tx sqrlx.Transaction,
+		state *trigger_pb.TriggerState,
+	) error {
+		_, err := tx.Update(ctx, sq.Update("trigger").
+			Set("due_at", dueAt(state)).
+			Where("trigger_id = ?", state.Keys.TriggerId))
+		if err != nil {
+			return fmt.Errorf("update trigger due time: %w", err)
+		}
+		return nil
+	}))
 
 	return sm, nil
 }
 
-func validateCronString(c string) error {
-	_, err := cron.ParseStandard(c)
+// completeReachedMaxFires completes a trigger updated to a maxFires it has
+// already fired as many times as, rather than leaving it to fire again.
+var completeReachedMaxFires = trigger_pb.TriggerPSMLogicHook(func(
+	ctx context.Context,
+	tb trigger_pb.TriggerPSMHookBaton,
+	state *trigger_pb.TriggerState,
+	event *trigger_pb.TriggerEventType_Updated,
+) error {
+	if maxFiresReached(state.Data) {
+		tb.ChainEvent(&trigger_pb.TriggerEventType_Completed{})
+	}
+	return nil
+})
+
+// maxFiresReached reports whether the trigger has fired as many times as its
+// maxFires allows.
+func maxFiresReached(data *trigger_pb.TriggerData) bool {
+	return data.MaxFires != nil && data.FireCount >= *data.MaxFires
+}
+
+// ErrRejected marks an event the state machine refuses to apply, as it is
+// invalid or the status has no transition for it, rather than a failure to
+// store it. Retrying a rejected event fails the same way.
+var ErrRejected = errors.New("event rejected")
+
+type rejectedError struct {
+	err error
+}
+
+func (e rejectedError) Error() string {
+	return e.err.Error()
+}
+
+func (e rejectedError) Unwrap() error {
+	return e.err
+}
+
+func (e rejectedError) Is(target error) bool {
+	return target == ErrRejected
+}
+
+// Reject marks err as an ErrRejected, keeping its message.
+func Reject(err error) error {
+	return rejectedError{err: err}
+}
+
+// IsRejected reports whether the state machine refused the event, or the
+// caller rejected it with Reject.
+func IsRejected(err error) bool {
+	if err == nil {
+		return false
+	}
+	if errors.Is(err, ErrRejected) {
+		return true
+	}
+
+	// psm returns a status without a transition for the event as a plain
+	// error, before it writes anything
+	return strings.Contains(err.Error(), "no transition found for ")
+}
+
+// ErrAlreadyFired is returned by a Triggered transition for a time the trigger
+// has already fired for, e.g. from a redelivered tick, so that the transaction
+// rolls back without replying to the trigger again.
+var ErrAlreadyFired = errors.New("trigger already fired for this time")
+
+// recordFire adds the fire to the trigger_fire ledger, which is keyed by the
+// trigger and the time it fired for.
+func recordFire(ctx context.Context, tx sqrlx.Transaction, triggerID string, triggerTime time.Time) error {
+	res, err := tx.Insert(ctx, sq.Insert("trigger_fire").
+		Columns("trigger_id", "trigger_time").
+		Values(triggerID, triggerTime).
+		Suffix("ON CONFLICT DO NOTHING"))
 	if err != nil {
-		return fmt.Errorf("invalid cron string: %w", err)
+		return fmt.Errorf("record trigger fire: %w", err)
+	}
+
+	inserted, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("record trigger fire: %w", err)
+	}
+	if inserted == 0 {
+		return fmt.Errorf("trigger %s at %s: %w", triggerID, triggerTime.Format(time.RFC3339), ErrAlreadyFired)
 	}
+
 	return nil
 }
+
+// dueAt returns when the tick loop next needs to check the trigger, its next
+// fire time, or its notAfter time to expire it once it will not fire again.
+// Paused and faulted triggers are only due to expire them, and triggers which
+// have stopped for good are never due.
+func dueAt(state *trigger_pb.TriggerState) *time.Time {
+	switch state.Status {
+	case trigger_pb.TriggerStatus_ACTIVE:
+	case trigger_pb.TriggerStatus_PAUSED, trigger_pb.TriggerStatus_FAULTED:
+		if state.Data.NotAfter == nil {
+			return nil
+		}
+		due := state.Data.NotAfter.AsTime()
+		return &due
+	default:
+		return nil
+	}
+
+	var due time.Time
+	if state.Data.NextFireAt != nil {
+		due = state.Data.NextFireAt.AsTime()
+	} else if state.Data.NotAfter != nil {
+		due = state.Data.NotAfter.AsTime()
+	} else {
+		return nil
+	}
+
+	return &due
+}
@@ -0,0 +1,71 @@
+package utils
+
+import (
+	"fmt"
+
+	"github.com/pentops/trigger/gen/o5/trigger/v1/trigger_pb"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// definitionFields are the fields which configure a trigger. The create and
+// update actions, the Created and Updated events and the trigger data all
+// carry them under the same names as the TriggerDefinition.
+var definitionFields = (&trigger_pb.TriggerDefinition{}).ProtoReflect().Descriptor().Fields()
+
+// DefinitionOf returns the trigger definition carried by an action, event or
+// trigger data.
+func DefinitionOf(msg proto.Message) *trigger_pb.TriggerDefinition {
+	def := &trigger_pb.TriggerDefinition{}
+	copyDefinition(def.ProtoReflect(), msg.ProtoReflect())
+	return def
+}
+
+// ApplyDefinition sets the definition fields of an action, event or trigger
+// data from the definition, clearing those the definition leaves unset.
+func ApplyDefinition(dst proto.Message, def *trigger_pb.TriggerDefinition) {
+	copyDefinition(dst.ProtoReflect(), def.ProtoReflect())
+}
+
+func copyDefinition(dst, src protoreflect.Message) {
+	for i := 0; i < definitionFields.Len(); i++ {
+		name := definitionFields.Get(i).Name()
+		srcField := src.Descriptor().Fields().ByName(name)
+		dstField := dst.Descriptor().Fields().ByName(name)
+		if srcField == nil || dstField == nil {
+			panic(fmt.Sprintf("trigger definition field %s missing from %s or %s", name, src.Descriptor().FullName(), dst.Descriptor().FullName()))
+		}
+
+		if !src.Has(srcField) {
+			dst.Clear(dstField)
+			continue
+		}
+
+		val := src.Get(srcField)
+		if srcField.Message() != nil {
+			val = protoreflect.ValueOfMessage(proto.Clone(val.Message().Interface()).ProtoReflect())
+		}
+		dst.Set(dstField, val)
+	}
+}
+
+// ValidateDefinition checks the schedule, window, limits, calendar and payload
+// of a trigger definition.
+func ValidateDefinition(def *trigger_pb.TriggerDefinition) error {
+	if err := ValidateSchedule(def.Cron, def.Timezone, def.RunAt, def.Interval); err != nil {
+		return err
+	}
+	if err := ValidateWindow(def.NotBefore, def.NotAfter); err != nil {
+		return err
+	}
+	if err := ValidateMaxFires(def.MaxFires); err != nil {
+		return err
+	}
+	if err := ValidateJitter(def.JitterSeconds); err != nil {
+		return err
+	}
+	if err := ValidateCalendar(def.CalendarId, def.RunAt); err != nil {
+		return err
+	}
+	return ValidatePayload(def.Payload)
+}
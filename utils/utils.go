@@ -2,11 +2,19 @@ package utils
 
 import (
 	"database/sql"
+	"encoding/binary"
 	"fmt"
 	"math/big"
+	"strings"
+	"time"
+
+	// embed the zoneinfo database so timezones validate the same everywhere,
+	// regardless of what the container image ships with
+	_ "time/tzdata"
 
 	"github.com/google/uuid"
 	"github.com/pentops/sqrlx.go/sqrlx"
+	"github.com/pentops/trigger/gen/o5/trigger/v1/trigger_pb"
 	"github.com/robfig/cron/v3"
 )
 
@@ -14,6 +22,16 @@ var (
 	NamespaceTrigger = uuid.MustParse("C8343AEB-AEC1-430D-A6C0-6E7D2086D168")
 )
 
+// TickResolution is the cadence of the scheduler tick loop. Any seconds in a
+// cron expression must fall on a multiple of it to be reachable.
+const TickResolution = 5 * time.Second
+
+// cronParser accepts standard five field expressions, plus an optional leading
+// seconds field for sub-minute schedules, e.g. "*/15 * * * * *".
+var cronParser = cron.NewParser(
+	cron.SecondOptional | cron.Minute | cron.Hour | cron.Dom | cron.Month | cron.Dow | cron.Descriptor,
+)
+
 var ReadOnlyTxOptions = &sqrlx.TxOptions{
 	ReadOnly:  true,
 	Retryable: true,
@@ -26,6 +44,22 @@ var MutableTxOptions = &sqrlx.TxOptions{
 	Isolation: sql.LevelReadCommitted,
 }
 
+// MaxJitter is the longest a trigger reply can be spread out by.
+const MaxJitter = time.Hour
+
+// JitterOffset returns how long to delay the replies of a trigger, somewhere
+// up to the jitter window. The offset is hashed from the trigger ID so it stays
+// the same for every fire.
+func JitterOffset(triggerID string, jitterSeconds int32) time.Duration {
+	if jitterSeconds <= 0 {
+		return 0
+	}
+	// the same hash as NewIdempotentId
+	id := uuid.NewMD5(NamespaceTrigger, []byte(triggerID))
+	offset := binary.BigEndian.Uint64(id[:8]) % uint64(jitterSeconds)
+	return time.Duration(offset) * time.Second
+}
+
 func NewIdempotentId62(data []byte) string {
 	id := uuid.NewMD5(NamespaceTrigger, data)
 	return base62String(id[:])
@@ -48,8 +82,131 @@ func base62String(id []byte) string {
 	return str
 }
 
+// ParseCron parses a cron expression with an optional seconds field, rejecting
+// seconds which the tick loop would never land on. An @every duration counts
+// from whenever it is asked for the next time, so it is returned as an interval
+// anchored to the Unix epoch instead, and must be a multiple of the tick.
+func ParseCron(c string) (cron.Schedule, error) {
+	sched, err := cronParser.Parse(c)
+	if err != nil {
+		return nil, err
+	}
+
+	if every, ok := sched.(cron.ConstantDelaySchedule); ok {
+		if every.Delay%TickResolution != 0 {
+			return nil, fmt.Errorf("@every must be a multiple of %s, got %s", TickResolution, every.Delay)
+		}
+		return intervalSchedule{
+			anchor: time.Unix(0, 0).UTC(),
+			every:  every.Delay,
+		}, nil
+	}
+
+	if spec, ok := sched.(*cron.SpecSchedule); ok {
+		step := uint(TickResolution / time.Second)
+		for sec := uint(0); sec < 60; sec++ {
+			if spec.Second&(1<<sec) != 0 && sec%step != 0 {
+				return nil, fmt.Errorf("seconds must be a multiple of %d, got %d", step, sec)
+			}
+		}
+	}
+
+	return sched, nil
+}
+
+// LoadTimezone resolves an IANA timezone name, e.g. America/New_York. An empty
+// name is UTC.
+func LoadTimezone(name string) (*time.Location, error) {
+	if name == "" {
+		return time.UTC, nil
+	}
+
+	// LoadLocation treats "Local" as the server's zone, which is not portable
+	if name == "Local" {
+		return nil, fmt.Errorf("unknown timezone %q", name)
+	}
+
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		return nil, fmt.Errorf("unknown timezone %q", name)
+	}
+	return loc, nil
+}
+
+// ParseCronIn parses a cron expression which is evaluated as wall clock time
+// in the given timezone, UTC when empty. Expressions with a CRON_TZ prefix keep
+// their own timezone, but cannot be combined with an explicit one.
+func ParseCronIn(c, timezone string) (cron.Schedule, error) {
+	loc, err := LoadTimezone(timezone)
+	if err != nil {
+		return nil, err
+	}
+
+	hasPrefix := strings.HasPrefix(c, "CRON_TZ=") || strings.HasPrefix(c, "TZ=")
+	if hasPrefix && timezone != "" {
+		return nil, fmt.Errorf("timezone cannot be set with a CRON_TZ prefix as well")
+	}
+
+	sched, err := ParseCron(c)
+	if err != nil {
+		return nil, err
+	}
+
+	// without a prefix the parser leaves the location as time.Local, which
+	// robfig/cron then takes from the time passed to Next.
+	if spec, ok := sched.(*cron.SpecSchedule); ok && !hasPrefix {
+		spec.Location = loc
+	}
+
+	return sched, nil
+}
+
+// intervalSchedule fires every period, counting from the anchor.
+type intervalSchedule struct {
+	anchor time.Time
+	every  time.Duration
+}
+
+func (s intervalSchedule) Next(t time.Time) time.Time {
+	if t.Before(s.anchor) {
+		return s.anchor
+	}
+	n := t.Sub(s.anchor)/s.every + 1
+	return s.anchor.Add(n * s.every)
+}
+
+// MaxInterval is the longest period accepted for a fixed interval. Longer
+// schedules are better expressed as a cron, and the bound keeps the
+// conversion to a time.Duration from overflowing.
+const MaxInterval = 366 * 24 * time.Hour
+
+// ParseInterval builds the schedule for a fixed interval, which like cron
+// seconds must line up with the tick loop.
+func ParseInterval(interval *trigger_pb.Interval) (cron.Schedule, error) {
+	if interval.EverySeconds > int64(MaxInterval/time.Second) {
+		return nil, fmt.Errorf("interval must be at most %s, got %ds", MaxInterval, interval.EverySeconds)
+	}
+	every := time.Duration(interval.EverySeconds) * time.Second
+	if every <= 0 || every%TickResolution != 0 {
+		return nil, fmt.Errorf("interval must be a positive multiple of %s, got %s", TickResolution, every)
+	}
+
+	if interval.Anchor == nil {
+		return nil, fmt.Errorf("interval anchor is required")
+	}
+	anchor := interval.Anchor.AsTime()
+	if !anchor.Truncate(TickResolution).Equal(anchor) {
+		return nil, fmt.Errorf("interval anchor must be a multiple of %s, got %s", TickResolution, anchor)
+	}
+
+	return intervalSchedule{
+		anchor: anchor,
+		every:  every,
+	}, nil
+}
+
 func ValidateCronString(c string) error {
-	_, err := cron.ParseStandard(c)
+	_, err := ParseCron(c)
 	if err != nil {
 		return fmt.Errorf("invalid cron string: %w", err)
 	}
@@ -0,0 +1,100 @@
+package utils
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/pentops/j5/j5types/any_j5t"
+	"github.com/pentops/trigger/gen/o5/trigger/v1/trigger_pb"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// ValidateSchedule checks that exactly one of a cron expression, a one-shot
+// run time or an interval is set, and that the timezone is known.
+func ValidateSchedule(c, timezone string, runAt *timestamppb.Timestamp, interval *trigger_pb.Interval) error {
+	if _, err := LoadTimezone(timezone); err != nil {
+		return fmt.Errorf("invalid timezone: %w", err)
+	}
+
+	switch {
+	case runAt != nil:
+		if c != "" || interval != nil {
+			return fmt.Errorf("only one of cron, runAt or interval can be set")
+		}
+		return nil
+
+	case interval != nil:
+		if c != "" {
+			return fmt.Errorf("only one of cron, runAt or interval can be set")
+		}
+		_, err := ParseInterval(interval)
+		if err != nil {
+			return fmt.Errorf("invalid interval: %w", err)
+		}
+		return nil
+
+	default:
+		_, err := ParseCronIn(c, timezone)
+		if err != nil {
+			return fmt.Errorf("invalid cron string: %w", err)
+		}
+		return nil
+	}
+}
+
+// ValidateWindow checks that the notBefore and notAfter times, when both are
+// set, leave a window for the trigger to fire in.
+func ValidateWindow(notBefore, notAfter *timestamppb.Timestamp) error {
+	if notBefore == nil || notAfter == nil {
+		return nil
+	}
+	if notAfter.AsTime().Before(notBefore.AsTime()) {
+		return fmt.Errorf("notAfter must not be before notBefore")
+	}
+	return nil
+}
+
+func ValidateMaxFires(maxFires *int32) error {
+	if maxFires != nil && *maxFires < 1 {
+		return fmt.Errorf("maxFires must be at least 1")
+	}
+	return nil
+}
+
+func ValidateJitter(jitterSeconds int32) error {
+	jitter := time.Duration(jitterSeconds) * time.Second
+	if jitter < 0 || jitter > MaxJitter {
+		return fmt.Errorf("jitter must be between 0 and %s, got %s", MaxJitter, jitter)
+	}
+	return nil
+}
+
+func ValidateCalendar(calendarID *string, runAt *timestamppb.Timestamp) error {
+	if calendarID != nil && runAt != nil {
+		return fmt.Errorf("a calendar cannot be used with a one-shot runAt")
+	}
+	return nil
+}
+
+// ValidatePayload checks the payload can be stored as JSON, which needs the
+// J5Json encoding unless the message type is known to this service.
+func ValidatePayload(payload *any_j5t.Any) error {
+	if payload == nil {
+		return nil
+	}
+	if payload.TypeName == "" {
+		return fmt.Errorf("payload must have a type name")
+	}
+	if len(payload.J5Json) > 0 {
+		return nil
+	}
+	if len(payload.Proto) == 0 {
+		return fmt.Errorf("payload %q has no J5Json or proto data", payload.TypeName)
+	}
+	if _, err := protoregistry.GlobalTypes.FindMessageByName(protoreflect.FullName(payload.TypeName)); err != nil {
+		return fmt.Errorf("payload %q is not a known type, send it as J5Json", payload.TypeName)
+	}
+	return nil
+}